@@ -33,6 +33,9 @@ func SetDefaults_LandscaperConfiguration(obj *LandscaperConfiguration) {
 	SetDefaults_CommonControllerConfig(&obj.Controllers.Executions.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&obj.Controllers.DeployItems.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&obj.Controllers.Contexts.CommonControllerConfig)
+	SetDefaults_CommonControllerConfig(&obj.Controllers.Targets.CommonControllerConfig)
+	SetDefaults_TargetControllerConfig(&obj.Controllers.Targets.Config)
+	SetDefaults_CommonControllerConfig(&obj.Controllers.TargetChanges.CommonControllerConfig)
 
 	if obj.DeployItemTimeouts == nil {
 		obj.DeployItemTimeouts = &DeployItemTimeouts{}
@@ -80,6 +83,19 @@ func SetDefaults_CommonControllerConfig(obj *CommonControllerConfig) {
 	}
 }
 
+// SetDefaults_TargetControllerConfig sets the defaults for the target controller configuration.
+func SetDefaults_TargetControllerConfig(obj *TargetControllerConfig) {
+	if obj.ProbeInterval == nil {
+		obj.ProbeInterval = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.ProbeTimeout == nil {
+		obj.ProbeTimeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+	if obj.CredentialsExpirationWarning == nil {
+		obj.CredentialsExpirationWarning = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+	}
+}
+
 // SetDefaults_BlueprintStore sets the defaults for the landscaper blueprint store configuration.
 func SetDefaults_BlueprintStore(obj *BlueprintStore) {
 	// GCHighThreshold defines the default percent of disk usage which triggers files garbage collection.
@@ -35,6 +35,10 @@ type LandscaperConfiguration struct {
 	// DeployItemTimeouts contains configuration for multiple deploy item timeouts
 	// +optional
 	DeployItemTimeouts *DeployItemTimeouts `json:"deployItemTimeouts,omitempty"`
+	// DeletionSafeguard limits the number of orphaned objects which are deleted during a reconciliation without
+	// confirmation.
+	// +optional
+	DeletionSafeguard *DeletionSafeguard `json:"deletionSafeguard,omitempty"`
 	// LsDeployments contains the names of the landscaper deployments
 	// +optional
 	LsDeployments *LsDeployments `json:"lsDeployments,omitempty"`
@@ -109,6 +113,12 @@ type Controllers struct {
 	DeployItems DeployItemsController `json:"deployItems"`
 	// Contexts contains the controller config that reconciles context objects.
 	Contexts ContextsController `json:"contexts"`
+	// Targets contains the controller config that probes targets.
+	// +optional
+	Targets TargetsController `json:"targets,omitempty"`
+	// TargetChanges contains the controller config that reconciles deploy items again whose target has changed.
+	// +optional
+	TargetChanges TargetChangesController `json:"targetChanges,omitempty"`
 }
 
 // InstallationsController contains the controller config that reconciles installations.
@@ -150,6 +160,45 @@ type ContextControllerDefaultConfig struct {
 	RepositoryContext *cdv2.UnstructuredTypedObject `json:"repositoryContext,omitempty"`
 }
 
+// TargetsController contains all configuration for the target controller.
+type TargetsController struct {
+	CommonControllerConfig
+	Config TargetControllerConfig `json:"config"`
+}
+
+// TargetControllerConfig contains the configuration for the probing of targets.
+type TargetControllerConfig struct {
+	// Disable disables the target controller.
+	// If disabled, targets are not probed and their status is not maintained.
+	Disable bool `json:"disable"`
+	// ProbeInterval defines how often a target is probed.
+	// Defaults to 10 minutes.
+	// +optional
+	ProbeInterval *metav1.Duration `json:"probeInterval,omitempty"`
+	// ProbeTimeout defines how long a single probe may take before the target is considered unreachable.
+	// Defaults to 10 seconds.
+	// +optional
+	ProbeTimeout *metav1.Duration `json:"probeTimeout,omitempty"`
+	// CredentialsExpirationWarning defines how long before the expiration of the credentials of a target
+	// a warning is raised.
+	// Defaults to 7 days.
+	// +optional
+	CredentialsExpirationWarning *metav1.Duration `json:"credentialsExpirationWarning,omitempty"`
+}
+
+// TargetChangesController contains all configuration for the target change controller.
+type TargetChangesController struct {
+	CommonControllerConfig
+	Config TargetChangeControllerConfig `json:"config"`
+}
+
+// TargetChangeControllerConfig contains the configuration for the reconciliation of deploy items on target changes.
+type TargetChangeControllerConfig struct {
+	// Disable disables the target change controller.
+	// If disabled, a change of a target does not start a new job of the deploy items with reconcileOnTargetChange.
+	Disable bool `json:"disable"`
+}
+
 // DeployItemTimeouts contains multiple timeout configurations for deploy items
 type DeployItemTimeouts struct {
 	// PickupTimeout defines how long a deployer can take to react on changes to a deploy item before the landscaper will mark it as failed.
@@ -164,6 +213,19 @@ type DeployItemTimeouts struct {
 	Abort *lsv1alpha1.Duration `json:"abort,omitempty"`
 }
 
+// DeletionSafeguard limits the deletion of orphaned subinstallations and deploy items during a reconciliation.
+// If the deletion exceeds a limit, the reconciliation stops until the deletion is confirmed by an annotation.
+type DeletionSafeguard struct {
+	// MaxDeletions is the maximal number of orphaned subinstallations of an installation, or orphaned deploy items of
+	// an execution, which are deleted without confirmation.
+	// +optional
+	MaxDeletions *int32 `json:"maxDeletions,omitempty"`
+	// MaxDeletionPercentage is the maximal percentage of the subinstallations of an installation, or of the deploy
+	// items of an execution, which are deleted without confirmation.
+	// +optional
+	MaxDeletionPercentage *int32 `json:"maxDeletionPercentage,omitempty"`
+}
+
 // RegistryConfiguration contains the configuration for the used definition registry
 type RegistryConfiguration struct {
 	// Local defines a local registry to use for definitions
@@ -108,6 +108,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeletionSafeguard)(nil), (*config.DeletionSafeguard)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeletionSafeguard_To_config_DeletionSafeguard(a.(*DeletionSafeguard), b.(*config.DeletionSafeguard), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DeletionSafeguard)(nil), (*DeletionSafeguard)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DeletionSafeguard_To_v1alpha1_DeletionSafeguard(a.(*config.DeletionSafeguard), b.(*DeletionSafeguard), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployItemTimeouts)(nil), (*config.DeployItemTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployItemTimeouts_To_config_DeployItemTimeouts(a.(*DeployItemTimeouts), b.(*config.DeployItemTimeouts), scope)
 	}); err != nil {
@@ -238,6 +248,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetChangeControllerConfig)(nil), (*config.TargetChangeControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetChangeControllerConfig_To_config_TargetChangeControllerConfig(a.(*TargetChangeControllerConfig), b.(*config.TargetChangeControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetChangeControllerConfig)(nil), (*TargetChangeControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetChangeControllerConfig_To_v1alpha1_TargetChangeControllerConfig(a.(*config.TargetChangeControllerConfig), b.(*TargetChangeControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetChangesController)(nil), (*config.TargetChangesController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetChangesController_To_config_TargetChangesController(a.(*TargetChangesController), b.(*config.TargetChangesController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetChangesController)(nil), (*TargetChangesController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetChangesController_To_v1alpha1_TargetChangesController(a.(*config.TargetChangesController), b.(*TargetChangesController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetControllerConfig)(nil), (*config.TargetControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig(a.(*TargetControllerConfig), b.(*config.TargetControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetControllerConfig)(nil), (*TargetControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig(a.(*config.TargetControllerConfig), b.(*TargetControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetsController)(nil), (*config.TargetsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetsController_To_config_TargetsController(a.(*TargetsController), b.(*config.TargetsController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetsController)(nil), (*TargetsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetsController_To_v1alpha1_TargetsController(a.(*config.TargetsController), b.(*TargetsController), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -405,6 +455,12 @@ func autoConvert_v1alpha1_Controllers_To_config_Controllers(in *Controllers, out
 	if err := Convert_v1alpha1_ContextsController_To_config_ContextsController(&in.Contexts, &out.Contexts, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_TargetsController_To_config_TargetsController(&in.Targets, &out.Targets, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TargetChangesController_To_config_TargetChangesController(&in.TargetChanges, &out.TargetChanges, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -427,6 +483,12 @@ func autoConvert_config_Controllers_To_v1alpha1_Controllers(in *config.Controlle
 	if err := Convert_config_ContextsController_To_v1alpha1_ContextsController(&in.Contexts, &out.Contexts, s); err != nil {
 		return err
 	}
+	if err := Convert_config_TargetsController_To_v1alpha1_TargetsController(&in.Targets, &out.Targets, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TargetChangesController_To_v1alpha1_TargetChangesController(&in.TargetChanges, &out.TargetChanges, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -457,6 +519,28 @@ func Convert_config_CrdManagementConfiguration_To_v1alpha1_CrdManagementConfigur
 	return autoConvert_config_CrdManagementConfiguration_To_v1alpha1_CrdManagementConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeletionSafeguard_To_config_DeletionSafeguard(in *DeletionSafeguard, out *config.DeletionSafeguard, s conversion.Scope) error {
+	out.MaxDeletions = (*int32)(unsafe.Pointer(in.MaxDeletions))
+	out.MaxDeletionPercentage = (*int32)(unsafe.Pointer(in.MaxDeletionPercentage))
+	return nil
+}
+
+// Convert_v1alpha1_DeletionSafeguard_To_config_DeletionSafeguard is an autogenerated conversion function.
+func Convert_v1alpha1_DeletionSafeguard_To_config_DeletionSafeguard(in *DeletionSafeguard, out *config.DeletionSafeguard, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeletionSafeguard_To_config_DeletionSafeguard(in, out, s)
+}
+
+func autoConvert_config_DeletionSafeguard_To_v1alpha1_DeletionSafeguard(in *config.DeletionSafeguard, out *DeletionSafeguard, s conversion.Scope) error {
+	out.MaxDeletions = (*int32)(unsafe.Pointer(in.MaxDeletions))
+	out.MaxDeletionPercentage = (*int32)(unsafe.Pointer(in.MaxDeletionPercentage))
+	return nil
+}
+
+// Convert_config_DeletionSafeguard_To_v1alpha1_DeletionSafeguard is an autogenerated conversion function.
+func Convert_config_DeletionSafeguard_To_v1alpha1_DeletionSafeguard(in *config.DeletionSafeguard, out *DeletionSafeguard, s conversion.Scope) error {
+	return autoConvert_config_DeletionSafeguard_To_v1alpha1_DeletionSafeguard(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployItemTimeouts_To_config_DeployItemTimeouts(in *DeployItemTimeouts, out *config.DeployItemTimeouts, s conversion.Scope) error {
 	out.Pickup = (*core.Duration)(unsafe.Pointer(in.Pickup))
 	out.Abort = (*core.Duration)(unsafe.Pointer(in.Abort))
@@ -619,6 +703,7 @@ func autoConvert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfigurat
 		return err
 	}
 	out.DeployItemTimeouts = (*config.DeployItemTimeouts)(unsafe.Pointer(in.DeployItemTimeouts))
+	out.DeletionSafeguard = (*config.DeletionSafeguard)(unsafe.Pointer(in.DeletionSafeguard))
 	out.LsDeployments = (*config.LsDeployments)(unsafe.Pointer(in.LsDeployments))
 	out.HPAMainConfiguration = (*config.HPAMainConfiguration)(unsafe.Pointer(in.HPAMainConfiguration))
 	out.SignatureVerificationEnforcementPolicy = config.SignatureVerificationEnforcementPolicy(in.SignatureVerificationEnforcementPolicy)
@@ -646,6 +731,7 @@ func autoConvert_config_LandscaperConfiguration_To_v1alpha1_LandscaperConfigurat
 		return err
 	}
 	out.DeployItemTimeouts = (*DeployItemTimeouts)(unsafe.Pointer(in.DeployItemTimeouts))
+	out.DeletionSafeguard = (*DeletionSafeguard)(unsafe.Pointer(in.DeletionSafeguard))
 	out.LsDeployments = (*LsDeployments)(unsafe.Pointer(in.LsDeployments))
 	out.HPAMainConfiguration = (*HPAMainConfiguration)(unsafe.Pointer(in.HPAMainConfiguration))
 	out.SignatureVerificationEnforcementPolicy = SignatureVerificationEnforcementPolicy(in.SignatureVerificationEnforcementPolicy)
@@ -796,3 +882,109 @@ func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(
 func Convert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	return autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_TargetChangeControllerConfig_To_config_TargetChangeControllerConfig(in *TargetChangeControllerConfig, out *config.TargetChangeControllerConfig, s conversion.Scope) error {
+	out.Disable = in.Disable
+	return nil
+}
+
+// Convert_v1alpha1_TargetChangeControllerConfig_To_config_TargetChangeControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TargetChangeControllerConfig_To_config_TargetChangeControllerConfig(in *TargetChangeControllerConfig, out *config.TargetChangeControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetChangeControllerConfig_To_config_TargetChangeControllerConfig(in, out, s)
+}
+
+func autoConvert_config_TargetChangeControllerConfig_To_v1alpha1_TargetChangeControllerConfig(in *config.TargetChangeControllerConfig, out *TargetChangeControllerConfig, s conversion.Scope) error {
+	out.Disable = in.Disable
+	return nil
+}
+
+// Convert_config_TargetChangeControllerConfig_To_v1alpha1_TargetChangeControllerConfig is an autogenerated conversion function.
+func Convert_config_TargetChangeControllerConfig_To_v1alpha1_TargetChangeControllerConfig(in *config.TargetChangeControllerConfig, out *TargetChangeControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_TargetChangeControllerConfig_To_v1alpha1_TargetChangeControllerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetChangesController_To_config_TargetChangesController(in *TargetChangesController, out *config.TargetChangesController, s conversion.Scope) error {
+	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TargetChangeControllerConfig_To_config_TargetChangeControllerConfig(&in.Config, &out.Config, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TargetChangesController_To_config_TargetChangesController is an autogenerated conversion function.
+func Convert_v1alpha1_TargetChangesController_To_config_TargetChangesController(in *TargetChangesController, out *config.TargetChangesController, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetChangesController_To_config_TargetChangesController(in, out, s)
+}
+
+func autoConvert_config_TargetChangesController_To_v1alpha1_TargetChangesController(in *config.TargetChangesController, out *TargetChangesController, s conversion.Scope) error {
+	if err := Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TargetChangeControllerConfig_To_v1alpha1_TargetChangeControllerConfig(&in.Config, &out.Config, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_TargetChangesController_To_v1alpha1_TargetChangesController is an autogenerated conversion function.
+func Convert_config_TargetChangesController_To_v1alpha1_TargetChangesController(in *config.TargetChangesController, out *TargetChangesController, s conversion.Scope) error {
+	return autoConvert_config_TargetChangesController_To_v1alpha1_TargetChangesController(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig(in *TargetControllerConfig, out *config.TargetControllerConfig, s conversion.Scope) error {
+	out.Disable = in.Disable
+	out.ProbeInterval = (*v1.Duration)(unsafe.Pointer(in.ProbeInterval))
+	out.ProbeTimeout = (*v1.Duration)(unsafe.Pointer(in.ProbeTimeout))
+	out.CredentialsExpirationWarning = (*v1.Duration)(unsafe.Pointer(in.CredentialsExpirationWarning))
+	return nil
+}
+
+// Convert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig(in *TargetControllerConfig, out *config.TargetControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig(in, out, s)
+}
+
+func autoConvert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig(in *config.TargetControllerConfig, out *TargetControllerConfig, s conversion.Scope) error {
+	out.Disable = in.Disable
+	out.ProbeInterval = (*v1.Duration)(unsafe.Pointer(in.ProbeInterval))
+	out.ProbeTimeout = (*v1.Duration)(unsafe.Pointer(in.ProbeTimeout))
+	out.CredentialsExpirationWarning = (*v1.Duration)(unsafe.Pointer(in.CredentialsExpirationWarning))
+	return nil
+}
+
+// Convert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig is an autogenerated conversion function.
+func Convert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig(in *config.TargetControllerConfig, out *TargetControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetsController_To_config_TargetsController(in *TargetsController, out *config.TargetsController, s conversion.Scope) error {
+	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TargetControllerConfig_To_config_TargetControllerConfig(&in.Config, &out.Config, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TargetsController_To_config_TargetsController is an autogenerated conversion function.
+func Convert_v1alpha1_TargetsController_To_config_TargetsController(in *TargetsController, out *config.TargetsController, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetsController_To_config_TargetsController(in, out, s)
+}
+
+func autoConvert_config_TargetsController_To_v1alpha1_TargetsController(in *config.TargetsController, out *TargetsController, s conversion.Scope) error {
+	if err := Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TargetControllerConfig_To_v1alpha1_TargetControllerConfig(&in.Config, &out.Config, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_TargetsController_To_v1alpha1_TargetsController is an autogenerated conversion function.
+func Convert_config_TargetsController_To_v1alpha1_TargetsController(in *config.TargetsController, out *TargetsController, s conversion.Scope) error {
+	return autoConvert_config_TargetsController_To_v1alpha1_TargetsController(in, out, s)
+}
@@ -136,6 +136,8 @@ func (in *Controllers) DeepCopyInto(out *Controllers) {
 	in.Executions.DeepCopyInto(&out.Executions)
 	in.DeployItems.DeepCopyInto(&out.DeployItems)
 	in.Contexts.DeepCopyInto(&out.Contexts)
+	in.Targets.DeepCopyInto(&out.Targets)
+	in.TargetChanges.DeepCopyInto(&out.TargetChanges)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Controllers.
@@ -173,6 +175,31 @@ func (in *CrdManagementConfiguration) DeepCopy() *CrdManagementConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionSafeguard) DeepCopyInto(out *DeletionSafeguard) {
+	*out = *in
+	if in.MaxDeletions != nil {
+		in, out := &in.MaxDeletions, &out.MaxDeletions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDeletionPercentage != nil {
+		in, out := &in.MaxDeletionPercentage, &out.MaxDeletionPercentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionSafeguard.
+func (in *DeletionSafeguard) DeepCopy() *DeletionSafeguard {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionSafeguard)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemTimeouts) DeepCopyInto(out *DeployItemTimeouts) {
 	*out = *in
@@ -303,6 +330,11 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 		*out = new(DeployItemTimeouts)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeletionSafeguard != nil {
+		in, out := &in.DeletionSafeguard, &out.DeletionSafeguard
+		*out = new(DeletionSafeguard)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LsDeployments != nil {
 		in, out := &in.LsDeployments, &out.LsDeployments
 		*out = new(LsDeployments)
@@ -447,3 +479,82 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetChangeControllerConfig) DeepCopyInto(out *TargetChangeControllerConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetChangeControllerConfig.
+func (in *TargetChangeControllerConfig) DeepCopy() *TargetChangeControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetChangeControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetChangesController) DeepCopyInto(out *TargetChangesController) {
+	*out = *in
+	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	out.Config = in.Config
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetChangesController.
+func (in *TargetChangesController) DeepCopy() *TargetChangesController {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetChangesController)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetControllerConfig) DeepCopyInto(out *TargetControllerConfig) {
+	*out = *in
+	if in.ProbeInterval != nil {
+		in, out := &in.ProbeInterval, &out.ProbeInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ProbeTimeout != nil {
+		in, out := &in.ProbeTimeout, &out.ProbeTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CredentialsExpirationWarning != nil {
+		in, out := &in.CredentialsExpirationWarning, &out.CredentialsExpirationWarning
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetControllerConfig.
+func (in *TargetControllerConfig) DeepCopy() *TargetControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetsController) DeepCopyInto(out *TargetsController) {
+	*out = *in
+	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	in.Config.DeepCopyInto(&out.Config)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetsController.
+func (in *TargetsController) DeepCopy() *TargetsController {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetsController)
+	in.DeepCopyInto(out)
+	return out
+}
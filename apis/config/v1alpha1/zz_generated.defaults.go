@@ -26,6 +26,8 @@ func SetObjectDefaults_LandscaperConfiguration(in *LandscaperConfiguration) {
 	SetDefaults_CommonControllerConfig(&in.Controllers.Executions.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&in.Controllers.DeployItems.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&in.Controllers.Contexts.CommonControllerConfig)
+	SetDefaults_CommonControllerConfig(&in.Controllers.Targets.CommonControllerConfig)
+	SetDefaults_TargetControllerConfig(&in.Controllers.Targets.Config)
 	SetDefaults_BlueprintStore(&in.BlueprintStore)
 	SetDefaults_CrdManagementConfiguration(&in.CrdManagement)
 }
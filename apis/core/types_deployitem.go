@@ -65,6 +65,11 @@ type DeployItemSpec struct {
 	// +optional
 	UpdateOnChangeOnly bool `json:"updateOnChangeOnly,omitempty"`
 
+	// ReconcileOnTargetChange specifies that the deploy item is reconciled again when the resolved content of its target
+	// changes, e.g. because the secret referenced by the target has been rotated.
+	// +optional
+	ReconcileOnTargetChange bool `json:"reconcileOnTargetChange,omitempty"`
+
 	// OnDelete specifies particular setting when deleting a deploy item
 	OnDelete *OnDeleteConfig `json:"onDelete,omitempty"`
 }
@@ -116,12 +121,28 @@ type DeployItemStatus struct {
 	// JobIDGenerationTime is the timestamp when the JobID was set.
 	JobIDGenerationTime *metav1.Time `json:"jobIDGenerationTime,omitempty"`
 
+	// TargetContentHash is the hash of the resolved content of the target with which the deploy item
+	// has been reconciled the last time. It is only maintained if ReconcileOnTargetChange is set.
+	// +optional
+	TargetContentHash string `json:"targetContentHash,omitempty"`
+
+	// ReferencedDataHash is the hash of the data in Secrets and ConfigMaps which is referenced by the configuration
+	// of the deploy item, e.g. by the valuesFrom of a helm deploy item, with which the deploy item has been
+	// reconciled the last time. It is used to reconcile a deploy item with UpdateOnChangeOnly again if the
+	// referenced data has changed.
+	// +optional
+	ReferencedDataHash string `json:"referencedDataHash,omitempty"`
+
 	// DeployerPhase is DEPRECATED and will soon be removed.
 	DeployerPhase *string `json:"deployItemPhase,omitempty"`
 
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// Retry describes the retries of the failed deploy item according to the retry policy of its template.
+	// +optional
+	Retry *RetryStatus `json:"retry,omitempty"`
 }
 
 // DeployerInformation holds additional information about the deployer that
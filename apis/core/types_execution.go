@@ -63,6 +63,21 @@ type ExecutionSpec struct {
 
 	// DeployItemsCompressed as zipped byte array
 	DeployItemsCompressed []byte `json:"deployItemsCompressed,omitempty"`
+
+	// Rollout configures the progressive rollout of the deploy items with a rollout group.
+	// If not set, all deploy items are updated at once.
+	// +optional
+	Rollout *RolloutPolicy `json:"rollout,omitempty"`
+
+	// RequireApproval specifies that changes of the deploy items have to be approved before they are applied.
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
+
+	// MaxParallel is the maximum number of deploy items which are processed in parallel.
+	// Further runnable deploy items are queued until running deploy items have finished.
+	// If not set, all runnable deploy items are started at once.
+	// +optional
+	MaxParallel *int32 `json:"maxParallel,omitempty"`
 }
 
 // ExecutionStatus contains the current status of a execution.
@@ -102,6 +117,35 @@ type ExecutionStatus struct {
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// Rollout describes the progress of the rollout of the deploy items.
+	// It is only maintained if the execution has a rollout policy.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+
+	// NextRetryTime is the time of the next scheduled retry of a failed deploy item according to its retry policy.
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+
+	// PendingDeletion describes the deletion of orphaned deploy items, which waits for a confirmation because it
+	// exceeds the deletion safeguard.
+	// +optional
+	PendingDeletion *PendingDeletion `json:"pendingDeletion,omitempty"`
+
+	// PendingApproval describes the change of the deploy items which waits for approval.
+	// +optional
+	PendingApproval *PendingApproval `json:"pendingApproval,omitempty"`
+}
+
+// PendingApproval describes a change of the deploy items of an execution which waits for approval.
+type PendingApproval struct {
+	// Digest identifies the pending change.
+	// The change is approved by setting the approve annotation of the execution to this digest.
+	Digest string `json:"digest"`
+
+	// DeployItems contains the names of the deploy items which are created, updated or deleted by the change.
+	// +optional
+	DeployItems []string `json:"deployItems,omitempty"`
 }
 
 // DeployItemTemplateList is a list of deploy item templates
@@ -141,8 +185,50 @@ type DeployItemTemplate struct {
 	// +optional
 	UpdateOnChangeOnly bool `json:"updateOnChangeOnly,omitempty"`
 
+	// ReconcileOnTargetChange specifies that the deploy item is reconciled again when the resolved content of its target
+	// changes, e.g. because the secret referenced by the target has been rotated.
+	// +optional
+	ReconcileOnTargetChange bool `json:"reconcileOnTargetChange,omitempty"`
+
+	// RolloutGroup identifies the element of a targetList or targetMap import the deploy item deploys to.
+	// Deploy items with a rollout group are released wave by wave according to the rollout policy of the execution.
+	// +optional
+	RolloutGroup string `json:"rolloutGroup,omitempty"`
+
 	// OnDelete specifies particular setting when deleting a deploy item
 	OnDelete *OnDeleteConfig `json:"onDelete,omitempty"`
+
+	// RetryPolicy configures automatic retries of the deploy item when it has failed, depending on the error codes
+	// of the failure. The execution waits for the retries before it fails.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// When specifies whether the deploy item is created. The deploy item is omitted if the value is false.
+	// The field is evaluated when the deploy executions of a blueprint are templated and must not be set in executions.
+	// +optional
+	When *bool `json:"when,omitempty"`
+
+	// ForEach expands the deploy item into one deploy item per element of a list, a map, or a targetList or targetMap
+	// import. The field is evaluated when the deploy executions of a blueprint are templated and must not be set in
+	// executions.
+	// +optional
+	ForEach *DeployItemForEach `json:"forEach,omitempty"`
+}
+
+// DeployItemForEach defines the elements over which a deploy item template is expanded.
+// Exactly one of Items and TargetImport has to be set.
+// The generated deploy items are named "<name>-<key>", where the key is the index of a list element or the key of
+// a map element. The placeholders "$(each.key)" and "$(each.value)" in the deploy item template are replaced by the
+// key and the value of the element; fields of the value are referenced by paths like "$(each.value.name)".
+type DeployItemForEach struct {
+	// Items is a list or a map of values.
+	// +optional
+	Items *AnyJSON `json:"items,omitempty"`
+
+	// TargetImport is the name of a targetList or targetMap import of the blueprint.
+	// The target of each generated deploy item is the respective element of the import.
+	// +optional
+	TargetImport string `json:"targetImport,omitempty"`
 }
 
 // OnDeleteConfig specifies particular setting when deleting a deploy item
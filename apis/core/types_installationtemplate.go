@@ -47,6 +47,16 @@ type InstallationTemplate struct {
 	// Optimization contains settings to improve execution performance.
 	// +optional
 	Optimization *Optimization `json:"optimization,omitempty"`
+
+	// Rollout configures the progressive rollout of the deploy items and subinstallations of the installation
+	// which deploy to an element of a targetList or targetMap import.
+	// +optional
+	Rollout *RolloutPolicy `json:"rollout,omitempty"`
+
+	// DependsOn lists the names of sibling installation templates which have to be finished successfully
+	// before this subinstallation is reconciled, additionally to the dependencies defined by the imports.
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
 }
 
 // InstallationTemplateList is a list of installation templates.
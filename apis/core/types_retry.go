@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RetryPolicy configures automatic retries of a failed object depending on the error codes of its last error.
+// The waiting time before a retry grows exponentially with the number of retries.
+type RetryPolicy struct {
+	// RetryOn lists the error codes for which a failed object is retried.
+	// If empty, a failed object is retried for all error codes which are not listed in NoRetryOn.
+	// +optional
+	RetryOn []ErrorCode `json:"retryOn,omitempty"`
+
+	// NoRetryOn lists the error codes for which a failed object is never retried. It takes precedence over RetryOn.
+	// +optional
+	NoRetryOn []ErrorCode `json:"noRetryOn,omitempty"`
+
+	// MaxAttempts is the maximal number of retries. If not set, no upper limit exists.
+	// +optional
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+
+	// Backoff configures the waiting time before a retry.
+	// +optional
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+}
+
+// RetryBackoff configures an exponential backoff between retries.
+type RetryBackoff struct {
+	// InitialInterval is the waiting time before the first retry. Defaults to 30 seconds.
+	// +optional
+	InitialInterval *Duration `json:"initialInterval,omitempty"`
+
+	// MaxInterval is the upper limit of the waiting time before a retry. Defaults to 10 minutes.
+	// +optional
+	MaxInterval *Duration `json:"maxInterval,omitempty"`
+
+	// Factor is the factor by which the waiting time grows with every retry. Defaults to 2.
+	// +optional
+	Factor *int32 `json:"factor,omitempty"`
+
+	// JitterPercentage is the maximal percentage by which the waiting time is randomly extended,
+	// so that objects which failed at the same time are not retried at the same time. Defaults to 10.
+	// +optional
+	JitterPercentage *int32 `json:"jitterPercentage,omitempty"`
+}
+
+// RetryStatus describes the retries of a failed object according to its retry policy.
+type RetryStatus struct {
+	// Generation is the generation of the object for which the retries are counted.
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+
+	// Attempts is the number of retries which have been started.
+	Attempts int32 `json:"attempts"`
+
+	// LastAttemptTime is the time when the last retry was started.
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+
+	// NextRetryTime is the time when the next retry is started, if a retry is scheduled.
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+}
@@ -30,6 +30,10 @@ type Target struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Spec TargetSpec `json:"spec"`
+
+	// Status contains the observed state of the target.
+	// +optional
+	Status TargetStatus `json:"status,omitzero"`
 }
 
 // TargetSpec contains the definition of a target.
@@ -49,6 +53,31 @@ type TargetSpec struct {
 	SecretRef *LocalSecretReference `json:"secretRef,omitempty"`
 }
 
+// TargetStatus contains the observed state of a target.
+// The status is only maintained for targets of type kubernetes-cluster which are periodically probed by the landscaper.
+type TargetStatus struct {
+	// ObservedGeneration is the most recent generation of the target that has been probed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions contains the last observed conditions of the target.
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// ServerVersion is the version of the api server of the target cluster observed by the last successful probe.
+	// +optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// CredentialsExpirationTime is the time when the client certificate or token of the target's kubeconfig expires.
+	// It is not set if the credentials do not expire or their expiration time cannot be determined.
+	// +optional
+	CredentialsExpirationTime *metav1.Time `json:"credentialsExpirationTime,omitempty"`
+
+	// LastProbeTime is the time when the target was probed the last time.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+}
+
 // TargetTemplate exposes specific parts of a target that are used in the exports
 // to export a target
 type TargetTemplate struct {
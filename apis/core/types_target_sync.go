@@ -64,12 +64,45 @@ type TargetSyncSpec struct {
 	// +optional
 	ShootNameExpression string `json:"shootNameExpression"`
 
+	// ClusterAPI defines that targets are created for the Cluster API clusters in the source namespace. The kubeconfigs
+	// of the clusters are taken from the secrets "<cluster name>-kubeconfig" maintained by Cluster API.
+	// if not set no targets for Cluster API clusters are created
+	// +optional
+	ClusterAPI *TargetSyncClusterAPISource `json:"clusterAPI,omitempty"`
+
+	// SecretSelector defines that targets are created for the secrets in the source namespace which match a label selector.
+	// This allows to sync the kubeconfigs of cluster managers which publish them in their own secret formats.
+	// if not set no secrets are selected by labels
+	// +optional
+	SecretSelector *TargetSyncSecretSelectorSource `json:"secretSelector,omitempty"`
+
 	// TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the
 	// secrets to sync. The token expires after 90 days and will be rotated every 60 days.
 	// +optional
 	TokenRotation *TokenRotation `json:"tokenRotation,omitempty"`
 }
 
+// TargetSyncClusterAPISource defines the sync of Cluster API clusters.
+type TargetSyncClusterAPISource struct {
+	// ClusterNameExpression defines the names of the Cluster API clusters for which targets are created via a regular
+	// expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid
+	// expression and matches all names.
+	// If not set, targets are created for all clusters.
+	// +optional
+	ClusterNameExpression string `json:"clusterNameExpression,omitempty"`
+}
+
+// TargetSyncSecretSelectorSource defines the sync of secrets selected by labels.
+type TargetSyncSecretSelectorSource struct {
+	// LabelSelector selects the secrets in the source namespace which are synced.
+	LabelSelector metav1.LabelSelector `json:"labelSelector"`
+
+	// KubeconfigKey is the key in the data of the selected secrets which contains the kubeconfig.
+	// Defaults to "kubeconfig".
+	// +optional
+	KubeconfigKey string `json:"kubeconfigKey,omitempty"`
+}
+
 type TokenRotation struct {
 	// Enabled defines if automatic token is executed
 	Enabled bool `json:"enabled,omitempty"`
@@ -92,4 +125,47 @@ type TargetSyncStatus struct {
 	// Last time the token was rotated
 	// +optional
 	LastTokenRotationTime *metav1.Time `json:"lastTokenRotationTime,omitempty"`
+
+	// Sources contains the result of the last sync for every source of the TargetSync.
+	// +optional
+	Sources []TargetSyncSourceStatus `json:"sources,omitempty"`
+}
+
+// TargetSyncSourceType defines the type of a source from which a TargetSync creates targets.
+type TargetSyncSourceType string
+
+const (
+	// TargetSyncSourceTypeSecrets is the type of the source that syncs secrets matching the SecretNameExpression.
+	TargetSyncSourceTypeSecrets TargetSyncSourceType = "Secrets"
+	// TargetSyncSourceTypeShoots is the type of the source that creates targets for shoots matching the ShootNameExpression.
+	TargetSyncSourceTypeShoots TargetSyncSourceType = "Shoots"
+	// TargetSyncSourceTypeClusterAPI is the type of the source that creates targets for Cluster API clusters.
+	TargetSyncSourceTypeClusterAPI TargetSyncSourceType = "ClusterAPI"
+	// TargetSyncSourceTypeSecretSelector is the type of the source that syncs secrets selected by labels.
+	TargetSyncSourceTypeSecretSelector TargetSyncSourceType = "SecretSelector"
+	// TargetSyncSourceTypeTargetToSource is the type of the source that creates the target to the source cluster.
+	TargetSyncSourceTypeTargetToSource TargetSyncSourceType = "TargetToSource"
+)
+
+// TargetSyncSourceStatus contains the result of the last sync of a source of a TargetSync.
+type TargetSyncSourceStatus struct {
+	// Type is the type of the source.
+	Type TargetSyncSourceType `json:"type"`
+
+	// CreatedTargets contains the names of the targets which were created by the last sync.
+	// +optional
+	CreatedTargets []string `json:"createdTargets,omitempty"`
+
+	// UpdatedTargets contains the names of the targets which were updated by the last sync.
+	// +optional
+	UpdatedTargets []string `json:"updatedTargets,omitempty"`
+
+	// RemovedTargets contains the names of the targets which were removed by the last sync.
+	// +optional
+	RemovedTargets []string `json:"removedTargets,omitempty"`
+
+	// ConflictingTargets contains the names of the targets which were not synced from this source, because another
+	// source of the TargetSync produces a target with the same name.
+	// +optional
+	ConflictingTargets []string `json:"conflictingTargets,omitempty"`
 }
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TargetTypeDefinitionList contains a list of TargetTypeDefinitions
+type TargetTypeDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetTypeDefinition `json:"items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TargetTypeDefinition registers a target type in the landscaper cluster.
+// It declares the schema of the configuration of all targets of the type.
+type TargetTypeDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification of the target type.
+	Spec TargetTypeDefinitionSpec `json:"spec"`
+}
+
+// TargetTypeDefinitionSpec contains the specification of a target type.
+type TargetTypeDefinitionSpec struct {
+	// Type is the registered target type, e.g. "landscaper.gardener.cloud/kubernetes-cluster".
+	// A target type must not be registered by more than one TargetTypeDefinition.
+	Type TargetType `json:"type"`
+
+	// Schema is the json schema that the configuration of targets of this type has to satisfy.
+	// The schema is applied to the inline configuration of a target as well as to the content of the referenced secret.
+	// +optional
+	Schema *JSONSchemaDefinition `json:"schema,omitempty"`
+}
@@ -31,6 +31,10 @@ const (
 	// uninstalling the deployed artifacts
 	DeleteWithoutUninstallAnnotation = LandscaperDomain + "/delete-without-uninstall"
 
+	// DeletionProtectionAnnotation is the annotation that protects installations, targets, and data objects against
+	// deletion. The protection is enforced by the landscaper webhooks if the value is "true".
+	DeletionProtectionAnnotation = LandscaperDomain + "/deletion-protection"
+
 	// CacheHelmChartsAnnotation specifies if helm charts of an installation should be cached
 	CacheHelmChartsAnnotation = LandscaperDomain + "/cache-helm-charts"
 
@@ -57,6 +61,19 @@ const (
 	// RotateTokenAnnotation is the annotation that specifies to rotate a token (used e.g. in the context of TargetSyncObjects)
 	RotateTokenAnnotation = LandscaperDomain + "/rotate-token"
 
+	// SuspendedAnnotation is set by the landscaper on the subinstallations, the execution, and the deploy items of a
+	// suspended installation. It stops their reconciliation. Use the field spec.suspend of an installation instead of
+	// setting it manually.
+	SuspendedAnnotation = LandscaperDomain + "/suspended"
+
+	// ApproveAnnotation is the annotation that approves the pending change of an execution that requires approval.
+	// Its value has to be the digest of the pending change.
+	ApproveAnnotation = LandscaperDomain + "/approve"
+
+	// ConfirmDeletionAnnotation is the annotation that confirms a pending deletion of orphaned objects, which exceeds
+	// the deletion safeguard. Its value has to be the digest of the pending deletion.
+	ConfirmDeletionAnnotation = LandscaperDomain + "/confirm-deletion"
+
 	// DeployerTypeAnnotation is the annotation that specifies the type of the deployer.
 	DeployerTypeAnnotation = LandscaperDomain + "/deployer-type"
 
@@ -111,16 +128,40 @@ const (
 	ProgressingTimeoutReason = "ProgressingTimeout" // for error messages
 )
 
+// DeployItem concurrency constants
+const (
+	MaxParallelReachedReason            = "MaxParallelReached"            // for error messages
+	TargetConcurrencyLimitReachedReason = "TargetConcurrencyLimitReached" // for error messages
+)
+
+// Installation blocking reasons, used in field status.blockedBy of installations
+const (
+	PredecessorNotFinishedReason      = "PredecessorNotFinished"
+	SubinstallationNotFinishedReason  = "SubinstallationNotFinished"
+	SubinstallationFailedReason       = "SubinstallationFailed"
+	ExecutionWaitingForApprovalReason = "ExecutionWaitingForApproval"
+	DeployItemNotFinishedReason       = "DeployItemNotFinished"
+	DeployItemFailedReason            = "DeployItemFailed"
+	DeployItemWaitingForRetryReason   = "DeployItemWaitingForRetry"
+	DeployItemNotPickedUpReason       = "DeployItemNotPickedUp"
+	DeletionNotConfirmedReason        = "DeletionNotConfirmed"
+	TestsNotFinishedReason            = "TestsNotFinished"
+)
+
+// TestsFailedReason is the reason of the error of an installation whose tests have failed.
+const TestsFailedReason = "TestsFailed"
+
 // define common constants for phase names here, so all phases which use any of them
 // will use the same ones
 const (
-	PhaseStringInit            string = "Init"
-	PhaseStringCleanupOrphaned string = "CleanupOrphaned"
-	PhaseStringObjectsCreated  string = "ObjectsCreated"
-	PhaseStringProgressing     string = "Progressing"
-	PhaseStringCompleting      string = "Completing"
-	PhaseStringSucceeded       string = "Succeeded"
-	PhaseStringFailed          string = "Failed"
+	PhaseStringInit               string = "Init"
+	PhaseStringCleanupOrphaned    string = "CleanupOrphaned"
+	PhaseStringObjectsCreated     string = "ObjectsCreated"
+	PhaseStringProgressing        string = "Progressing"
+	PhaseStringCompleting         string = "Completing"
+	PhaseStringWaitingForApproval string = "WaitingForApproval"
+	PhaseStringSucceeded          string = "Succeeded"
+	PhaseStringFailed             string = "Failed"
 
 	PhaseStringInitDelete    string = "InitDelete"
 	PhaseStringTriggerDelete string = "TriggerDelete"
@@ -56,6 +56,13 @@ func GenerateDataObjectNameWithIndex(context string, name string, index int) str
 	return GenerateDataObjectName(context, fmt.Sprintf("%s[%d]", name, index))
 }
 
+// GenerateDataObjectNameWithAggregationKey generates a unique name for a data object which contributes
+// to an aggregated data object and is therefore identified by a combination of name and aggregation key.
+// It builds a fake name by combining name and key and then calls GenerateDataObjectName.
+func GenerateDataObjectNameWithAggregationKey(context string, name string, key string) string {
+	return GenerateDataObjectName(context, fmt.Sprintf("%s{%s}", name, key))
+}
+
 // DataObjectSourceFromObject returns the data object source for a runtime object.
 func DataObjectSourceFromObject(src runtime.Object) (string, error) {
 	acc, ok := src.(metav1.Object)
@@ -5,6 +5,8 @@
 package helper
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"reflect"
 	"time"
 
@@ -168,6 +170,19 @@ func ObjectReferenceFromObject(obj metav1.Object) v1alpha1.ObjectReference {
 	}
 }
 
+// NewBlockingObject creates the description of a landscaper object of the given kind which blocks an installation.
+func NewBlockingObject(kind string, obj metav1.Object, reason, message string) v1alpha1.BlockingObject {
+	return v1alpha1.BlockingObject{
+		Object: v1alpha1.TypedObjectReference{
+			APIVersion:      v1alpha1.SchemeGroupVersion.String(),
+			Kind:            kind,
+			ObjectReference: ObjectReferenceFromObject(obj),
+		},
+		Reason:  reason,
+		Message: message,
+	}
+}
+
 // CreateOrUpdateVersionedObjectReferences creates or updates a element in versioned objectReference slice.
 func CreateOrUpdateVersionedObjectReferences(refs []v1alpha1.VersionedObjectReference, ref v1alpha1.ObjectReference, gen int64) []v1alpha1.VersionedObjectReference {
 	for i, vref := range refs {
@@ -234,6 +249,29 @@ func HasIgnoreAnnotation(obj metav1.ObjectMeta) bool {
 	return ok && v == "true"
 }
 
+// HasSuspendedAnnotation returns true only if the given object
+// has the 'landscaper.gardener.cloud/suspended' annotation
+// and its value is 'true'.
+func HasSuspendedAnnotation(obj metav1.ObjectMeta) bool {
+	v, ok := obj.GetAnnotations()[v1alpha1.SuspendedAnnotation]
+	return ok && v == "true"
+}
+
+// SetSuspendedAnnotation adds or removes the 'landscaper.gardener.cloud/suspended' annotation.
+// It returns whether the object has been changed.
+func SetSuspendedAnnotation(obj *metav1.ObjectMeta, suspended bool) bool {
+	if HasSuspendedAnnotation(*obj) == suspended {
+		return false
+	}
+
+	if suspended {
+		metav1.SetMetaDataAnnotation(obj, v1alpha1.SuspendedAnnotation, "true")
+	} else {
+		delete(obj.Annotations, v1alpha1.SuspendedAnnotation)
+	}
+	return true
+}
+
 // HasDeleteWithoutUninstallAnnotation returns true only if the given object
 // has the 'landscaper.gardener.cloud/delete-without-uninstall' annotation
 // and its value is 'true'.
@@ -242,6 +280,14 @@ func HasDeleteWithoutUninstallAnnotation(obj metav1.ObjectMeta) bool {
 	return ok && v == "true"
 }
 
+// HasDeletionProtectionAnnotation returns true only if the given object
+// has the 'landscaper.gardener.cloud/deletion-protection' annotation
+// and its value is 'true'.
+func HasDeletionProtectionAnnotation(obj metav1.ObjectMeta) bool {
+	v, ok := obj.GetAnnotations()[v1alpha1.DeletionProtectionAnnotation]
+	return ok && v == "true"
+}
+
 func HasCacheHelmChartsAnnotation(obj *metav1.ObjectMeta) bool {
 	v, ok := obj.GetAnnotations()[v1alpha1.CacheHelmChartsAnnotation]
 	return ok && v == "true"
@@ -270,3 +316,23 @@ func SetDeployItemToFailed(di *v1alpha1.DeployItem) v1alpha1.DeployItemPhase {
 	}
 	return di.Status.Phase
 }
+
+// ResolvedTargetContentHash returns a hash of the resolved content of the given target.
+// An empty string is returned if no target is given.
+func ResolvedTargetContentHash(rt *v1alpha1.ResolvedTarget) string {
+	if rt == nil {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(rt.Content))
+	return hex.EncodeToString(hash[:])
+}
+
+// IsTargetContentChanged returns true if the deploy item has been reconciled with a different content of its target
+// than the given one and should therefore be reconciled again.
+// This is only the case for deploy items which have ReconcileOnTargetChange set.
+func IsTargetContentChanged(di *v1alpha1.DeployItem, rt *v1alpha1.ResolvedTarget) bool {
+	if !di.Spec.ReconcileOnTargetChange || rt == nil || len(di.Status.TargetContentHash) == 0 {
+		return false
+	}
+	return di.Status.TargetContentHash != ResolvedTargetContentHash(rt)
+}
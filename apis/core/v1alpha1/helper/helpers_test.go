@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+)
+
+var _ = Describe("Target content", func() {
+
+	newResolvedTarget := func(content string) *lsv1alpha1.ResolvedTarget {
+		return &lsv1alpha1.ResolvedTarget{
+			Target:  &lsv1alpha1.Target{},
+			Content: content,
+		}
+	}
+
+	It("should compute the same hash for the same content", func() {
+		Expect(helper.ResolvedTargetContentHash(newResolvedTarget("abc"))).To(Equal(helper.ResolvedTargetContentHash(newResolvedTarget("abc"))))
+		Expect(helper.ResolvedTargetContentHash(newResolvedTarget("abc"))).ToNot(Equal(helper.ResolvedTargetContentHash(newResolvedTarget("abd"))))
+		Expect(helper.ResolvedTargetContentHash(nil)).To(BeEmpty())
+	})
+
+	It("should detect a changed target content of deploy items that opted in", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Spec.ReconcileOnTargetChange = true
+		di.Status.TargetContentHash = helper.ResolvedTargetContentHash(newResolvedTarget("old"))
+
+		Expect(helper.IsTargetContentChanged(di, newResolvedTarget("old"))).To(BeFalse())
+		Expect(helper.IsTargetContentChanged(di, newResolvedTarget("new"))).To(BeTrue())
+		Expect(helper.IsTargetContentChanged(di, nil)).To(BeFalse())
+	})
+
+	It("should not detect a changed target content of deploy items that did not opt in", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Status.TargetContentHash = helper.ResolvedTargetContentHash(newResolvedTarget("old"))
+		Expect(helper.IsTargetContentChanged(di, newResolvedTarget("new"))).To(BeFalse())
+	})
+
+	It("should not detect a changed target content of deploy items that have not been reconciled yet", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Spec.ReconcileOnTargetChange = true
+		Expect(helper.IsTargetContentChanged(di, newResolvedTarget("new"))).To(BeFalse())
+	})
+})
+
+var _ = Describe("Suspended annotation", func() {
+
+	It("should add and remove the suspended annotation", func() {
+		obj := &metav1.ObjectMeta{}
+		Expect(helper.HasSuspendedAnnotation(*obj)).To(BeFalse())
+
+		Expect(helper.SetSuspendedAnnotation(obj, true)).To(BeTrue())
+		Expect(helper.HasSuspendedAnnotation(*obj)).To(BeTrue())
+		Expect(helper.SetSuspendedAnnotation(obj, true)).To(BeFalse())
+
+		Expect(helper.SetSuspendedAnnotation(obj, false)).To(BeTrue())
+		Expect(helper.HasSuspendedAnnotation(*obj)).To(BeFalse())
+		Expect(helper.SetSuspendedAnnotation(obj, false)).To(BeFalse())
+	})
+})
+
+var _ = Describe("Deletion protection annotation", func() {
+
+	It("should only accept the value true", func() {
+		obj := metav1.ObjectMeta{}
+		Expect(helper.HasDeletionProtectionAnnotation(obj)).To(BeFalse())
+
+		metav1.SetMetaDataAnnotation(&obj, lsv1alpha1.DeletionProtectionAnnotation, "false")
+		Expect(helper.HasDeletionProtectionAnnotation(obj)).To(BeFalse())
+
+		metav1.SetMetaDataAnnotation(&obj, lsv1alpha1.DeletionProtectionAnnotation, "true")
+		Expect(helper.HasDeletionProtectionAnnotation(obj)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Blocking object", func() {
+
+	It("should reference the blocking object", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = "test-di"
+		di.Namespace = "test-ns"
+
+		blockingObject := helper.NewBlockingObject("DeployItem", di, lsv1alpha1.DeployItemNotPickedUpReason, "not picked up")
+		Expect(blockingObject.Object.APIVersion).To(Equal("landscaper.gardener.cloud/v1alpha1"))
+		Expect(blockingObject.Object.Kind).To(Equal("DeployItem"))
+		Expect(blockingObject.Object.ObjectReference).To(Equal(lsv1alpha1.ObjectReference{Name: "test-di", Namespace: "test-ns"}))
+		Expect(blockingObject.Reason).To(Equal(lsv1alpha1.DeployItemNotPickedUpReason))
+		Expect(blockingObject.Message).To(Equal("not picked up"))
+	})
+})
@@ -61,6 +61,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TargetSyncList{},
 		&CriticalProblems{},
 		&CriticalProblemsList{},
+		&TargetTypeDefinition{},
+		&TargetTypeDefinitionList{},
+		&InstallationSet{},
+		&InstallationSetList{},
 	)
 	if err := RegisterConversions(scheme); err != nil {
 		return err
@@ -85,6 +85,17 @@ type Blueprint struct {
 	// +optional
 	DeployExecutions []TemplateExecutor `json:"deployExecutions,omitempty"`
 
+	// TestExecutions defines the templating executors for the test deploy items of the blueprint.
+	// The templates must return a list of deploy item templates. The test deploy items are executed after all other
+	// deploy items and all subinstallations have succeeded. The installation only succeeds if all tests succeed.
+	// +optional
+	TestExecutions []TemplateExecutor `json:"testExecutions,omitempty"`
+
+	// MaxParallelDeployItems is the maximum number of deploy items of the blueprint which are processed in parallel.
+	// If not set, all deploy items whose dependencies are satisfied are started at once.
+	// +optional
+	MaxParallelDeployItems *int32 `json:"maxParallelDeployItems,omitempty"`
+
 	// ExportExecutions defines the templating executors that are used to generate the exports.
 	// +optional
 	ExportExecutions []TemplateExecutor `json:"exportExecutions,omitempty"`
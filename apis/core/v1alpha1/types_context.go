@@ -70,6 +70,11 @@ type ContextConfiguration struct {
 	// VerificationSignatures maps a signature name to the trusted verification information
 	// +optional
 	VerificationSignatures map[string]VerificationSignature `json:"verificationSignatures,omitempty"`
+
+	// RequireApproval specifies that changes of the deploy items of the installations using this context have to be
+	// approved before they are applied. It can be overwritten by the installations.
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
 }
 
 // VerificationSignatures contains the trusted verification information
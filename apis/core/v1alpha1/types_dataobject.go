@@ -42,6 +42,9 @@ const DataObjectTargetMapKeyLabel = "data.landscaper.gardener.cloud/targetmapkey
 // DataObjectJobIDLabel defines the job ID under which a data object was created.
 const DataObjectJobIDLabel = "data.landscaper.gardener.cloud/jobid"
 
+// DataObjectAggregationKeyLabel defines the key under which a data object contributes to an aggregated data object.
+const DataObjectAggregationKeyLabel = "data.landscaper.gardener.cloud/aggregationkey"
+
 // DataObjectHashAnnotation defines the name of the annotation that specifies the hash of the data.
 const DataObjectHashAnnotation = "data.landscaper.gardener.cloud/hash"
 
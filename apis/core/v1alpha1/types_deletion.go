@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PendingDeletion describes the deletion of orphaned objects, which exceeds the deletion safeguard and therefore
+// waits for a confirmation.
+type PendingDeletion struct {
+	// Digest identifies the pending deletion. The deletion is confirmed by the annotation
+	// landscaper.gardener.cloud/confirm-deletion with the digest as value.
+	Digest string `json:"digest"`
+
+	// Objects lists the names of the orphaned objects which would be deleted.
+	// +optional
+	Objects []string `json:"objects,omitempty"`
+
+	// TotalObjects is the number of objects of the same kind, of which the orphaned objects would be deleted.
+	TotalObjects int32 `json:"totalObjects"`
+}
+
+// DeletionImpact describes the objects which would be deleted together with an installation.
+type DeletionImpact struct {
+	// ComputationTime is the time when the deletion impact was computed.
+	ComputationTime metav1.Time `json:"computationTime"`
+
+	// Installations lists the subinstallations in the subtree of the installation.
+	// +optional
+	Installations []ObjectReference `json:"installations,omitempty"`
+
+	// Executions lists the executions in the subtree of the installation.
+	// +optional
+	Executions []ObjectReference `json:"executions,omitempty"`
+
+	// DeployItems lists the deploy items in the subtree of the installation.
+	// +optional
+	DeployItems []ObjectReference `json:"deployItems,omitempty"`
+
+	// ManagedResources lists the resources in the target clusters which are deleted by the deployers.
+	// +optional
+	ManagedResources []ManagedResourceReference `json:"managedResources,omitempty"`
+
+	// TotalInstallations is the number of subinstallations in the subtree of the installation.
+	// +optional
+	TotalInstallations int32 `json:"totalInstallations,omitempty"`
+
+	// TotalExecutions is the number of executions in the subtree of the installation.
+	// +optional
+	TotalExecutions int32 `json:"totalExecutions,omitempty"`
+
+	// TotalDeployItems is the number of deploy items in the subtree of the installation.
+	// +optional
+	TotalDeployItems int32 `json:"totalDeployItems,omitempty"`
+
+	// TotalManagedResources is the number of resources in the target clusters which are deleted by the deployers.
+	// +optional
+	TotalManagedResources int32 `json:"totalManagedResources,omitempty"`
+
+	// Truncated is true if at least one of the lists is truncated, because it exceeds the maximum number of entries.
+	// The total numbers count all objects.
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ManagedResourceReference is the reference to a resource in a target cluster, which is managed by a deploy item.
+type ManagedResourceReference struct {
+	// DeployItem is the deploy item which manages the resource.
+	DeployItem ObjectReference `json:"deployItem"`
+
+	// APIVersion is the group and version of the resource.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the resource.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
@@ -50,6 +50,17 @@ const ValidateExportCondition ConditionType = "ValidateExport"
 // ComponentReferenceOverwriteCondition is the Conditions type to indicate that the component reference was overwritten.
 const ComponentReferenceOverwriteCondition ConditionType = "ComponentReferenceOverwrite"
 
+// SuspendedCondition is the Conditions type to indicate that the reconciliation of an installation or execution and
+// its subtree is suspended.
+const SuspendedCondition ConditionType = "Suspended"
+
+// Reasons of the Suspended condition.
+const (
+	SuspendedReason         = "Suspended"
+	SuspendedByParentReason = "SuspendedByParent"
+	ResumedReason           = "Resumed"
+)
+
 type InstallationPhase string
 
 func (p InstallationPhase) String() string {
@@ -193,6 +204,48 @@ type InstallationSpec struct {
 	// Optimization contains settings to improve execution performance.
 	// +optional
 	Optimization *Optimization `json:"optimization,omitempty"`
+
+	// ReconcileOnTargetChange specifies that all deploy items of the installation are reconciled again when the
+	// resolved content of their target changes, e.g. because the secret referenced by the target has been rotated.
+	// +optional
+	ReconcileOnTargetChange bool `json:"reconcileOnTargetChange,omitempty"`
+
+	// Rollout configures the progressive rollout of the deploy items and subinstallations of the installation
+	// which deploy to an element of a targetList or targetMap import.
+	// If not set, all of them are updated at once.
+	// +optional
+	Rollout *RolloutPolicy `json:"rollout,omitempty"`
+
+	// RequireApproval specifies that changes of the deploy items of the installation have to be approved before they
+	// are applied. If not set, the setting of the context of the installation is used.
+	// +optional
+	RequireApproval *bool `json:"requireApproval,omitempty"`
+
+	// Suspend pauses the reconciliation of the installation and of its subtree, i.e. its subinstallations, its
+	// execution and its deploy items. When the installation is resumed, the reconciliation continues in the current
+	// phase.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// DependsOn lists the names of sibling installations which have to be finished successfully before this
+	// installation is reconciled, additionally to the dependencies defined by the imports.
+	// Root installations refer to the names of other root installations in the same namespace,
+	// subinstallations to the names of the installation templates in the blueprint of their parent.
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// RetryPolicy configures automatic retries of the installation when it has failed, depending on the error codes
+	// of the failure. It must not be combined with automaticReconcile.failedReconcile.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Timeout is the maximal duration of a reconciliation of the installation including its whole subtree, measured
+	// from the start of the job. When it is exceeded, the installation fails with error code ERR_TIMEOUT, and the
+	// running deploy items of the subtree are interrupted.
+	// Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout).
+	// There is no timeout if not specified.
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
 }
 
 // Verification defines the necessary data to verify the signature of the refered component
@@ -261,6 +314,10 @@ type InstallationStatus struct {
 	// ExecutionReference is the reference to the execution that schedules the templated execution items.
 	ExecutionReference *ObjectReference `json:"executionRef,omitempty"`
 
+	// TestExecutionReference is the reference to the execution of the test deploy items of the blueprint.
+	// +optional
+	TestExecutionReference *ObjectReference `json:"testExecutionRef,omitempty"`
+
 	// JobID is the ID of the current working request.
 	JobID string `json:"jobID,omitempty"`
 
@@ -288,6 +345,129 @@ type InstallationStatus struct {
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// Rollout describes the progress of the rollout of the subinstallations.
+	// It is only maintained if the installation has a rollout policy.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+
+	// Retry describes the retries of the failed installation according to its retry policy.
+	// +optional
+	Retry *RetryStatus `json:"retry,omitempty"`
+
+	// BlockedBy lists the objects for which the installation is waiting.
+	// It is only set while the installation is in phase Init or Progressing.
+	// +optional
+	BlockedBy []BlockingObject `json:"blockedBy,omitempty"`
+
+	// Summary aggregates the status of the subinstallations, executions and deploy items in the subtree of the
+	// installation. For a root installation, it covers the whole tree.
+	// +optional
+	Summary *SubtreeSummary `json:"summary,omitempty"`
+
+	// PendingDeletion describes the deletion of orphaned subinstallations, which waits for a confirmation because
+	// it exceeds the deletion safeguard.
+	// +optional
+	PendingDeletion *PendingDeletion `json:"pendingDeletion,omitempty"`
+
+	// DeletionImpact describes the objects which would be deleted together with the installation.
+	// It is computed on request by the operation annotation deletion-impact.
+	// +optional
+	DeletionImpact *DeletionImpact `json:"deletionImpact,omitempty"`
+
+	// TimedOut describes the exceeded timeout, if the current job of the installation has failed because of its
+	// timeout.
+	// +optional
+	TimedOut *TimeoutDetails `json:"timedOut,omitempty"`
+
+	// Tests describes the latest run of the tests defined by the testExecutions of the blueprint.
+	// +optional
+	Tests *TestStatus `json:"tests,omitempty"`
+}
+
+// TimeoutDetails describes the exceeded timeout of an installation.
+type TimeoutDetails struct {
+	// Deadline is the time when the timeout was exceeded.
+	Deadline metav1.Time `json:"deadline"`
+
+	// SlowestChild is the subinstallation or execution, which had been incomplete for the longest time when the
+	// timeout was exceeded.
+	// +optional
+	SlowestChild *IncompleteChild `json:"slowestChild,omitempty"`
+}
+
+// IncompleteChild describes a subinstallation or execution, which has not finished the current job.
+type IncompleteChild struct {
+	// Object is the reference to the subinstallation or execution.
+	Object TypedObjectReference `json:"object"`
+
+	// Phase is the phase of the subinstallation or execution.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// StartTime is the time when the subinstallation or execution has started the current job.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// BlockingObject describes an object for which an installation is waiting, together with the reason.
+type BlockingObject struct {
+	// Object is the reference to the blocking object.
+	Object TypedObjectReference `json:"object"`
+
+	// Reason is a machine-readable reason why the object blocks the installation.
+	Reason string `json:"reason"`
+
+	// Message is a human-readable description why the object blocks the installation.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Imports lists the imports of the installation which are exported by the blocking object.
+	// +optional
+	Imports []string `json:"imports,omitempty"`
+}
+
+// SubtreeSummary aggregates the status of the objects in the subtree of an installation.
+type SubtreeSummary struct {
+	// Installations counts the subinstallations in the subtree by phase.
+	// +optional
+	Installations map[string]int32 `json:"installations,omitempty"`
+
+	// Executions counts the executions in the subtree by phase.
+	// +optional
+	Executions map[string]int32 `json:"executions,omitempty"`
+
+	// DeployItems counts the deploy items in the subtree by phase.
+	// +optional
+	DeployItems map[string]int32 `json:"deployItems,omitempty"`
+
+	// FailedObjects lists the failed objects in the subtree whose failure is not caused by failed children,
+	// i.e. the objects where the failures originate.
+	// +optional
+	FailedObjects []FailedObject `json:"failedObjects,omitempty"`
+
+	// FinishedObjects is the number of objects in the subtree which have finished the current job.
+	FinishedObjects int32 `json:"finishedObjects"`
+
+	// TotalObjects is the number of objects in the subtree.
+	TotalObjects int32 `json:"totalObjects"`
+
+	// Progress is the percentage of the objects in the subtree which have finished the current job.
+	Progress int32 `json:"progress"`
+}
+
+// FailedObject describes a failed object in the subtree of an installation.
+type FailedObject struct {
+	// Object is the reference to the failed object.
+	Object TypedObjectReference `json:"object"`
+
+	// Reason is the reason of the last error of the object.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Codes are the error codes of the last error of the object.
+	// +optional
+	Codes []ErrorCode `json:"codes,omitempty"`
 }
 
 type DependentToTrigger struct {
@@ -358,6 +538,30 @@ type DataImport struct {
 	// This method is not allowed in installation templates.
 	// +optional
 	ConfigMapRef *LocalConfigMapReference `json:"configMapRef,omitempty"`
+
+	// Aggregated defines that the imported data is merged from the contributions of all installations
+	// that export the referenced data object as aggregated export.
+	// Can only be used in combination with DataRef.
+	// +optional
+	Aggregated *DataImportAggregation `json:"aggregated,omitempty"`
+}
+
+// AggregationFormat defines how the contributions to an aggregated data object are merged.
+type AggregationFormat string
+
+const (
+	// AggregationFormatMap merges all contributions into a map from the contribution key to the contributed value.
+	AggregationFormatMap AggregationFormat = "map"
+	// AggregationFormatList merges all contributions into a list of values which is sorted by the contribution key.
+	AggregationFormatList AggregationFormat = "list"
+)
+
+// DataImportAggregation configures the import of an aggregated data object.
+type DataImportAggregation struct {
+	// Format defines whether the contributions are merged into a map or a list.
+	// Defaults to "map".
+	// +optional
+	Format AggregationFormat `json:"format,omitempty"`
 }
 
 // DataExport is a data object export.
@@ -367,6 +571,19 @@ type DataExport struct {
 
 	// DataRef is the name of the in-cluster data object.
 	DataRef string `json:"dataRef"`
+
+	// Aggregate defines that the exported data is a keyed contribution to an aggregated data object.
+	// Multiple installations are allowed to export the same data object if all of them use an aggregated export.
+	// +optional
+	Aggregate *DataExportAggregation `json:"aggregate,omitempty"`
+}
+
+// DataExportAggregation configures the contribution of an export to an aggregated data object.
+type DataExportAggregation struct {
+	// Key is the key under which the exported data is contributed.
+	// Defaults to the name of the exporting installation, or the name of the installation template for subinstallations.
+	// +optional
+	Key string `json:"key,omitempty"`
 }
 
 // TargetImport is either a single target or a target list import.
@@ -533,8 +750,22 @@ func (ti TargetImport) MarshalJSON() ([]byte, error) {
 	return json.Marshal(TargetImportWithTargets(ti))
 }
 
-// isSuccessor determines whether the given sibling imports any DataObject or Target that the given inst exports.
+// GetSiblingName returns the name by which sibling installations refer to the installation in their dependsOn list.
+// This is the name of the installation template for subinstallations and the installation name otherwise.
+func (inst *Installation) GetSiblingName() string {
+	if name, ok := inst.GetAnnotations()[SubinstallationNameAnnotation]; ok && len(name) != 0 {
+		return name
+	}
+	return inst.GetName()
+}
+
+// isSuccessor determines whether the given sibling imports any DataObject or Target that the given inst exports,
+// or whether the given sibling explicitly depends on the given inst.
 func (inst *Installation) IsSuccessor(sibling *Installation) bool {
+	if slices.Contains(sibling.Spec.DependsOn, inst.GetSiblingName()) {
+		return true
+	}
+
 	for _, dataExport := range inst.Spec.Exports.Data {
 		if sibling.IsImportingData(dataExport.DataRef) {
 			return true
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InstallationSetLabel is the label of the installations generated by an InstallationSet.
+// It contains the name of the InstallationSet.
+const InstallationSetLabel = LandscaperDomain + "/installation-set"
+
+// InstallationSetElementAnnotation is the annotation of the installations generated by an InstallationSet.
+// It contains the name of the generated element the installation belongs to.
+const InstallationSetElementAnnotation = LandscaperDomain + "/installation-set-element"
+
+// DefaultInstallationSetNameTemplate is the default template for the names of the generated installations.
+const DefaultInstallationSetNameTemplate = "{{ .setName }}-{{ .elementName }}"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InstallationSetList contains a list of InstallationSets
+type InstallationSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstallationSet `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=instset
+// +kubebuilder:printcolumn:name="Installations",type=integer,JSONPath=`.status.installationCount`
+// +kubebuilder:printcolumn:name="Progressing",type=integer,JSONPath=`.status.progressing`
+// +kubebuilder:printcolumn:name="Pending",type=integer,JSONPath=`.status.pending`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+
+// InstallationSet generates one root installation from a template for every element of a generator.
+type InstallationSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification of the installation set.
+	Spec InstallationSetSpec `json:"spec"`
+
+	// Status contains the status of the installation set.
+	// +optional
+	Status InstallationSetStatus `json:"status"`
+}
+
+// InstallationSetSpec contains the specification of an InstallationSet.
+type InstallationSetSpec struct {
+	// Template is the template of the generated installations.
+	Template InstallationSetTemplate `json:"template"`
+
+	// Generator defines the elements for which installations are generated.
+	Generator InstallationSetGenerator `json:"generator"`
+
+	// NameTemplate is a go template which computes the name of the installation of an element.
+	// The template gets the values "setName", "elementName" and "targetName".
+	// Defaults to "{{ .setName }}-{{ .elementName }}".
+	// +optional
+	NameTemplate string `json:"nameTemplate,omitempty"`
+
+	// Overrides replace imports of the template for particular elements.
+	// +optional
+	Overrides []InstallationSetElementOverride `json:"overrides,omitempty"`
+
+	// MaxProgressing is the maximal number of generated installations which are progressing at the same time.
+	// Installations of further elements are created or updated when progressing installations have finished.
+	// If not set, all installations are created or updated at once.
+	// +optional
+	MaxProgressing *int32 `json:"maxProgressing,omitempty"`
+}
+
+// InstallationSetTemplate is the template of the installations of an InstallationSet.
+type InstallationSetTemplate struct {
+	// Labels are added to the generated installations.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are added to the generated installations.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Spec is the specification of the generated installations.
+	Spec InstallationSpec `json:"spec"`
+}
+
+// InstallationSetGenerator defines the elements of an InstallationSet.
+// Exactly one of Targets, TargetMap and Parameters has to be specified.
+type InstallationSetGenerator struct {
+	// Targets generates an element for every target in the namespace of the InstallationSet that matches a label selector.
+	// The element has the name of the target.
+	// +optional
+	Targets *InstallationSetTargetsGenerator `json:"targets,omitempty"`
+
+	// TargetMap generates an element for every entry of the map. The element has the name of the key,
+	// the value is the name of the target.
+	// +optional
+	TargetMap map[string]string `json:"targetMap,omitempty"`
+
+	// TargetImport is the name of the target import of the generated installations,
+	// which is set to the target of an element. Required for Targets and TargetMap.
+	// +optional
+	TargetImport string `json:"targetImport,omitempty"`
+
+	// Parameters generates an element for every parameter set.
+	// +optional
+	Parameters []InstallationSetParameterSet `json:"parameters,omitempty"`
+}
+
+// InstallationSetTargetsGenerator selects the targets for which installations are generated.
+type InstallationSetTargetsGenerator struct {
+	// Selector selects the targets by their labels.
+	Selector metav1.LabelSelector `json:"selector"`
+}
+
+// InstallationSetParameterSet is an element of an InstallationSet defined by parameters.
+type InstallationSetParameterSet struct {
+	// Name is the name of the element.
+	Name string `json:"name"`
+
+	// ImportDataMappings are added to the import data mappings of the generated installation.
+	// +optional
+	ImportDataMappings map[string]AnyJSON `json:"importDataMappings,omitempty"`
+}
+
+// InstallationSetElementOverride replaces imports of the template for a particular element.
+type InstallationSetElementOverride struct {
+	// Element is the name of the element.
+	Element string `json:"element"`
+
+	// Imports replace the imports of the template with the same name or are added to them.
+	// +optional
+	Imports InstallationImports `json:"imports,omitempty"`
+
+	// ImportDataMappings replace the import data mappings of the template with the same name or are added to them.
+	// +optional
+	ImportDataMappings map[string]AnyJSON `json:"importDataMappings,omitempty"`
+}
+
+// InstallationSetStatus contains the status of an InstallationSet.
+type InstallationSetStatus struct {
+	// ObservedGeneration is the most recent generation observed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastError describes the last error of the installation set.
+	// +optional
+	LastError *Error `json:"lastError,omitempty"`
+
+	// InstallationCount is the number of generated installations.
+	// +optional
+	InstallationCount int32 `json:"installationCount,omitempty"`
+
+	// Progressing is the number of generated installations which are currently progressing.
+	// +optional
+	Progressing int32 `json:"progressing,omitempty"`
+
+	// Pending is the number of elements whose installation waits to be created, updated or deleted,
+	// because MaxProgressing installations are already progressing.
+	// +optional
+	Pending int32 `json:"pending,omitempty"`
+
+	// Installations contains the status of the generated installations.
+	// +optional
+	Installations []InstallationSetInstallationStatus `json:"installations,omitempty"`
+}
+
+// InstallationSetInstallationStatus contains the status of an installation generated by an InstallationSet.
+type InstallationSetInstallationStatus struct {
+	// Element is the name of the element.
+	Element string `json:"element"`
+
+	// Name is the name of the installation.
+	Name string `json:"name"`
+
+	// Phase is the phase of the installation.
+	// +optional
+	Phase InstallationPhase `json:"phase,omitempty"`
+}
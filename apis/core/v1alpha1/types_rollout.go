@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RolloutPolicy configures the progressive rollout of the deploy items and subinstallations of an installation
+// which deploy to an element of a targetList or targetMap import.
+// The elements are released in waves. A wave is only released when the objects of the previous waves have finished.
+type RolloutPolicy struct {
+	// BatchSize is the number of elements which are released in one wave.
+	// +optional
+	BatchSize *int32 `json:"batchSize,omitempty"`
+
+	// BatchPercentage is the percentage of all elements which are released in one wave.
+	// The resulting number of elements is rounded up. Only one of BatchSize and BatchPercentage may be set.
+	// If none of them is set, all elements are released in one wave.
+	// +optional
+	BatchPercentage *int32 `json:"batchPercentage,omitempty"`
+
+	// PauseBetweenWaves is the time to wait after a wave has finished before the next wave is released.
+	// +optional
+	PauseBetweenWaves *Duration `json:"pauseBetweenWaves,omitempty"`
+
+	// StopOnFailure specifies that no further waves are released when an object of a released wave has failed.
+	// Defaults to true.
+	// +optional
+	StopOnFailure *bool `json:"stopOnFailure,omitempty"`
+}
+
+// RolloutStatus describes the progress of a rollout.
+type RolloutStatus struct {
+	// JobID is the ID of the job the rollout belongs to.
+	JobID string `json:"jobID,omitempty"`
+
+	// CurrentWave is the number of the latest released wave, starting with 1.
+	CurrentWave int32 `json:"currentWave"`
+
+	// Waves is the total number of waves.
+	Waves int32 `json:"waves"`
+
+	// NextWaveTime is the time when the next wave is released, if the rollout is paused between two waves.
+	// +optional
+	NextWaveTime *metav1.Time `json:"nextWaveTime,omitempty"`
+}
@@ -172,6 +172,10 @@ const (
 	ErrorForInfoOnly ErrorCode = "ERR_FOR_INFO_ONLY"
 	// ErrorNoRetry indicates that no retry is required.
 	ErrorNoRetry ErrorCode = "ERR_NO_RETRY"
+	// ErrorOwnershipConflict indicates that an object in the target cluster is owned by another deploy item.
+	ErrorOwnershipConflict ErrorCode = "ERR_OWNERSHIP_CONFLICT"
+	// ErrorHelmTestFailed indicates that a test of a helm chart has failed.
+	ErrorHelmTestFailed ErrorCode = "ERR_HELM_TEST_FAILED"
 )
 
 // UnrecoverableErrorCodes defines unrecoverable error codes
@@ -234,6 +238,14 @@ const (
 	// deployer could do some cleanup.
 	InterruptOperation Operation = "interrupt"
 
+	// DeletionImpactOperation is the annotation to let the landscaper compute the objects which would be deleted
+	// together with an installation. The result is written to the field status.deletionImpact of the installation.
+	DeletionImpactOperation Operation = "deletion-impact"
+
+	// RunTestsOperation is the annotation to let the landscaper run the tests of a succeeded installation again,
+	// without reconciling the installation. The results are written to the field status.tests of the installation.
+	RunTestsOperation Operation = "run-tests"
+
 	// TestReconcileOperation is only used for test purposes. If set at a DeployItem, it triggers a reconciliation
 	// of that DeployItem. It must not be used in a productive scenario.
 	TestReconcileOperation Operation = "test-reconcile"
@@ -11,6 +11,13 @@ import (
 // TargetType defines the type of the target.
 type TargetType string
 
+// TargetReachableCondition is the Conditions type to indicate whether the cluster of a target could be reached by the last probe.
+const TargetReachableCondition ConditionType = "Reachable"
+
+// TargetCredentialsValidCondition is the Conditions type to indicate whether the credentials of a target are valid
+// and not about to expire.
+const TargetCredentialsValidCondition ConditionType = "CredentialsValid"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // TargetList contains a list of Targets
@@ -22,11 +29,13 @@ type TargetList struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:shortName=tgt;tg
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
 // +kubebuilder:printcolumn:name="Context",type=string,JSONPath=`.metadata.labels['data\.landscaper\.gardener\.cloud\/context']`
 // +kubebuilder:printcolumn:name="Key",type=string,JSONPath=`.metadata.labels['data\.landscaper\.gardener\.cloud\/key']`
 // +kubebuilder:printcolumn:name="Idx",type=string,JSONPath=`.metadata.labels['data\.landscaper\.gardener\.cloud\/index']`
 // +kubebuilder:printcolumn:name="TMKey",type=string,JSONPath=`.metadata.labels['data\.landscaper\.gardener\.cloud\/targetmapkey']`
+// +kubebuilder:printcolumn:name="Reachable",type=string,JSONPath=`.status.conditions[?(@.type=='Reachable')].status`
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Target defines a specific data object that defines target environment.
@@ -36,6 +45,10 @@ type Target struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Spec TargetSpec `json:"spec"`
+
+	// Status contains the observed state of the target.
+	// +optional
+	Status TargetStatus `json:"status,omitzero"`
 }
 
 // TargetSpec contains the definition of a target.
@@ -57,6 +70,31 @@ type TargetSpec struct {
 	SecretRef *LocalSecretReference `json:"secretRef,omitempty"`
 }
 
+// TargetStatus contains the observed state of a target.
+// The status is only maintained for targets of type kubernetes-cluster which are periodically probed by the landscaper.
+type TargetStatus struct {
+	// ObservedGeneration is the most recent generation of the target that has been probed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions contains the last observed conditions of the target.
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// ServerVersion is the version of the api server of the target cluster observed by the last successful probe.
+	// +optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// CredentialsExpirationTime is the time when the client certificate or token of the target's kubeconfig expires.
+	// It is not set if the credentials do not expire or their expiration time cannot be determined.
+	// +optional
+	CredentialsExpirationTime *metav1.Time `json:"credentialsExpirationTime,omitempty"`
+
+	// LastProbeTime is the time when the target was probed the last time.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+}
+
 // TargetTemplate exposes specific parts of a target that are used in the exports
 // to export a target
 type TargetTemplate struct {
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestStatus describes the latest run of the tests of an installation, i.e. of the deploy items which are defined by
+// the testExecutions of its blueprint.
+type TestStatus struct {
+	// JobID is the ID of the job of the test execution in which the tests have run.
+	JobID string `json:"jobID"`
+
+	// Phase is the phase of the test execution.
+	// +optional
+	Phase ExecutionPhase `json:"phase,omitempty"`
+
+	// StartTime is the time when the tests have been started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time when the tests have finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Results lists the results of the single test deploy items.
+	// +optional
+	Results []TestResult `json:"results,omitempty"`
+}
+
+// TestResult describes the result of a test deploy item.
+type TestResult struct {
+	// Name is the name of the test deploy item as defined by the testExecutions of the blueprint.
+	Name string `json:"name"`
+
+	// DeployItem is the reference to the test deploy item.
+	// +optional
+	DeployItem *ObjectReference `json:"deployItem,omitempty"`
+
+	// Phase is the phase of the test deploy item.
+	// +optional
+	Phase DeployItemPhase `json:"phase,omitempty"`
+
+	// Message describes the error of a failed test.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
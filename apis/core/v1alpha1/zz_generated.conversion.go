@@ -60,6 +60,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BlockingObject)(nil), (*core.BlockingObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BlockingObject_To_core_BlockingObject(a.(*BlockingObject), b.(*core.BlockingObject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BlockingObject)(nil), (*BlockingObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BlockingObject_To_v1alpha1_BlockingObject(a.(*core.BlockingObject), b.(*BlockingObject), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Blueprint)(nil), (*core.Blueprint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Blueprint_To_core_Blueprint(a.(*Blueprint), b.(*core.Blueprint), scope)
 	}); err != nil {
@@ -270,6 +280,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataExportAggregation)(nil), (*core.DataExportAggregation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataExportAggregation_To_core_DataExportAggregation(a.(*DataExportAggregation), b.(*core.DataExportAggregation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DataExportAggregation)(nil), (*DataExportAggregation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DataExportAggregation_To_v1alpha1_DataExportAggregation(a.(*core.DataExportAggregation), b.(*DataExportAggregation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataImport)(nil), (*core.DataImport)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DataImport_To_core_DataImport(a.(*DataImport), b.(*core.DataImport), scope)
 	}); err != nil {
@@ -280,6 +300,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataImportAggregation)(nil), (*core.DataImportAggregation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataImportAggregation_To_core_DataImportAggregation(a.(*DataImportAggregation), b.(*core.DataImportAggregation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DataImportAggregation)(nil), (*DataImportAggregation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DataImportAggregation_To_v1alpha1_DataImportAggregation(a.(*core.DataImportAggregation), b.(*DataImportAggregation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataObject)(nil), (*core.DataObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DataObject_To_core_DataObject(a.(*DataObject), b.(*core.DataObject), scope)
 	}); err != nil {
@@ -310,6 +340,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeletionImpact)(nil), (*core.DeletionImpact)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeletionImpact_To_core_DeletionImpact(a.(*DeletionImpact), b.(*core.DeletionImpact), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeletionImpact)(nil), (*DeletionImpact)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeletionImpact_To_v1alpha1_DeletionImpact(a.(*core.DeletionImpact), b.(*DeletionImpact), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DependentToTrigger)(nil), (*core.DependentToTrigger)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DependentToTrigger_To_core_DependentToTrigger(a.(*DependentToTrigger), b.(*core.DependentToTrigger), scope)
 	}); err != nil {
@@ -340,6 +380,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployItemForEach)(nil), (*core.DeployItemForEach)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployItemForEach_To_core_DeployItemForEach(a.(*DeployItemForEach), b.(*core.DeployItemForEach), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployItemForEach)(nil), (*DeployItemForEach)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployItemForEach_To_v1alpha1_DeployItemForEach(a.(*core.DeployItemForEach), b.(*DeployItemForEach), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployItemList)(nil), (*core.DeployItemList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployItemList_To_core_DeployItemList(a.(*DeployItemList), b.(*core.DeployItemList), scope)
 	}); err != nil {
@@ -460,6 +510,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FailedObject)(nil), (*core.FailedObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FailedObject_To_core_FailedObject(a.(*FailedObject), b.(*core.FailedObject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.FailedObject)(nil), (*FailedObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_FailedObject_To_v1alpha1_FailedObject(a.(*core.FailedObject), b.(*FailedObject), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*FailedReconcile)(nil), (*core.FailedReconcile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_FailedReconcile_To_core_FailedReconcile(a.(*FailedReconcile), b.(*core.FailedReconcile), scope)
 	}); err != nil {
@@ -490,6 +550,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IncompleteChild)(nil), (*core.IncompleteChild)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IncompleteChild_To_core_IncompleteChild(a.(*IncompleteChild), b.(*core.IncompleteChild), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.IncompleteChild)(nil), (*IncompleteChild)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_IncompleteChild_To_v1alpha1_IncompleteChild(a.(*core.IncompleteChild), b.(*IncompleteChild), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InlineBlueprint)(nil), (*core.InlineBlueprint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InlineBlueprint_To_core_InlineBlueprint(a.(*InlineBlueprint), b.(*core.InlineBlueprint), scope)
 	}); err != nil {
@@ -540,6 +610,106 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstallationSet)(nil), (*core.InstallationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationSet_To_core_InstallationSet(a.(*InstallationSet), b.(*core.InstallationSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationSet)(nil), (*InstallationSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationSet_To_v1alpha1_InstallationSet(a.(*core.InstallationSet), b.(*InstallationSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationSetElementOverride)(nil), (*core.InstallationSetElementOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationSetElementOverride_To_core_InstallationSetElementOverride(a.(*InstallationSetElementOverride), b.(*core.InstallationSetElementOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationSetElementOverride)(nil), (*InstallationSetElementOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationSetElementOverride_To_v1alpha1_InstallationSetElementOverride(a.(*core.InstallationSetElementOverride), b.(*InstallationSetElementOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationSetGenerator)(nil), (*core.InstallationSetGenerator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationSetGenerator_To_core_InstallationSetGenerator(a.(*InstallationSetGenerator), b.(*core.InstallationSetGenerator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationSetGenerator)(nil), (*InstallationSetGenerator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationSetGenerator_To_v1alpha1_InstallationSetGenerator(a.(*core.InstallationSetGenerator), b.(*InstallationSetGenerator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationSetInstallationStatus)(nil), (*core.InstallationSetInstallationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationSetInstallationStatus_To_core_InstallationSetInstallationStatus(a.(*InstallationSetInstallationStatus), b.(*core.InstallationSetInstallationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationSetInstallationStatus)(nil), (*InstallationSetInstallationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationSetInstallationStatus_To_v1alpha1_InstallationSetInstallationStatus(a.(*core.InstallationSetInstallationStatus), b.(*InstallationSetInstallationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationSetList)(nil), (*core.InstallationSetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationSetList_To_core_InstallationSetList(a.(*InstallationSetList), b.(*core.InstallationSetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationSetList)(nil), (*InstallationSetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationSetList_To_v1alpha1_InstallationSetList(a.(*core.InstallationSetList), b.(*InstallationSetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationSetParameterSet)(nil), (*core.InstallationSetParameterSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationSetParameterSet_To_core_InstallationSetParameterSet(a.(*InstallationSetParameterSet), b.(*core.InstallationSetParameterSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationSetParameterSet)(nil), (*InstallationSetParameterSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationSetParameterSet_To_v1alpha1_InstallationSetParameterSet(a.(*core.InstallationSetParameterSet), b.(*InstallationSetParameterSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationSetSpec)(nil), (*core.InstallationSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationSetSpec_To_core_InstallationSetSpec(a.(*InstallationSetSpec), b.(*core.InstallationSetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationSetSpec)(nil), (*InstallationSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationSetSpec_To_v1alpha1_InstallationSetSpec(a.(*core.InstallationSetSpec), b.(*InstallationSetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationSetStatus)(nil), (*core.InstallationSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationSetStatus_To_core_InstallationSetStatus(a.(*InstallationSetStatus), b.(*core.InstallationSetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationSetStatus)(nil), (*InstallationSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationSetStatus_To_v1alpha1_InstallationSetStatus(a.(*core.InstallationSetStatus), b.(*InstallationSetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationSetTargetsGenerator)(nil), (*core.InstallationSetTargetsGenerator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationSetTargetsGenerator_To_core_InstallationSetTargetsGenerator(a.(*InstallationSetTargetsGenerator), b.(*core.InstallationSetTargetsGenerator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationSetTargetsGenerator)(nil), (*InstallationSetTargetsGenerator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationSetTargetsGenerator_To_v1alpha1_InstallationSetTargetsGenerator(a.(*core.InstallationSetTargetsGenerator), b.(*InstallationSetTargetsGenerator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationSetTemplate)(nil), (*core.InstallationSetTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationSetTemplate_To_core_InstallationSetTemplate(a.(*InstallationSetTemplate), b.(*core.InstallationSetTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationSetTemplate)(nil), (*InstallationSetTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationSetTemplate_To_v1alpha1_InstallationSetTemplate(a.(*core.InstallationSetTemplate), b.(*InstallationSetTemplate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationSpec)(nil), (*core.InstallationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(a.(*InstallationSpec), b.(*core.InstallationSpec), scope)
 	}); err != nil {
@@ -630,6 +800,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedResourceReference)(nil), (*core.ManagedResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedResourceReference_To_core_ManagedResourceReference(a.(*ManagedResourceReference), b.(*core.ManagedResourceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ManagedResourceReference)(nil), (*ManagedResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ManagedResourceReference_To_v1alpha1_ManagedResourceReference(a.(*core.ManagedResourceReference), b.(*ManagedResourceReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamedObjectReference)(nil), (*core.NamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(a.(*NamedObjectReference), b.(*core.NamedObjectReference), scope)
 	}); err != nil {
@@ -670,6 +850,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PendingApproval)(nil), (*core.PendingApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PendingApproval_To_core_PendingApproval(a.(*PendingApproval), b.(*core.PendingApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PendingApproval)(nil), (*PendingApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PendingApproval_To_v1alpha1_PendingApproval(a.(*core.PendingApproval), b.(*PendingApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PendingDeletion)(nil), (*core.PendingDeletion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PendingDeletion_To_core_PendingDeletion(a.(*PendingDeletion), b.(*core.PendingDeletion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PendingDeletion)(nil), (*PendingDeletion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PendingDeletion_To_v1alpha1_PendingDeletion(a.(*core.PendingDeletion), b.(*PendingDeletion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteBlueprintReference)(nil), (*core.RemoteBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(a.(*RemoteBlueprintReference), b.(*core.RemoteBlueprintReference), scope)
 	}); err != nil {
@@ -710,6 +910,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RetryBackoff)(nil), (*core.RetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RetryBackoff_To_core_RetryBackoff(a.(*RetryBackoff), b.(*core.RetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RetryBackoff)(nil), (*RetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RetryBackoff_To_v1alpha1_RetryBackoff(a.(*core.RetryBackoff), b.(*RetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RetryPolicy)(nil), (*core.RetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RetryPolicy_To_core_RetryPolicy(a.(*RetryPolicy), b.(*core.RetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RetryPolicy)(nil), (*RetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RetryPolicy_To_v1alpha1_RetryPolicy(a.(*core.RetryPolicy), b.(*RetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RetryStatus)(nil), (*core.RetryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RetryStatus_To_core_RetryStatus(a.(*RetryStatus), b.(*core.RetryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RetryStatus)(nil), (*RetryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RetryStatus_To_v1alpha1_RetryStatus(a.(*core.RetryStatus), b.(*RetryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutPolicy)(nil), (*core.RolloutPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutPolicy_To_core_RolloutPolicy(a.(*RolloutPolicy), b.(*core.RolloutPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutPolicy)(nil), (*RolloutPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutPolicy_To_v1alpha1_RolloutPolicy(a.(*core.RolloutPolicy), b.(*RolloutPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStatus)(nil), (*core.RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(a.(*RolloutStatus), b.(*core.RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutStatus)(nil), (*RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(a.(*core.RolloutStatus), b.(*RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretLabelSelectorRef)(nil), (*core.SecretLabelSelectorRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(a.(*SecretLabelSelectorRef), b.(*core.SecretLabelSelectorRef), scope)
 	}); err != nil {
@@ -780,6 +1030,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SubtreeSummary)(nil), (*core.SubtreeSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SubtreeSummary_To_core_SubtreeSummary(a.(*SubtreeSummary), b.(*core.SubtreeSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SubtreeSummary)(nil), (*SubtreeSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SubtreeSummary_To_v1alpha1_SubtreeSummary(a.(*core.SubtreeSummary), b.(*SubtreeSummary), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SucceededReconcile)(nil), (*core.SucceededReconcile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SucceededReconcile_To_core_SucceededReconcile(a.(*SucceededReconcile), b.(*core.SucceededReconcile), scope)
 	}); err != nil {
@@ -890,6 +1150,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetStatus)(nil), (*core.TargetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetStatus_To_core_TargetStatus(a.(*TargetStatus), b.(*core.TargetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetStatus)(nil), (*TargetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetStatus_To_v1alpha1_TargetStatus(a.(*core.TargetStatus), b.(*TargetStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TargetSync)(nil), (*core.TargetSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TargetSync_To_core_TargetSync(a.(*TargetSync), b.(*core.TargetSync), scope)
 	}); err != nil {
@@ -900,6 +1170,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetSyncClusterAPISource)(nil), (*core.TargetSyncClusterAPISource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetSyncClusterAPISource_To_core_TargetSyncClusterAPISource(a.(*TargetSyncClusterAPISource), b.(*core.TargetSyncClusterAPISource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetSyncClusterAPISource)(nil), (*TargetSyncClusterAPISource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetSyncClusterAPISource_To_v1alpha1_TargetSyncClusterAPISource(a.(*core.TargetSyncClusterAPISource), b.(*TargetSyncClusterAPISource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TargetSyncList)(nil), (*core.TargetSyncList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TargetSyncList_To_core_TargetSyncList(a.(*TargetSyncList), b.(*core.TargetSyncList), scope)
 	}); err != nil {
@@ -910,6 +1190,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetSyncSecretSelectorSource)(nil), (*core.TargetSyncSecretSelectorSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetSyncSecretSelectorSource_To_core_TargetSyncSecretSelectorSource(a.(*TargetSyncSecretSelectorSource), b.(*core.TargetSyncSecretSelectorSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetSyncSecretSelectorSource)(nil), (*TargetSyncSecretSelectorSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetSyncSecretSelectorSource_To_v1alpha1_TargetSyncSecretSelectorSource(a.(*core.TargetSyncSecretSelectorSource), b.(*TargetSyncSecretSelectorSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetSyncSourceStatus)(nil), (*core.TargetSyncSourceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetSyncSourceStatus_To_core_TargetSyncSourceStatus(a.(*TargetSyncSourceStatus), b.(*core.TargetSyncSourceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetSyncSourceStatus)(nil), (*TargetSyncSourceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetSyncSourceStatus_To_v1alpha1_TargetSyncSourceStatus(a.(*core.TargetSyncSourceStatus), b.(*TargetSyncSourceStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TargetSyncSpec)(nil), (*core.TargetSyncSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TargetSyncSpec_To_core_TargetSyncSpec(a.(*TargetSyncSpec), b.(*core.TargetSyncSpec), scope)
 	}); err != nil {
@@ -940,6 +1240,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetTypeDefinition)(nil), (*core.TargetTypeDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetTypeDefinition_To_core_TargetTypeDefinition(a.(*TargetTypeDefinition), b.(*core.TargetTypeDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetTypeDefinition)(nil), (*TargetTypeDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetTypeDefinition_To_v1alpha1_TargetTypeDefinition(a.(*core.TargetTypeDefinition), b.(*TargetTypeDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetTypeDefinitionList)(nil), (*core.TargetTypeDefinitionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetTypeDefinitionList_To_core_TargetTypeDefinitionList(a.(*TargetTypeDefinitionList), b.(*core.TargetTypeDefinitionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetTypeDefinitionList)(nil), (*TargetTypeDefinitionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetTypeDefinitionList_To_v1alpha1_TargetTypeDefinitionList(a.(*core.TargetTypeDefinitionList), b.(*TargetTypeDefinitionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetTypeDefinitionSpec)(nil), (*core.TargetTypeDefinitionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec(a.(*TargetTypeDefinitionSpec), b.(*core.TargetTypeDefinitionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TargetTypeDefinitionSpec)(nil), (*TargetTypeDefinitionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec(a.(*core.TargetTypeDefinitionSpec), b.(*TargetTypeDefinitionSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TemplateExecutor)(nil), (*core.TemplateExecutor)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TemplateExecutor_To_core_TemplateExecutor(a.(*TemplateExecutor), b.(*core.TemplateExecutor), scope)
 	}); err != nil {
@@ -950,6 +1280,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TestResult)(nil), (*core.TestResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestResult_To_core_TestResult(a.(*TestResult), b.(*core.TestResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TestResult)(nil), (*TestResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TestResult_To_v1alpha1_TestResult(a.(*core.TestResult), b.(*TestResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TestStatus)(nil), (*core.TestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestStatus_To_core_TestStatus(a.(*TestStatus), b.(*core.TestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TestStatus)(nil), (*TestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TestStatus_To_v1alpha1_TestStatus(a.(*core.TestStatus), b.(*TestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TimeoutDetails)(nil), (*core.TimeoutDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TimeoutDetails_To_core_TimeoutDetails(a.(*TimeoutDetails), b.(*core.TimeoutDetails), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TimeoutDetails)(nil), (*TimeoutDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TimeoutDetails_To_v1alpha1_TimeoutDetails(a.(*core.TimeoutDetails), b.(*TimeoutDetails), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TokenRotation)(nil), (*core.TokenRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TokenRotation_To_core_TokenRotation(a.(*TokenRotation), b.(*core.TokenRotation), scope)
 	}); err != nil {
@@ -1121,6 +1481,36 @@ func Convert_core_AutomaticReconcileStatus_To_v1alpha1_AutomaticReconcileStatus(
 	return autoConvert_core_AutomaticReconcileStatus_To_v1alpha1_AutomaticReconcileStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_BlockingObject_To_core_BlockingObject(in *BlockingObject, out *core.BlockingObject, s conversion.Scope) error {
+	if err := Convert_v1alpha1_TypedObjectReference_To_core_TypedObjectReference(&in.Object, &out.Object, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.Imports = *(*[]string)(unsafe.Pointer(&in.Imports))
+	return nil
+}
+
+// Convert_v1alpha1_BlockingObject_To_core_BlockingObject is an autogenerated conversion function.
+func Convert_v1alpha1_BlockingObject_To_core_BlockingObject(in *BlockingObject, out *core.BlockingObject, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BlockingObject_To_core_BlockingObject(in, out, s)
+}
+
+func autoConvert_core_BlockingObject_To_v1alpha1_BlockingObject(in *core.BlockingObject, out *BlockingObject, s conversion.Scope) error {
+	if err := Convert_core_TypedObjectReference_To_v1alpha1_TypedObjectReference(&in.Object, &out.Object, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.Imports = *(*[]string)(unsafe.Pointer(&in.Imports))
+	return nil
+}
+
+// Convert_core_BlockingObject_To_v1alpha1_BlockingObject is an autogenerated conversion function.
+func Convert_core_BlockingObject_To_v1alpha1_BlockingObject(in *core.BlockingObject, out *BlockingObject, s conversion.Scope) error {
+	return autoConvert_core_BlockingObject_To_v1alpha1_BlockingObject(in, out, s)
+}
+
 func autoConvert_v1alpha1_Blueprint_To_core_Blueprint(in *Blueprint, out *core.Blueprint, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.JSONSchemaVersion = in.JSONSchemaVersion
@@ -1131,6 +1521,8 @@ func autoConvert_v1alpha1_Blueprint_To_core_Blueprint(in *Blueprint, out *core.B
 	out.Subinstallations = *(*core.SubinstallationTemplateList)(unsafe.Pointer(&in.Subinstallations))
 	out.SubinstallationExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
 	out.DeployExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.DeployExecutions))
+	out.TestExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.TestExecutions))
+	out.MaxParallelDeployItems = (*int32)(unsafe.Pointer(in.MaxParallelDeployItems))
 	out.ExportExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.ExportExecutions))
 	return nil
 }
@@ -1150,6 +1542,8 @@ func autoConvert_core_Blueprint_To_v1alpha1_Blueprint(in *core.Blueprint, out *B
 	out.Subinstallations = *(*SubinstallationTemplateList)(unsafe.Pointer(&in.Subinstallations))
 	out.SubinstallationExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
 	out.DeployExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.DeployExecutions))
+	out.TestExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.TestExecutions))
+	out.MaxParallelDeployItems = (*int32)(unsafe.Pointer(in.MaxParallelDeployItems))
 	out.ExportExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.ExportExecutions))
 	return nil
 }
@@ -1462,6 +1856,7 @@ func autoConvert_v1alpha1_ContextConfiguration_To_core_ContextConfiguration(in *
 	out.Configurations = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
 	out.VerificationSignatures = *(*map[string]core.VerificationSignature)(unsafe.Pointer(&in.VerificationSignatures))
+	out.RequireApproval = in.RequireApproval
 	return nil
 }
 
@@ -1477,6 +1872,7 @@ func autoConvert_core_ContextConfiguration_To_v1alpha1_ContextConfiguration(in *
 	out.Configurations = *(*map[string]AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
 	out.VerificationSignatures = *(*map[string]VerificationSignature)(unsafe.Pointer(&in.VerificationSignatures))
+	out.RequireApproval = in.RequireApproval
 	return nil
 }
 
@@ -1626,6 +2022,7 @@ func Convert_core_CriticalProblemsStatus_To_v1alpha1_CriticalProblemsStatus(in *
 func autoConvert_v1alpha1_DataExport_To_core_DataExport(in *DataExport, out *core.DataExport, s conversion.Scope) error {
 	out.Name = in.Name
 	out.DataRef = in.DataRef
+	out.Aggregate = (*core.DataExportAggregation)(unsafe.Pointer(in.Aggregate))
 	return nil
 }
 
@@ -1637,6 +2034,7 @@ func Convert_v1alpha1_DataExport_To_core_DataExport(in *DataExport, out *core.Da
 func autoConvert_core_DataExport_To_v1alpha1_DataExport(in *core.DataExport, out *DataExport, s conversion.Scope) error {
 	out.Name = in.Name
 	out.DataRef = in.DataRef
+	out.Aggregate = (*DataExportAggregation)(unsafe.Pointer(in.Aggregate))
 	return nil
 }
 
@@ -1645,12 +2043,33 @@ func Convert_core_DataExport_To_v1alpha1_DataExport(in *core.DataExport, out *Da
 	return autoConvert_core_DataExport_To_v1alpha1_DataExport(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataExportAggregation_To_core_DataExportAggregation(in *DataExportAggregation, out *core.DataExportAggregation, s conversion.Scope) error {
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1alpha1_DataExportAggregation_To_core_DataExportAggregation is an autogenerated conversion function.
+func Convert_v1alpha1_DataExportAggregation_To_core_DataExportAggregation(in *DataExportAggregation, out *core.DataExportAggregation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataExportAggregation_To_core_DataExportAggregation(in, out, s)
+}
+
+func autoConvert_core_DataExportAggregation_To_v1alpha1_DataExportAggregation(in *core.DataExportAggregation, out *DataExportAggregation, s conversion.Scope) error {
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_core_DataExportAggregation_To_v1alpha1_DataExportAggregation is an autogenerated conversion function.
+func Convert_core_DataExportAggregation_To_v1alpha1_DataExportAggregation(in *core.DataExportAggregation, out *DataExportAggregation, s conversion.Scope) error {
+	return autoConvert_core_DataExportAggregation_To_v1alpha1_DataExportAggregation(in, out, s)
+}
+
 func autoConvert_v1alpha1_DataImport_To_core_DataImport(in *DataImport, out *core.DataImport, s conversion.Scope) error {
 	out.Name = in.Name
 	out.DataRef = in.DataRef
 	out.Version = in.Version
 	out.SecretRef = (*core.LocalSecretReference)(unsafe.Pointer(in.SecretRef))
 	out.ConfigMapRef = (*core.LocalConfigMapReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.Aggregated = (*core.DataImportAggregation)(unsafe.Pointer(in.Aggregated))
 	return nil
 }
 
@@ -1665,6 +2084,7 @@ func autoConvert_core_DataImport_To_v1alpha1_DataImport(in *core.DataImport, out
 	out.Version = in.Version
 	out.SecretRef = (*LocalSecretReference)(unsafe.Pointer(in.SecretRef))
 	out.ConfigMapRef = (*LocalConfigMapReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.Aggregated = (*DataImportAggregation)(unsafe.Pointer(in.Aggregated))
 	return nil
 }
 
@@ -1673,6 +2093,26 @@ func Convert_core_DataImport_To_v1alpha1_DataImport(in *core.DataImport, out *Da
 	return autoConvert_core_DataImport_To_v1alpha1_DataImport(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataImportAggregation_To_core_DataImportAggregation(in *DataImportAggregation, out *core.DataImportAggregation, s conversion.Scope) error {
+	out.Format = core.AggregationFormat(in.Format)
+	return nil
+}
+
+// Convert_v1alpha1_DataImportAggregation_To_core_DataImportAggregation is an autogenerated conversion function.
+func Convert_v1alpha1_DataImportAggregation_To_core_DataImportAggregation(in *DataImportAggregation, out *core.DataImportAggregation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataImportAggregation_To_core_DataImportAggregation(in, out, s)
+}
+
+func autoConvert_core_DataImportAggregation_To_v1alpha1_DataImportAggregation(in *core.DataImportAggregation, out *DataImportAggregation, s conversion.Scope) error {
+	out.Format = AggregationFormat(in.Format)
+	return nil
+}
+
+// Convert_core_DataImportAggregation_To_v1alpha1_DataImportAggregation is an autogenerated conversion function.
+func Convert_core_DataImportAggregation_To_v1alpha1_DataImportAggregation(in *core.DataImportAggregation, out *DataImportAggregation, s conversion.Scope) error {
+	return autoConvert_core_DataImportAggregation_To_v1alpha1_DataImportAggregation(in, out, s)
+}
+
 func autoConvert_v1alpha1_DataObject_To_core_DataObject(in *DataObject, out *core.DataObject, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_AnyJSON_To_core_AnyJSON(&in.Data, &out.Data, s); err != nil {
@@ -1745,6 +2185,44 @@ func Convert_core_Default_To_v1alpha1_Default(in *core.Default, out *Default, s
 	return autoConvert_core_Default_To_v1alpha1_Default(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeletionImpact_To_core_DeletionImpact(in *DeletionImpact, out *core.DeletionImpact, s conversion.Scope) error {
+	out.ComputationTime = in.ComputationTime
+	out.Installations = *(*[]core.ObjectReference)(unsafe.Pointer(&in.Installations))
+	out.Executions = *(*[]core.ObjectReference)(unsafe.Pointer(&in.Executions))
+	out.DeployItems = *(*[]core.ObjectReference)(unsafe.Pointer(&in.DeployItems))
+	out.ManagedResources = *(*[]core.ManagedResourceReference)(unsafe.Pointer(&in.ManagedResources))
+	out.TotalInstallations = in.TotalInstallations
+	out.TotalExecutions = in.TotalExecutions
+	out.TotalDeployItems = in.TotalDeployItems
+	out.TotalManagedResources = in.TotalManagedResources
+	out.Truncated = in.Truncated
+	return nil
+}
+
+// Convert_v1alpha1_DeletionImpact_To_core_DeletionImpact is an autogenerated conversion function.
+func Convert_v1alpha1_DeletionImpact_To_core_DeletionImpact(in *DeletionImpact, out *core.DeletionImpact, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeletionImpact_To_core_DeletionImpact(in, out, s)
+}
+
+func autoConvert_core_DeletionImpact_To_v1alpha1_DeletionImpact(in *core.DeletionImpact, out *DeletionImpact, s conversion.Scope) error {
+	out.ComputationTime = in.ComputationTime
+	out.Installations = *(*[]ObjectReference)(unsafe.Pointer(&in.Installations))
+	out.Executions = *(*[]ObjectReference)(unsafe.Pointer(&in.Executions))
+	out.DeployItems = *(*[]ObjectReference)(unsafe.Pointer(&in.DeployItems))
+	out.ManagedResources = *(*[]ManagedResourceReference)(unsafe.Pointer(&in.ManagedResources))
+	out.TotalInstallations = in.TotalInstallations
+	out.TotalExecutions = in.TotalExecutions
+	out.TotalDeployItems = in.TotalDeployItems
+	out.TotalManagedResources = in.TotalManagedResources
+	out.Truncated = in.Truncated
+	return nil
+}
+
+// Convert_core_DeletionImpact_To_v1alpha1_DeletionImpact is an autogenerated conversion function.
+func Convert_core_DeletionImpact_To_v1alpha1_DeletionImpact(in *core.DeletionImpact, out *DeletionImpact, s conversion.Scope) error {
+	return autoConvert_core_DeletionImpact_To_v1alpha1_DeletionImpact(in, out, s)
+}
+
 func autoConvert_v1alpha1_DependentToTrigger_To_core_DependentToTrigger(in *DependentToTrigger, out *core.DependentToTrigger, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -1819,6 +2297,28 @@ func Convert_core_DeployItemCache_To_v1alpha1_DeployItemCache(in *core.DeployIte
 	return autoConvert_core_DeployItemCache_To_v1alpha1_DeployItemCache(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployItemForEach_To_core_DeployItemForEach(in *DeployItemForEach, out *core.DeployItemForEach, s conversion.Scope) error {
+	out.Items = (*core.AnyJSON)(unsafe.Pointer(in.Items))
+	out.TargetImport = in.TargetImport
+	return nil
+}
+
+// Convert_v1alpha1_DeployItemForEach_To_core_DeployItemForEach is an autogenerated conversion function.
+func Convert_v1alpha1_DeployItemForEach_To_core_DeployItemForEach(in *DeployItemForEach, out *core.DeployItemForEach, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployItemForEach_To_core_DeployItemForEach(in, out, s)
+}
+
+func autoConvert_core_DeployItemForEach_To_v1alpha1_DeployItemForEach(in *core.DeployItemForEach, out *DeployItemForEach, s conversion.Scope) error {
+	out.Items = (*AnyJSON)(unsafe.Pointer(in.Items))
+	out.TargetImport = in.TargetImport
+	return nil
+}
+
+// Convert_core_DeployItemForEach_To_v1alpha1_DeployItemForEach is an autogenerated conversion function.
+func Convert_core_DeployItemForEach_To_v1alpha1_DeployItemForEach(in *core.DeployItemForEach, out *DeployItemForEach, s conversion.Scope) error {
+	return autoConvert_core_DeployItemForEach_To_v1alpha1_DeployItemForEach(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployItemList_To_core_DeployItemList(in *DeployItemList, out *core.DeployItemList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.DeployItem)(unsafe.Pointer(&in.Items))
@@ -1848,6 +2348,7 @@ func autoConvert_v1alpha1_DeployItemSpec_To_core_DeployItemSpec(in *DeployItemSp
 	out.Configuration = (*runtime.RawExtension)(unsafe.Pointer(in.Configuration))
 	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
+	out.ReconcileOnTargetChange = in.ReconcileOnTargetChange
 	out.OnDelete = (*core.OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
 	return nil
 }
@@ -1864,6 +2365,7 @@ func autoConvert_core_DeployItemSpec_To_v1alpha1_DeployItemSpec(in *core.DeployI
 	out.Configuration = (*runtime.RawExtension)(unsafe.Pointer(in.Configuration))
 	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
+	out.ReconcileOnTargetChange = in.ReconcileOnTargetChange
 	out.OnDelete = (*OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
 	return nil
 }
@@ -1889,8 +2391,11 @@ func autoConvert_v1alpha1_DeployItemStatus_To_core_DeployItemStatus(in *DeployIt
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
+	out.TargetContentHash = in.TargetContentHash
+	out.ReferencedDataHash = in.ReferencedDataHash
 	out.DeployerPhase = (*string)(unsafe.Pointer(in.DeployerPhase))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Retry = (*core.RetryStatus)(unsafe.Pointer(in.Retry))
 	return nil
 }
 
@@ -1915,8 +2420,11 @@ func autoConvert_core_DeployItemStatus_To_v1alpha1_DeployItemStatus(in *core.Dep
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
+	out.TargetContentHash = in.TargetContentHash
+	out.ReferencedDataHash = in.ReferencedDataHash
 	out.DeployerPhase = (*string)(unsafe.Pointer(in.DeployerPhase))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Retry = (*RetryStatus)(unsafe.Pointer(in.Retry))
 	return nil
 }
 
@@ -1934,7 +2442,12 @@ func autoConvert_v1alpha1_DeployItemTemplate_To_core_DeployItemTemplate(in *Depl
 	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
 	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
+	out.ReconcileOnTargetChange = in.ReconcileOnTargetChange
+	out.RolloutGroup = in.RolloutGroup
 	out.OnDelete = (*core.OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.RetryPolicy = (*core.RetryPolicy)(unsafe.Pointer(in.RetryPolicy))
+	out.When = (*bool)(unsafe.Pointer(in.When))
+	out.ForEach = (*core.DeployItemForEach)(unsafe.Pointer(in.ForEach))
 	return nil
 }
 
@@ -1952,7 +2465,12 @@ func autoConvert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in *core
 	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
 	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
+	out.ReconcileOnTargetChange = in.ReconcileOnTargetChange
+	out.RolloutGroup = in.RolloutGroup
 	out.OnDelete = (*OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.RetryPolicy = (*RetryPolicy)(unsafe.Pointer(in.RetryPolicy))
+	out.When = (*bool)(unsafe.Pointer(in.When))
+	out.ForEach = (*DeployItemForEach)(unsafe.Pointer(in.ForEach))
 	return nil
 }
 
@@ -2135,6 +2653,9 @@ func autoConvert_v1alpha1_ExecutionSpec_To_core_ExecutionSpec(in *ExecutionSpec,
 	out.Context = in.Context
 	out.DeployItems = *(*core.DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
+	out.Rollout = (*core.RolloutPolicy)(unsafe.Pointer(in.Rollout))
+	out.RequireApproval = in.RequireApproval
+	out.MaxParallel = (*int32)(unsafe.Pointer(in.MaxParallel))
 	return nil
 }
 
@@ -2142,6 +2663,9 @@ func autoConvert_core_ExecutionSpec_To_v1alpha1_ExecutionSpec(in *core.Execution
 	out.Context = in.Context
 	out.DeployItems = *(*DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
+	out.Rollout = (*RolloutPolicy)(unsafe.Pointer(in.Rollout))
+	out.RequireApproval = in.RequireApproval
+	out.MaxParallel = (*int32)(unsafe.Pointer(in.MaxParallel))
 	return nil
 }
 
@@ -2156,6 +2680,10 @@ func autoConvert_v1alpha1_ExecutionStatus_To_core_ExecutionStatus(in *ExecutionS
 	out.ExecutionPhase = core.ExecutionPhase(in.ExecutionPhase)
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Rollout = (*core.RolloutStatus)(unsafe.Pointer(in.Rollout))
+	out.NextRetryTime = (*metav1.Time)(unsafe.Pointer(in.NextRetryTime))
+	out.PendingDeletion = (*core.PendingDeletion)(unsafe.Pointer(in.PendingDeletion))
+	out.PendingApproval = (*core.PendingApproval)(unsafe.Pointer(in.PendingApproval))
 	return nil
 }
 
@@ -2175,6 +2703,10 @@ func autoConvert_core_ExecutionStatus_To_v1alpha1_ExecutionStatus(in *core.Execu
 	out.ExecutionPhase = ExecutionPhase(in.ExecutionPhase)
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Rollout = (*RolloutStatus)(unsafe.Pointer(in.Rollout))
+	out.NextRetryTime = (*metav1.Time)(unsafe.Pointer(in.NextRetryTime))
+	out.PendingDeletion = (*PendingDeletion)(unsafe.Pointer(in.PendingDeletion))
+	out.PendingApproval = (*PendingApproval)(unsafe.Pointer(in.PendingApproval))
 	return nil
 }
 
@@ -2209,6 +2741,34 @@ func Convert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in *core.ExportD
 	return autoConvert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_FailedObject_To_core_FailedObject(in *FailedObject, out *core.FailedObject, s conversion.Scope) error {
+	if err := Convert_v1alpha1_TypedObjectReference_To_core_TypedObjectReference(&in.Object, &out.Object, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Codes = *(*[]core.ErrorCode)(unsafe.Pointer(&in.Codes))
+	return nil
+}
+
+// Convert_v1alpha1_FailedObject_To_core_FailedObject is an autogenerated conversion function.
+func Convert_v1alpha1_FailedObject_To_core_FailedObject(in *FailedObject, out *core.FailedObject, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FailedObject_To_core_FailedObject(in, out, s)
+}
+
+func autoConvert_core_FailedObject_To_v1alpha1_FailedObject(in *core.FailedObject, out *FailedObject, s conversion.Scope) error {
+	if err := Convert_core_TypedObjectReference_To_v1alpha1_TypedObjectReference(&in.Object, &out.Object, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Codes = *(*[]ErrorCode)(unsafe.Pointer(&in.Codes))
+	return nil
+}
+
+// Convert_core_FailedObject_To_v1alpha1_FailedObject is an autogenerated conversion function.
+func Convert_core_FailedObject_To_v1alpha1_FailedObject(in *core.FailedObject, out *FailedObject, s conversion.Scope) error {
+	return autoConvert_core_FailedObject_To_v1alpha1_FailedObject(in, out, s)
+}
+
 func autoConvert_v1alpha1_FailedReconcile_To_core_FailedReconcile(in *FailedReconcile, out *core.FailedReconcile, s conversion.Scope) error {
 	out.NumberOfReconciles = (*int)(unsafe.Pointer(in.NumberOfReconciles))
 	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
@@ -2293,6 +2853,34 @@ func Convert_core_ImportDefinition_To_v1alpha1_ImportDefinition(in *core.ImportD
 	return autoConvert_core_ImportDefinition_To_v1alpha1_ImportDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_IncompleteChild_To_core_IncompleteChild(in *IncompleteChild, out *core.IncompleteChild, s conversion.Scope) error {
+	if err := Convert_v1alpha1_TypedObjectReference_To_core_TypedObjectReference(&in.Object, &out.Object, s); err != nil {
+		return err
+	}
+	out.Phase = in.Phase
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	return nil
+}
+
+// Convert_v1alpha1_IncompleteChild_To_core_IncompleteChild is an autogenerated conversion function.
+func Convert_v1alpha1_IncompleteChild_To_core_IncompleteChild(in *IncompleteChild, out *core.IncompleteChild, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IncompleteChild_To_core_IncompleteChild(in, out, s)
+}
+
+func autoConvert_core_IncompleteChild_To_v1alpha1_IncompleteChild(in *core.IncompleteChild, out *IncompleteChild, s conversion.Scope) error {
+	if err := Convert_core_TypedObjectReference_To_v1alpha1_TypedObjectReference(&in.Object, &out.Object, s); err != nil {
+		return err
+	}
+	out.Phase = in.Phase
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	return nil
+}
+
+// Convert_core_IncompleteChild_To_v1alpha1_IncompleteChild is an autogenerated conversion function.
+func Convert_core_IncompleteChild_To_v1alpha1_IncompleteChild(in *core.IncompleteChild, out *IncompleteChild, s conversion.Scope) error {
+	return autoConvert_core_IncompleteChild_To_v1alpha1_IncompleteChild(in, out, s)
+}
+
 func autoConvert_v1alpha1_InlineBlueprint_To_core_InlineBlueprint(in *InlineBlueprint, out *core.InlineBlueprint, s conversion.Scope) error {
 	if err := Convert_v1alpha1_AnyJSON_To_core_AnyJSON(&in.Filesystem, &out.Filesystem, s); err != nil {
 		return err
@@ -2415,6 +3003,274 @@ func Convert_core_InstallationList_To_v1alpha1_InstallationList(in *core.Install
 	return autoConvert_core_InstallationList_To_v1alpha1_InstallationList(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstallationSet_To_core_InstallationSet(in *InstallationSet, out *core.InstallationSet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_InstallationSetSpec_To_core_InstallationSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_InstallationSetStatus_To_core_InstallationSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_InstallationSet_To_core_InstallationSet is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationSet_To_core_InstallationSet(in *InstallationSet, out *core.InstallationSet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationSet_To_core_InstallationSet(in, out, s)
+}
+
+func autoConvert_core_InstallationSet_To_v1alpha1_InstallationSet(in *core.InstallationSet, out *InstallationSet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_InstallationSetSpec_To_v1alpha1_InstallationSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_InstallationSetStatus_To_v1alpha1_InstallationSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_InstallationSet_To_v1alpha1_InstallationSet is an autogenerated conversion function.
+func Convert_core_InstallationSet_To_v1alpha1_InstallationSet(in *core.InstallationSet, out *InstallationSet, s conversion.Scope) error {
+	return autoConvert_core_InstallationSet_To_v1alpha1_InstallationSet(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationSetElementOverride_To_core_InstallationSetElementOverride(in *InstallationSetElementOverride, out *core.InstallationSetElementOverride, s conversion.Scope) error {
+	out.Element = in.Element
+	if err := Convert_v1alpha1_InstallationImports_To_core_InstallationImports(&in.Imports, &out.Imports, s); err != nil {
+		return err
+	}
+	out.ImportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ImportDataMappings))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationSetElementOverride_To_core_InstallationSetElementOverride is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationSetElementOverride_To_core_InstallationSetElementOverride(in *InstallationSetElementOverride, out *core.InstallationSetElementOverride, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationSetElementOverride_To_core_InstallationSetElementOverride(in, out, s)
+}
+
+func autoConvert_core_InstallationSetElementOverride_To_v1alpha1_InstallationSetElementOverride(in *core.InstallationSetElementOverride, out *InstallationSetElementOverride, s conversion.Scope) error {
+	out.Element = in.Element
+	if err := Convert_core_InstallationImports_To_v1alpha1_InstallationImports(&in.Imports, &out.Imports, s); err != nil {
+		return err
+	}
+	out.ImportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ImportDataMappings))
+	return nil
+}
+
+// Convert_core_InstallationSetElementOverride_To_v1alpha1_InstallationSetElementOverride is an autogenerated conversion function.
+func Convert_core_InstallationSetElementOverride_To_v1alpha1_InstallationSetElementOverride(in *core.InstallationSetElementOverride, out *InstallationSetElementOverride, s conversion.Scope) error {
+	return autoConvert_core_InstallationSetElementOverride_To_v1alpha1_InstallationSetElementOverride(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationSetGenerator_To_core_InstallationSetGenerator(in *InstallationSetGenerator, out *core.InstallationSetGenerator, s conversion.Scope) error {
+	out.Targets = (*core.InstallationSetTargetsGenerator)(unsafe.Pointer(in.Targets))
+	out.TargetMap = *(*map[string]string)(unsafe.Pointer(&in.TargetMap))
+	out.TargetImport = in.TargetImport
+	out.Parameters = *(*[]core.InstallationSetParameterSet)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationSetGenerator_To_core_InstallationSetGenerator is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationSetGenerator_To_core_InstallationSetGenerator(in *InstallationSetGenerator, out *core.InstallationSetGenerator, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationSetGenerator_To_core_InstallationSetGenerator(in, out, s)
+}
+
+func autoConvert_core_InstallationSetGenerator_To_v1alpha1_InstallationSetGenerator(in *core.InstallationSetGenerator, out *InstallationSetGenerator, s conversion.Scope) error {
+	out.Targets = (*InstallationSetTargetsGenerator)(unsafe.Pointer(in.Targets))
+	out.TargetMap = *(*map[string]string)(unsafe.Pointer(&in.TargetMap))
+	out.TargetImport = in.TargetImport
+	out.Parameters = *(*[]InstallationSetParameterSet)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_core_InstallationSetGenerator_To_v1alpha1_InstallationSetGenerator is an autogenerated conversion function.
+func Convert_core_InstallationSetGenerator_To_v1alpha1_InstallationSetGenerator(in *core.InstallationSetGenerator, out *InstallationSetGenerator, s conversion.Scope) error {
+	return autoConvert_core_InstallationSetGenerator_To_v1alpha1_InstallationSetGenerator(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationSetInstallationStatus_To_core_InstallationSetInstallationStatus(in *InstallationSetInstallationStatus, out *core.InstallationSetInstallationStatus, s conversion.Scope) error {
+	out.Element = in.Element
+	out.Name = in.Name
+	out.Phase = core.InstallationPhase(in.Phase)
+	return nil
+}
+
+// Convert_v1alpha1_InstallationSetInstallationStatus_To_core_InstallationSetInstallationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationSetInstallationStatus_To_core_InstallationSetInstallationStatus(in *InstallationSetInstallationStatus, out *core.InstallationSetInstallationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationSetInstallationStatus_To_core_InstallationSetInstallationStatus(in, out, s)
+}
+
+func autoConvert_core_InstallationSetInstallationStatus_To_v1alpha1_InstallationSetInstallationStatus(in *core.InstallationSetInstallationStatus, out *InstallationSetInstallationStatus, s conversion.Scope) error {
+	out.Element = in.Element
+	out.Name = in.Name
+	out.Phase = InstallationPhase(in.Phase)
+	return nil
+}
+
+// Convert_core_InstallationSetInstallationStatus_To_v1alpha1_InstallationSetInstallationStatus is an autogenerated conversion function.
+func Convert_core_InstallationSetInstallationStatus_To_v1alpha1_InstallationSetInstallationStatus(in *core.InstallationSetInstallationStatus, out *InstallationSetInstallationStatus, s conversion.Scope) error {
+	return autoConvert_core_InstallationSetInstallationStatus_To_v1alpha1_InstallationSetInstallationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationSetList_To_core_InstallationSetList(in *InstallationSetList, out *core.InstallationSetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.InstallationSet)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationSetList_To_core_InstallationSetList is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationSetList_To_core_InstallationSetList(in *InstallationSetList, out *core.InstallationSetList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationSetList_To_core_InstallationSetList(in, out, s)
+}
+
+func autoConvert_core_InstallationSetList_To_v1alpha1_InstallationSetList(in *core.InstallationSetList, out *InstallationSetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]InstallationSet)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_InstallationSetList_To_v1alpha1_InstallationSetList is an autogenerated conversion function.
+func Convert_core_InstallationSetList_To_v1alpha1_InstallationSetList(in *core.InstallationSetList, out *InstallationSetList, s conversion.Scope) error {
+	return autoConvert_core_InstallationSetList_To_v1alpha1_InstallationSetList(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationSetParameterSet_To_core_InstallationSetParameterSet(in *InstallationSetParameterSet, out *core.InstallationSetParameterSet, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ImportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ImportDataMappings))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationSetParameterSet_To_core_InstallationSetParameterSet is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationSetParameterSet_To_core_InstallationSetParameterSet(in *InstallationSetParameterSet, out *core.InstallationSetParameterSet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationSetParameterSet_To_core_InstallationSetParameterSet(in, out, s)
+}
+
+func autoConvert_core_InstallationSetParameterSet_To_v1alpha1_InstallationSetParameterSet(in *core.InstallationSetParameterSet, out *InstallationSetParameterSet, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ImportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ImportDataMappings))
+	return nil
+}
+
+// Convert_core_InstallationSetParameterSet_To_v1alpha1_InstallationSetParameterSet is an autogenerated conversion function.
+func Convert_core_InstallationSetParameterSet_To_v1alpha1_InstallationSetParameterSet(in *core.InstallationSetParameterSet, out *InstallationSetParameterSet, s conversion.Scope) error {
+	return autoConvert_core_InstallationSetParameterSet_To_v1alpha1_InstallationSetParameterSet(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationSetSpec_To_core_InstallationSetSpec(in *InstallationSetSpec, out *core.InstallationSetSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_InstallationSetTemplate_To_core_InstallationSetTemplate(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_InstallationSetGenerator_To_core_InstallationSetGenerator(&in.Generator, &out.Generator, s); err != nil {
+		return err
+	}
+	out.NameTemplate = in.NameTemplate
+	out.Overrides = *(*[]core.InstallationSetElementOverride)(unsafe.Pointer(&in.Overrides))
+	out.MaxProgressing = (*int32)(unsafe.Pointer(in.MaxProgressing))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationSetSpec_To_core_InstallationSetSpec is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationSetSpec_To_core_InstallationSetSpec(in *InstallationSetSpec, out *core.InstallationSetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationSetSpec_To_core_InstallationSetSpec(in, out, s)
+}
+
+func autoConvert_core_InstallationSetSpec_To_v1alpha1_InstallationSetSpec(in *core.InstallationSetSpec, out *InstallationSetSpec, s conversion.Scope) error {
+	if err := Convert_core_InstallationSetTemplate_To_v1alpha1_InstallationSetTemplate(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	if err := Convert_core_InstallationSetGenerator_To_v1alpha1_InstallationSetGenerator(&in.Generator, &out.Generator, s); err != nil {
+		return err
+	}
+	out.NameTemplate = in.NameTemplate
+	out.Overrides = *(*[]InstallationSetElementOverride)(unsafe.Pointer(&in.Overrides))
+	out.MaxProgressing = (*int32)(unsafe.Pointer(in.MaxProgressing))
+	return nil
+}
+
+// Convert_core_InstallationSetSpec_To_v1alpha1_InstallationSetSpec is an autogenerated conversion function.
+func Convert_core_InstallationSetSpec_To_v1alpha1_InstallationSetSpec(in *core.InstallationSetSpec, out *InstallationSetSpec, s conversion.Scope) error {
+	return autoConvert_core_InstallationSetSpec_To_v1alpha1_InstallationSetSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationSetStatus_To_core_InstallationSetStatus(in *InstallationSetStatus, out *core.InstallationSetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastError = (*core.Error)(unsafe.Pointer(in.LastError))
+	out.InstallationCount = in.InstallationCount
+	out.Progressing = in.Progressing
+	out.Pending = in.Pending
+	out.Installations = *(*[]core.InstallationSetInstallationStatus)(unsafe.Pointer(&in.Installations))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationSetStatus_To_core_InstallationSetStatus is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationSetStatus_To_core_InstallationSetStatus(in *InstallationSetStatus, out *core.InstallationSetStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationSetStatus_To_core_InstallationSetStatus(in, out, s)
+}
+
+func autoConvert_core_InstallationSetStatus_To_v1alpha1_InstallationSetStatus(in *core.InstallationSetStatus, out *InstallationSetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastError = (*Error)(unsafe.Pointer(in.LastError))
+	out.InstallationCount = in.InstallationCount
+	out.Progressing = in.Progressing
+	out.Pending = in.Pending
+	out.Installations = *(*[]InstallationSetInstallationStatus)(unsafe.Pointer(&in.Installations))
+	return nil
+}
+
+// Convert_core_InstallationSetStatus_To_v1alpha1_InstallationSetStatus is an autogenerated conversion function.
+func Convert_core_InstallationSetStatus_To_v1alpha1_InstallationSetStatus(in *core.InstallationSetStatus, out *InstallationSetStatus, s conversion.Scope) error {
+	return autoConvert_core_InstallationSetStatus_To_v1alpha1_InstallationSetStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationSetTargetsGenerator_To_core_InstallationSetTargetsGenerator(in *InstallationSetTargetsGenerator, out *core.InstallationSetTargetsGenerator, s conversion.Scope) error {
+	out.Selector = in.Selector
+	return nil
+}
+
+// Convert_v1alpha1_InstallationSetTargetsGenerator_To_core_InstallationSetTargetsGenerator is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationSetTargetsGenerator_To_core_InstallationSetTargetsGenerator(in *InstallationSetTargetsGenerator, out *core.InstallationSetTargetsGenerator, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationSetTargetsGenerator_To_core_InstallationSetTargetsGenerator(in, out, s)
+}
+
+func autoConvert_core_InstallationSetTargetsGenerator_To_v1alpha1_InstallationSetTargetsGenerator(in *core.InstallationSetTargetsGenerator, out *InstallationSetTargetsGenerator, s conversion.Scope) error {
+	out.Selector = in.Selector
+	return nil
+}
+
+// Convert_core_InstallationSetTargetsGenerator_To_v1alpha1_InstallationSetTargetsGenerator is an autogenerated conversion function.
+func Convert_core_InstallationSetTargetsGenerator_To_v1alpha1_InstallationSetTargetsGenerator(in *core.InstallationSetTargetsGenerator, out *InstallationSetTargetsGenerator, s conversion.Scope) error {
+	return autoConvert_core_InstallationSetTargetsGenerator_To_v1alpha1_InstallationSetTargetsGenerator(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationSetTemplate_To_core_InstallationSetTemplate(in *InstallationSetTemplate, out *core.InstallationSetTemplate, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	if err := Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_InstallationSetTemplate_To_core_InstallationSetTemplate is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationSetTemplate_To_core_InstallationSetTemplate(in *InstallationSetTemplate, out *core.InstallationSetTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationSetTemplate_To_core_InstallationSetTemplate(in, out, s)
+}
+
+func autoConvert_core_InstallationSetTemplate_To_v1alpha1_InstallationSetTemplate(in *core.InstallationSetTemplate, out *InstallationSetTemplate, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	if err := Convert_core_InstallationSpec_To_v1alpha1_InstallationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_InstallationSetTemplate_To_v1alpha1_InstallationSetTemplate is an autogenerated conversion function.
+func Convert_core_InstallationSetTemplate_To_v1alpha1_InstallationSetTemplate(in *core.InstallationSetTemplate, out *InstallationSetTemplate, s conversion.Scope) error {
+	return autoConvert_core_InstallationSetTemplate_To_v1alpha1_InstallationSetTemplate(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *InstallationSpec, out *core.InstallationSpec, s conversion.Scope) error {
 	out.Context = in.Context
 	out.Verification = (*core.Verification)(unsafe.Pointer(in.Verification))
@@ -2432,6 +3288,13 @@ func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *Installa
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*core.AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
 	out.Optimization = (*core.Optimization)(unsafe.Pointer(in.Optimization))
+	out.ReconcileOnTargetChange = in.ReconcileOnTargetChange
+	out.Rollout = (*core.RolloutPolicy)(unsafe.Pointer(in.Rollout))
+	out.RequireApproval = (*bool)(unsafe.Pointer(in.RequireApproval))
+	out.Suspend = in.Suspend
+	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
+	out.RetryPolicy = (*core.RetryPolicy)(unsafe.Pointer(in.RetryPolicy))
+	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
 	return nil
 }
 
@@ -2457,6 +3320,13 @@ func autoConvert_core_InstallationSpec_To_v1alpha1_InstallationSpec(in *core.Ins
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
 	out.Optimization = (*Optimization)(unsafe.Pointer(in.Optimization))
+	out.ReconcileOnTargetChange = in.ReconcileOnTargetChange
+	out.Rollout = (*RolloutPolicy)(unsafe.Pointer(in.Rollout))
+	out.RequireApproval = (*bool)(unsafe.Pointer(in.RequireApproval))
+	out.Suspend = in.Suspend
+	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
+	out.RetryPolicy = (*RetryPolicy)(unsafe.Pointer(in.RetryPolicy))
+	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
 	return nil
 }
 
@@ -2471,6 +3341,7 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.LastError = (*core.Error)(unsafe.Pointer(in.LastError))
 	out.SubInstCache = (*core.SubInstCache)(unsafe.Pointer(in.SubInstCache))
 	out.ExecutionReference = (*core.ObjectReference)(unsafe.Pointer(in.ExecutionReference))
+	out.TestExecutionReference = (*core.ObjectReference)(unsafe.Pointer(in.TestExecutionReference))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.InstallationPhase = core.InstallationPhase(in.InstallationPhase)
@@ -2479,6 +3350,14 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.AutomaticReconcileStatus = (*core.AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]core.DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Rollout = (*core.RolloutStatus)(unsafe.Pointer(in.Rollout))
+	out.Retry = (*core.RetryStatus)(unsafe.Pointer(in.Retry))
+	out.BlockedBy = *(*[]core.BlockingObject)(unsafe.Pointer(&in.BlockedBy))
+	out.Summary = (*core.SubtreeSummary)(unsafe.Pointer(in.Summary))
+	out.PendingDeletion = (*core.PendingDeletion)(unsafe.Pointer(in.PendingDeletion))
+	out.DeletionImpact = (*core.DeletionImpact)(unsafe.Pointer(in.DeletionImpact))
+	out.TimedOut = (*core.TimeoutDetails)(unsafe.Pointer(in.TimedOut))
+	out.Tests = (*core.TestStatus)(unsafe.Pointer(in.Tests))
 	return nil
 }
 
@@ -2493,6 +3372,7 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.LastError = (*Error)(unsafe.Pointer(in.LastError))
 	out.SubInstCache = (*SubInstCache)(unsafe.Pointer(in.SubInstCache))
 	out.ExecutionReference = (*ObjectReference)(unsafe.Pointer(in.ExecutionReference))
+	out.TestExecutionReference = (*ObjectReference)(unsafe.Pointer(in.TestExecutionReference))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.InstallationPhase = InstallationPhase(in.InstallationPhase)
@@ -2501,6 +3381,14 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.AutomaticReconcileStatus = (*AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Rollout = (*RolloutStatus)(unsafe.Pointer(in.Rollout))
+	out.Retry = (*RetryStatus)(unsafe.Pointer(in.Retry))
+	out.BlockedBy = *(*[]BlockingObject)(unsafe.Pointer(&in.BlockedBy))
+	out.Summary = (*SubtreeSummary)(unsafe.Pointer(in.Summary))
+	out.PendingDeletion = (*PendingDeletion)(unsafe.Pointer(in.PendingDeletion))
+	out.DeletionImpact = (*DeletionImpact)(unsafe.Pointer(in.DeletionImpact))
+	out.TimedOut = (*TimeoutDetails)(unsafe.Pointer(in.TimedOut))
+	out.Tests = (*TestStatus)(unsafe.Pointer(in.Tests))
 	return nil
 }
 
@@ -2523,6 +3411,8 @@ func autoConvert_v1alpha1_InstallationTemplate_To_core_InstallationTemplate(in *
 	}
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.Optimization = (*core.Optimization)(unsafe.Pointer(in.Optimization))
+	out.Rollout = (*core.RolloutPolicy)(unsafe.Pointer(in.Rollout))
+	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
 	return nil
 }
 
@@ -2545,6 +3435,8 @@ func autoConvert_core_InstallationTemplate_To_v1alpha1_InstallationTemplate(in *
 	}
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.Optimization = (*Optimization)(unsafe.Pointer(in.Optimization))
+	out.Rollout = (*RolloutPolicy)(unsafe.Pointer(in.Rollout))
+	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
 	return nil
 }
 
@@ -2691,6 +3583,38 @@ func Convert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in *core.LsHea
 	return autoConvert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in, out, s)
 }
 
+func autoConvert_v1alpha1_ManagedResourceReference_To_core_ManagedResourceReference(in *ManagedResourceReference, out *core.ManagedResourceReference, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&in.DeployItem, &out.DeployItem, s); err != nil {
+		return err
+	}
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1alpha1_ManagedResourceReference_To_core_ManagedResourceReference is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedResourceReference_To_core_ManagedResourceReference(in *ManagedResourceReference, out *core.ManagedResourceReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedResourceReference_To_core_ManagedResourceReference(in, out, s)
+}
+
+func autoConvert_core_ManagedResourceReference_To_v1alpha1_ManagedResourceReference(in *core.ManagedResourceReference, out *ManagedResourceReference, s conversion.Scope) error {
+	if err := Convert_core_ObjectReference_To_v1alpha1_ObjectReference(&in.DeployItem, &out.DeployItem, s); err != nil {
+		return err
+	}
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_core_ManagedResourceReference_To_v1alpha1_ManagedResourceReference is an autogenerated conversion function.
+func Convert_core_ManagedResourceReference_To_v1alpha1_ManagedResourceReference(in *core.ManagedResourceReference, out *ManagedResourceReference, s conversion.Scope) error {
+	return autoConvert_core_ManagedResourceReference_To_v1alpha1_ManagedResourceReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(in *NamedObjectReference, out *core.NamedObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&in.Reference, &out.Reference, s); err != nil {
@@ -2781,6 +3705,52 @@ func Convert_core_Optimization_To_v1alpha1_Optimization(in *core.Optimization, o
 	return autoConvert_core_Optimization_To_v1alpha1_Optimization(in, out, s)
 }
 
+func autoConvert_v1alpha1_PendingApproval_To_core_PendingApproval(in *PendingApproval, out *core.PendingApproval, s conversion.Scope) error {
+	out.Digest = in.Digest
+	out.DeployItems = *(*[]string)(unsafe.Pointer(&in.DeployItems))
+	return nil
+}
+
+// Convert_v1alpha1_PendingApproval_To_core_PendingApproval is an autogenerated conversion function.
+func Convert_v1alpha1_PendingApproval_To_core_PendingApproval(in *PendingApproval, out *core.PendingApproval, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PendingApproval_To_core_PendingApproval(in, out, s)
+}
+
+func autoConvert_core_PendingApproval_To_v1alpha1_PendingApproval(in *core.PendingApproval, out *PendingApproval, s conversion.Scope) error {
+	out.Digest = in.Digest
+	out.DeployItems = *(*[]string)(unsafe.Pointer(&in.DeployItems))
+	return nil
+}
+
+// Convert_core_PendingApproval_To_v1alpha1_PendingApproval is an autogenerated conversion function.
+func Convert_core_PendingApproval_To_v1alpha1_PendingApproval(in *core.PendingApproval, out *PendingApproval, s conversion.Scope) error {
+	return autoConvert_core_PendingApproval_To_v1alpha1_PendingApproval(in, out, s)
+}
+
+func autoConvert_v1alpha1_PendingDeletion_To_core_PendingDeletion(in *PendingDeletion, out *core.PendingDeletion, s conversion.Scope) error {
+	out.Digest = in.Digest
+	out.Objects = *(*[]string)(unsafe.Pointer(&in.Objects))
+	out.TotalObjects = in.TotalObjects
+	return nil
+}
+
+// Convert_v1alpha1_PendingDeletion_To_core_PendingDeletion is an autogenerated conversion function.
+func Convert_v1alpha1_PendingDeletion_To_core_PendingDeletion(in *PendingDeletion, out *core.PendingDeletion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PendingDeletion_To_core_PendingDeletion(in, out, s)
+}
+
+func autoConvert_core_PendingDeletion_To_v1alpha1_PendingDeletion(in *core.PendingDeletion, out *PendingDeletion, s conversion.Scope) error {
+	out.Digest = in.Digest
+	out.Objects = *(*[]string)(unsafe.Pointer(&in.Objects))
+	out.TotalObjects = in.TotalObjects
+	return nil
+}
+
+// Convert_core_PendingDeletion_To_v1alpha1_PendingDeletion is an autogenerated conversion function.
+func Convert_core_PendingDeletion_To_v1alpha1_PendingDeletion(in *core.PendingDeletion, out *PendingDeletion, s conversion.Scope) error {
+	return autoConvert_core_PendingDeletion_To_v1alpha1_PendingDeletion(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(in *RemoteBlueprintReference, out *core.RemoteBlueprintReference, s conversion.Scope) error {
 	out.ResourceName = in.ResourceName
 	return nil
@@ -2869,6 +3839,136 @@ func Convert_core_ResourceReference_To_v1alpha1_ResourceReference(in *core.Resou
 	return autoConvert_core_ResourceReference_To_v1alpha1_ResourceReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_RetryBackoff_To_core_RetryBackoff(in *RetryBackoff, out *core.RetryBackoff, s conversion.Scope) error {
+	out.InitialInterval = (*core.Duration)(unsafe.Pointer(in.InitialInterval))
+	out.MaxInterval = (*core.Duration)(unsafe.Pointer(in.MaxInterval))
+	out.Factor = (*int32)(unsafe.Pointer(in.Factor))
+	out.JitterPercentage = (*int32)(unsafe.Pointer(in.JitterPercentage))
+	return nil
+}
+
+// Convert_v1alpha1_RetryBackoff_To_core_RetryBackoff is an autogenerated conversion function.
+func Convert_v1alpha1_RetryBackoff_To_core_RetryBackoff(in *RetryBackoff, out *core.RetryBackoff, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RetryBackoff_To_core_RetryBackoff(in, out, s)
+}
+
+func autoConvert_core_RetryBackoff_To_v1alpha1_RetryBackoff(in *core.RetryBackoff, out *RetryBackoff, s conversion.Scope) error {
+	out.InitialInterval = (*Duration)(unsafe.Pointer(in.InitialInterval))
+	out.MaxInterval = (*Duration)(unsafe.Pointer(in.MaxInterval))
+	out.Factor = (*int32)(unsafe.Pointer(in.Factor))
+	out.JitterPercentage = (*int32)(unsafe.Pointer(in.JitterPercentage))
+	return nil
+}
+
+// Convert_core_RetryBackoff_To_v1alpha1_RetryBackoff is an autogenerated conversion function.
+func Convert_core_RetryBackoff_To_v1alpha1_RetryBackoff(in *core.RetryBackoff, out *RetryBackoff, s conversion.Scope) error {
+	return autoConvert_core_RetryBackoff_To_v1alpha1_RetryBackoff(in, out, s)
+}
+
+func autoConvert_v1alpha1_RetryPolicy_To_core_RetryPolicy(in *RetryPolicy, out *core.RetryPolicy, s conversion.Scope) error {
+	out.RetryOn = *(*[]core.ErrorCode)(unsafe.Pointer(&in.RetryOn))
+	out.NoRetryOn = *(*[]core.ErrorCode)(unsafe.Pointer(&in.NoRetryOn))
+	out.MaxAttempts = (*int32)(unsafe.Pointer(in.MaxAttempts))
+	out.Backoff = (*core.RetryBackoff)(unsafe.Pointer(in.Backoff))
+	return nil
+}
+
+// Convert_v1alpha1_RetryPolicy_To_core_RetryPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_RetryPolicy_To_core_RetryPolicy(in *RetryPolicy, out *core.RetryPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RetryPolicy_To_core_RetryPolicy(in, out, s)
+}
+
+func autoConvert_core_RetryPolicy_To_v1alpha1_RetryPolicy(in *core.RetryPolicy, out *RetryPolicy, s conversion.Scope) error {
+	out.RetryOn = *(*[]ErrorCode)(unsafe.Pointer(&in.RetryOn))
+	out.NoRetryOn = *(*[]ErrorCode)(unsafe.Pointer(&in.NoRetryOn))
+	out.MaxAttempts = (*int32)(unsafe.Pointer(in.MaxAttempts))
+	out.Backoff = (*RetryBackoff)(unsafe.Pointer(in.Backoff))
+	return nil
+}
+
+// Convert_core_RetryPolicy_To_v1alpha1_RetryPolicy is an autogenerated conversion function.
+func Convert_core_RetryPolicy_To_v1alpha1_RetryPolicy(in *core.RetryPolicy, out *RetryPolicy, s conversion.Scope) error {
+	return autoConvert_core_RetryPolicy_To_v1alpha1_RetryPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_RetryStatus_To_core_RetryStatus(in *RetryStatus, out *core.RetryStatus, s conversion.Scope) error {
+	out.Generation = in.Generation
+	out.Attempts = in.Attempts
+	out.LastAttemptTime = (*metav1.Time)(unsafe.Pointer(in.LastAttemptTime))
+	out.NextRetryTime = (*metav1.Time)(unsafe.Pointer(in.NextRetryTime))
+	return nil
+}
+
+// Convert_v1alpha1_RetryStatus_To_core_RetryStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RetryStatus_To_core_RetryStatus(in *RetryStatus, out *core.RetryStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RetryStatus_To_core_RetryStatus(in, out, s)
+}
+
+func autoConvert_core_RetryStatus_To_v1alpha1_RetryStatus(in *core.RetryStatus, out *RetryStatus, s conversion.Scope) error {
+	out.Generation = in.Generation
+	out.Attempts = in.Attempts
+	out.LastAttemptTime = (*metav1.Time)(unsafe.Pointer(in.LastAttemptTime))
+	out.NextRetryTime = (*metav1.Time)(unsafe.Pointer(in.NextRetryTime))
+	return nil
+}
+
+// Convert_core_RetryStatus_To_v1alpha1_RetryStatus is an autogenerated conversion function.
+func Convert_core_RetryStatus_To_v1alpha1_RetryStatus(in *core.RetryStatus, out *RetryStatus, s conversion.Scope) error {
+	return autoConvert_core_RetryStatus_To_v1alpha1_RetryStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutPolicy_To_core_RolloutPolicy(in *RolloutPolicy, out *core.RolloutPolicy, s conversion.Scope) error {
+	out.BatchSize = (*int32)(unsafe.Pointer(in.BatchSize))
+	out.BatchPercentage = (*int32)(unsafe.Pointer(in.BatchPercentage))
+	out.PauseBetweenWaves = (*core.Duration)(unsafe.Pointer(in.PauseBetweenWaves))
+	out.StopOnFailure = (*bool)(unsafe.Pointer(in.StopOnFailure))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutPolicy_To_core_RolloutPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutPolicy_To_core_RolloutPolicy(in *RolloutPolicy, out *core.RolloutPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutPolicy_To_core_RolloutPolicy(in, out, s)
+}
+
+func autoConvert_core_RolloutPolicy_To_v1alpha1_RolloutPolicy(in *core.RolloutPolicy, out *RolloutPolicy, s conversion.Scope) error {
+	out.BatchSize = (*int32)(unsafe.Pointer(in.BatchSize))
+	out.BatchPercentage = (*int32)(unsafe.Pointer(in.BatchPercentage))
+	out.PauseBetweenWaves = (*Duration)(unsafe.Pointer(in.PauseBetweenWaves))
+	out.StopOnFailure = (*bool)(unsafe.Pointer(in.StopOnFailure))
+	return nil
+}
+
+// Convert_core_RolloutPolicy_To_v1alpha1_RolloutPolicy is an autogenerated conversion function.
+func Convert_core_RolloutPolicy_To_v1alpha1_RolloutPolicy(in *core.RolloutPolicy, out *RolloutPolicy, s conversion.Scope) error {
+	return autoConvert_core_RolloutPolicy_To_v1alpha1_RolloutPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in *RolloutStatus, out *core.RolloutStatus, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.CurrentWave = in.CurrentWave
+	out.Waves = in.Waves
+	out.NextWaveTime = (*metav1.Time)(unsafe.Pointer(in.NextWaveTime))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in *RolloutStatus, out *core.RolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in, out, s)
+}
+
+func autoConvert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in *core.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.CurrentWave = in.CurrentWave
+	out.Waves = in.Waves
+	out.NextWaveTime = (*metav1.Time)(unsafe.Pointer(in.NextWaveTime))
+	return nil
+}
+
+// Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus is an autogenerated conversion function.
+func Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in *core.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	return autoConvert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(in *SecretLabelSelectorRef, out *core.SecretLabelSelectorRef, s conversion.Scope) error {
 	out.Selector = *(*map[string]string)(unsafe.Pointer(&in.Selector))
 	out.Key = in.Key
@@ -3031,6 +4131,38 @@ func Convert_core_SubinstallationTemplate_To_v1alpha1_SubinstallationTemplate(in
 	return autoConvert_core_SubinstallationTemplate_To_v1alpha1_SubinstallationTemplate(in, out, s)
 }
 
+func autoConvert_v1alpha1_SubtreeSummary_To_core_SubtreeSummary(in *SubtreeSummary, out *core.SubtreeSummary, s conversion.Scope) error {
+	out.Installations = *(*map[string]int32)(unsafe.Pointer(&in.Installations))
+	out.Executions = *(*map[string]int32)(unsafe.Pointer(&in.Executions))
+	out.DeployItems = *(*map[string]int32)(unsafe.Pointer(&in.DeployItems))
+	out.FailedObjects = *(*[]core.FailedObject)(unsafe.Pointer(&in.FailedObjects))
+	out.FinishedObjects = in.FinishedObjects
+	out.TotalObjects = in.TotalObjects
+	out.Progress = in.Progress
+	return nil
+}
+
+// Convert_v1alpha1_SubtreeSummary_To_core_SubtreeSummary is an autogenerated conversion function.
+func Convert_v1alpha1_SubtreeSummary_To_core_SubtreeSummary(in *SubtreeSummary, out *core.SubtreeSummary, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SubtreeSummary_To_core_SubtreeSummary(in, out, s)
+}
+
+func autoConvert_core_SubtreeSummary_To_v1alpha1_SubtreeSummary(in *core.SubtreeSummary, out *SubtreeSummary, s conversion.Scope) error {
+	out.Installations = *(*map[string]int32)(unsafe.Pointer(&in.Installations))
+	out.Executions = *(*map[string]int32)(unsafe.Pointer(&in.Executions))
+	out.DeployItems = *(*map[string]int32)(unsafe.Pointer(&in.DeployItems))
+	out.FailedObjects = *(*[]FailedObject)(unsafe.Pointer(&in.FailedObjects))
+	out.FinishedObjects = in.FinishedObjects
+	out.TotalObjects = in.TotalObjects
+	out.Progress = in.Progress
+	return nil
+}
+
+// Convert_core_SubtreeSummary_To_v1alpha1_SubtreeSummary is an autogenerated conversion function.
+func Convert_core_SubtreeSummary_To_v1alpha1_SubtreeSummary(in *core.SubtreeSummary, out *SubtreeSummary, s conversion.Scope) error {
+	return autoConvert_core_SubtreeSummary_To_v1alpha1_SubtreeSummary(in, out, s)
+}
+
 func autoConvert_v1alpha1_SucceededReconcile_To_core_SucceededReconcile(in *SucceededReconcile, out *core.SucceededReconcile, s conversion.Scope) error {
 	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
 	out.CronSpec = in.CronSpec
@@ -3158,6 +4290,9 @@ func autoConvert_v1alpha1_Target_To_core_Target(in *Target, out *core.Target, s
 	if err := Convert_v1alpha1_TargetSpec_To_core_TargetSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_TargetStatus_To_core_TargetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3171,6 +4306,9 @@ func autoConvert_core_Target_To_v1alpha1_Target(in *core.Target, out *Target, s
 	if err := Convert_core_TargetSpec_To_v1alpha1_TargetSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_TargetStatus_To_v1alpha1_TargetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3301,6 +4439,34 @@ func Convert_core_TargetSpec_To_v1alpha1_TargetSpec(in *core.TargetSpec, out *Ta
 	return autoConvert_core_TargetSpec_To_v1alpha1_TargetSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetStatus_To_core_TargetStatus(in *TargetStatus, out *core.TargetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ServerVersion = in.ServerVersion
+	out.CredentialsExpirationTime = (*metav1.Time)(unsafe.Pointer(in.CredentialsExpirationTime))
+	out.LastProbeTime = (*metav1.Time)(unsafe.Pointer(in.LastProbeTime))
+	return nil
+}
+
+// Convert_v1alpha1_TargetStatus_To_core_TargetStatus is an autogenerated conversion function.
+func Convert_v1alpha1_TargetStatus_To_core_TargetStatus(in *TargetStatus, out *core.TargetStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetStatus_To_core_TargetStatus(in, out, s)
+}
+
+func autoConvert_core_TargetStatus_To_v1alpha1_TargetStatus(in *core.TargetStatus, out *TargetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
+	out.ServerVersion = in.ServerVersion
+	out.CredentialsExpirationTime = (*metav1.Time)(unsafe.Pointer(in.CredentialsExpirationTime))
+	out.LastProbeTime = (*metav1.Time)(unsafe.Pointer(in.LastProbeTime))
+	return nil
+}
+
+// Convert_core_TargetStatus_To_v1alpha1_TargetStatus is an autogenerated conversion function.
+func Convert_core_TargetStatus_To_v1alpha1_TargetStatus(in *core.TargetStatus, out *TargetStatus, s conversion.Scope) error {
+	return autoConvert_core_TargetStatus_To_v1alpha1_TargetStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_TargetSync_To_core_TargetSync(in *TargetSync, out *core.TargetSync, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_TargetSyncSpec_To_core_TargetSyncSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -3333,6 +4499,26 @@ func Convert_core_TargetSync_To_v1alpha1_TargetSync(in *core.TargetSync, out *Ta
 	return autoConvert_core_TargetSync_To_v1alpha1_TargetSync(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetSyncClusterAPISource_To_core_TargetSyncClusterAPISource(in *TargetSyncClusterAPISource, out *core.TargetSyncClusterAPISource, s conversion.Scope) error {
+	out.ClusterNameExpression = in.ClusterNameExpression
+	return nil
+}
+
+// Convert_v1alpha1_TargetSyncClusterAPISource_To_core_TargetSyncClusterAPISource is an autogenerated conversion function.
+func Convert_v1alpha1_TargetSyncClusterAPISource_To_core_TargetSyncClusterAPISource(in *TargetSyncClusterAPISource, out *core.TargetSyncClusterAPISource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetSyncClusterAPISource_To_core_TargetSyncClusterAPISource(in, out, s)
+}
+
+func autoConvert_core_TargetSyncClusterAPISource_To_v1alpha1_TargetSyncClusterAPISource(in *core.TargetSyncClusterAPISource, out *TargetSyncClusterAPISource, s conversion.Scope) error {
+	out.ClusterNameExpression = in.ClusterNameExpression
+	return nil
+}
+
+// Convert_core_TargetSyncClusterAPISource_To_v1alpha1_TargetSyncClusterAPISource is an autogenerated conversion function.
+func Convert_core_TargetSyncClusterAPISource_To_v1alpha1_TargetSyncClusterAPISource(in *core.TargetSyncClusterAPISource, out *TargetSyncClusterAPISource, s conversion.Scope) error {
+	return autoConvert_core_TargetSyncClusterAPISource_To_v1alpha1_TargetSyncClusterAPISource(in, out, s)
+}
+
 func autoConvert_v1alpha1_TargetSyncList_To_core_TargetSyncList(in *TargetSyncList, out *core.TargetSyncList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.TargetSync)(unsafe.Pointer(&in.Items))
@@ -3355,6 +4541,56 @@ func Convert_core_TargetSyncList_To_v1alpha1_TargetSyncList(in *core.TargetSyncL
 	return autoConvert_core_TargetSyncList_To_v1alpha1_TargetSyncList(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetSyncSecretSelectorSource_To_core_TargetSyncSecretSelectorSource(in *TargetSyncSecretSelectorSource, out *core.TargetSyncSecretSelectorSource, s conversion.Scope) error {
+	out.LabelSelector = in.LabelSelector
+	out.KubeconfigKey = in.KubeconfigKey
+	return nil
+}
+
+// Convert_v1alpha1_TargetSyncSecretSelectorSource_To_core_TargetSyncSecretSelectorSource is an autogenerated conversion function.
+func Convert_v1alpha1_TargetSyncSecretSelectorSource_To_core_TargetSyncSecretSelectorSource(in *TargetSyncSecretSelectorSource, out *core.TargetSyncSecretSelectorSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetSyncSecretSelectorSource_To_core_TargetSyncSecretSelectorSource(in, out, s)
+}
+
+func autoConvert_core_TargetSyncSecretSelectorSource_To_v1alpha1_TargetSyncSecretSelectorSource(in *core.TargetSyncSecretSelectorSource, out *TargetSyncSecretSelectorSource, s conversion.Scope) error {
+	out.LabelSelector = in.LabelSelector
+	out.KubeconfigKey = in.KubeconfigKey
+	return nil
+}
+
+// Convert_core_TargetSyncSecretSelectorSource_To_v1alpha1_TargetSyncSecretSelectorSource is an autogenerated conversion function.
+func Convert_core_TargetSyncSecretSelectorSource_To_v1alpha1_TargetSyncSecretSelectorSource(in *core.TargetSyncSecretSelectorSource, out *TargetSyncSecretSelectorSource, s conversion.Scope) error {
+	return autoConvert_core_TargetSyncSecretSelectorSource_To_v1alpha1_TargetSyncSecretSelectorSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetSyncSourceStatus_To_core_TargetSyncSourceStatus(in *TargetSyncSourceStatus, out *core.TargetSyncSourceStatus, s conversion.Scope) error {
+	out.Type = core.TargetSyncSourceType(in.Type)
+	out.CreatedTargets = *(*[]string)(unsafe.Pointer(&in.CreatedTargets))
+	out.UpdatedTargets = *(*[]string)(unsafe.Pointer(&in.UpdatedTargets))
+	out.RemovedTargets = *(*[]string)(unsafe.Pointer(&in.RemovedTargets))
+	out.ConflictingTargets = *(*[]string)(unsafe.Pointer(&in.ConflictingTargets))
+	return nil
+}
+
+// Convert_v1alpha1_TargetSyncSourceStatus_To_core_TargetSyncSourceStatus is an autogenerated conversion function.
+func Convert_v1alpha1_TargetSyncSourceStatus_To_core_TargetSyncSourceStatus(in *TargetSyncSourceStatus, out *core.TargetSyncSourceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetSyncSourceStatus_To_core_TargetSyncSourceStatus(in, out, s)
+}
+
+func autoConvert_core_TargetSyncSourceStatus_To_v1alpha1_TargetSyncSourceStatus(in *core.TargetSyncSourceStatus, out *TargetSyncSourceStatus, s conversion.Scope) error {
+	out.Type = TargetSyncSourceType(in.Type)
+	out.CreatedTargets = *(*[]string)(unsafe.Pointer(&in.CreatedTargets))
+	out.UpdatedTargets = *(*[]string)(unsafe.Pointer(&in.UpdatedTargets))
+	out.RemovedTargets = *(*[]string)(unsafe.Pointer(&in.RemovedTargets))
+	out.ConflictingTargets = *(*[]string)(unsafe.Pointer(&in.ConflictingTargets))
+	return nil
+}
+
+// Convert_core_TargetSyncSourceStatus_To_v1alpha1_TargetSyncSourceStatus is an autogenerated conversion function.
+func Convert_core_TargetSyncSourceStatus_To_v1alpha1_TargetSyncSourceStatus(in *core.TargetSyncSourceStatus, out *TargetSyncSourceStatus, s conversion.Scope) error {
+	return autoConvert_core_TargetSyncSourceStatus_To_v1alpha1_TargetSyncSourceStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_TargetSyncSpec_To_core_TargetSyncSpec(in *TargetSyncSpec, out *core.TargetSyncSpec, s conversion.Scope) error {
 	out.SourceNamespace = in.SourceNamespace
 	if err := Convert_v1alpha1_LocalSecretReference_To_core_LocalSecretReference(&in.SecretRef, &out.SecretRef, s); err != nil {
@@ -3364,6 +4600,8 @@ func autoConvert_v1alpha1_TargetSyncSpec_To_core_TargetSyncSpec(in *TargetSyncSp
 	out.TargetToSourceName = in.TargetToSourceName
 	out.SecretNameExpression = in.SecretNameExpression
 	out.ShootNameExpression = in.ShootNameExpression
+	out.ClusterAPI = (*core.TargetSyncClusterAPISource)(unsafe.Pointer(in.ClusterAPI))
+	out.SecretSelector = (*core.TargetSyncSecretSelectorSource)(unsafe.Pointer(in.SecretSelector))
 	out.TokenRotation = (*core.TokenRotation)(unsafe.Pointer(in.TokenRotation))
 	return nil
 }
@@ -3382,6 +4620,8 @@ func autoConvert_core_TargetSyncSpec_To_v1alpha1_TargetSyncSpec(in *core.TargetS
 	out.TargetToSourceName = in.TargetToSourceName
 	out.SecretNameExpression = in.SecretNameExpression
 	out.ShootNameExpression = in.ShootNameExpression
+	out.ClusterAPI = (*TargetSyncClusterAPISource)(unsafe.Pointer(in.ClusterAPI))
+	out.SecretSelector = (*TargetSyncSecretSelectorSource)(unsafe.Pointer(in.SecretSelector))
 	out.TokenRotation = (*TokenRotation)(unsafe.Pointer(in.TokenRotation))
 	return nil
 }
@@ -3396,6 +4636,7 @@ func autoConvert_v1alpha1_TargetSyncStatus_To_core_TargetSyncStatus(in *TargetSy
 	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	out.LastErrors = *(*[]string)(unsafe.Pointer(&in.LastErrors))
 	out.LastTokenRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
+	out.Sources = *(*[]core.TargetSyncSourceStatus)(unsafe.Pointer(&in.Sources))
 	return nil
 }
 
@@ -3409,6 +4650,7 @@ func autoConvert_core_TargetSyncStatus_To_v1alpha1_TargetSyncStatus(in *core.Tar
 	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	out.LastErrors = *(*[]string)(unsafe.Pointer(&in.LastErrors))
 	out.LastTokenRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
+	out.Sources = *(*[]TargetSyncSourceStatus)(unsafe.Pointer(&in.Sources))
 	return nil
 }
 
@@ -3445,6 +4687,76 @@ func Convert_core_TargetTemplate_To_v1alpha1_TargetTemplate(in *core.TargetTempl
 	return autoConvert_core_TargetTemplate_To_v1alpha1_TargetTemplate(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetTypeDefinition_To_core_TargetTypeDefinition(in *TargetTypeDefinition, out *core.TargetTypeDefinition, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TargetTypeDefinition_To_core_TargetTypeDefinition is an autogenerated conversion function.
+func Convert_v1alpha1_TargetTypeDefinition_To_core_TargetTypeDefinition(in *TargetTypeDefinition, out *core.TargetTypeDefinition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetTypeDefinition_To_core_TargetTypeDefinition(in, out, s)
+}
+
+func autoConvert_core_TargetTypeDefinition_To_v1alpha1_TargetTypeDefinition(in *core.TargetTypeDefinition, out *TargetTypeDefinition, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_TargetTypeDefinition_To_v1alpha1_TargetTypeDefinition is an autogenerated conversion function.
+func Convert_core_TargetTypeDefinition_To_v1alpha1_TargetTypeDefinition(in *core.TargetTypeDefinition, out *TargetTypeDefinition, s conversion.Scope) error {
+	return autoConvert_core_TargetTypeDefinition_To_v1alpha1_TargetTypeDefinition(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetTypeDefinitionList_To_core_TargetTypeDefinitionList(in *TargetTypeDefinitionList, out *core.TargetTypeDefinitionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.TargetTypeDefinition)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_TargetTypeDefinitionList_To_core_TargetTypeDefinitionList is an autogenerated conversion function.
+func Convert_v1alpha1_TargetTypeDefinitionList_To_core_TargetTypeDefinitionList(in *TargetTypeDefinitionList, out *core.TargetTypeDefinitionList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetTypeDefinitionList_To_core_TargetTypeDefinitionList(in, out, s)
+}
+
+func autoConvert_core_TargetTypeDefinitionList_To_v1alpha1_TargetTypeDefinitionList(in *core.TargetTypeDefinitionList, out *TargetTypeDefinitionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]TargetTypeDefinition)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_TargetTypeDefinitionList_To_v1alpha1_TargetTypeDefinitionList is an autogenerated conversion function.
+func Convert_core_TargetTypeDefinitionList_To_v1alpha1_TargetTypeDefinitionList(in *core.TargetTypeDefinitionList, out *TargetTypeDefinitionList, s conversion.Scope) error {
+	return autoConvert_core_TargetTypeDefinitionList_To_v1alpha1_TargetTypeDefinitionList(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec(in *TargetTypeDefinitionSpec, out *core.TargetTypeDefinitionSpec, s conversion.Scope) error {
+	out.Type = core.TargetType(in.Type)
+	out.Schema = (*core.JSONSchemaDefinition)(unsafe.Pointer(in.Schema))
+	return nil
+}
+
+// Convert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec is an autogenerated conversion function.
+func Convert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec(in *TargetTypeDefinitionSpec, out *core.TargetTypeDefinitionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec(in, out, s)
+}
+
+func autoConvert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec(in *core.TargetTypeDefinitionSpec, out *TargetTypeDefinitionSpec, s conversion.Scope) error {
+	out.Type = TargetType(in.Type)
+	out.Schema = (*JSONSchemaDefinition)(unsafe.Pointer(in.Schema))
+	return nil
+}
+
+// Convert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec is an autogenerated conversion function.
+func Convert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec(in *core.TargetTypeDefinitionSpec, out *TargetTypeDefinitionSpec, s conversion.Scope) error {
+	return autoConvert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_TemplateExecutor_To_core_TemplateExecutor(in *TemplateExecutor, out *core.TemplateExecutor, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Type = core.TemplateType(in.Type)
@@ -3475,6 +4787,82 @@ func Convert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(in *core.Templat
 	return autoConvert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(in, out, s)
 }
 
+func autoConvert_v1alpha1_TestResult_To_core_TestResult(in *TestResult, out *core.TestResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DeployItem = (*core.ObjectReference)(unsafe.Pointer(in.DeployItem))
+	out.Phase = core.DeployItemPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_TestResult_To_core_TestResult is an autogenerated conversion function.
+func Convert_v1alpha1_TestResult_To_core_TestResult(in *TestResult, out *core.TestResult, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestResult_To_core_TestResult(in, out, s)
+}
+
+func autoConvert_core_TestResult_To_v1alpha1_TestResult(in *core.TestResult, out *TestResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DeployItem = (*ObjectReference)(unsafe.Pointer(in.DeployItem))
+	out.Phase = DeployItemPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_TestResult_To_v1alpha1_TestResult is an autogenerated conversion function.
+func Convert_core_TestResult_To_v1alpha1_TestResult(in *core.TestResult, out *TestResult, s conversion.Scope) error {
+	return autoConvert_core_TestResult_To_v1alpha1_TestResult(in, out, s)
+}
+
+func autoConvert_v1alpha1_TestStatus_To_core_TestStatus(in *TestStatus, out *core.TestStatus, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.Phase = core.ExecutionPhase(in.Phase)
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.Results = *(*[]core.TestResult)(unsafe.Pointer(&in.Results))
+	return nil
+}
+
+// Convert_v1alpha1_TestStatus_To_core_TestStatus is an autogenerated conversion function.
+func Convert_v1alpha1_TestStatus_To_core_TestStatus(in *TestStatus, out *core.TestStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestStatus_To_core_TestStatus(in, out, s)
+}
+
+func autoConvert_core_TestStatus_To_v1alpha1_TestStatus(in *core.TestStatus, out *TestStatus, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.Phase = ExecutionPhase(in.Phase)
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.Results = *(*[]TestResult)(unsafe.Pointer(&in.Results))
+	return nil
+}
+
+// Convert_core_TestStatus_To_v1alpha1_TestStatus is an autogenerated conversion function.
+func Convert_core_TestStatus_To_v1alpha1_TestStatus(in *core.TestStatus, out *TestStatus, s conversion.Scope) error {
+	return autoConvert_core_TestStatus_To_v1alpha1_TestStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_TimeoutDetails_To_core_TimeoutDetails(in *TimeoutDetails, out *core.TimeoutDetails, s conversion.Scope) error {
+	out.Deadline = in.Deadline
+	out.SlowestChild = (*core.IncompleteChild)(unsafe.Pointer(in.SlowestChild))
+	return nil
+}
+
+// Convert_v1alpha1_TimeoutDetails_To_core_TimeoutDetails is an autogenerated conversion function.
+func Convert_v1alpha1_TimeoutDetails_To_core_TimeoutDetails(in *TimeoutDetails, out *core.TimeoutDetails, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TimeoutDetails_To_core_TimeoutDetails(in, out, s)
+}
+
+func autoConvert_core_TimeoutDetails_To_v1alpha1_TimeoutDetails(in *core.TimeoutDetails, out *TimeoutDetails, s conversion.Scope) error {
+	out.Deadline = in.Deadline
+	out.SlowestChild = (*IncompleteChild)(unsafe.Pointer(in.SlowestChild))
+	return nil
+}
+
+// Convert_core_TimeoutDetails_To_v1alpha1_TimeoutDetails is an autogenerated conversion function.
+func Convert_core_TimeoutDetails_To_v1alpha1_TimeoutDetails(in *core.TimeoutDetails, out *TimeoutDetails, s conversion.Scope) error {
+	return autoConvert_core_TimeoutDetails_To_v1alpha1_TimeoutDetails(in, out, s)
+}
+
 func autoConvert_v1alpha1_TokenRotation_To_core_TokenRotation(in *TokenRotation, out *core.TokenRotation, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -74,6 +74,27 @@ func (in *AutomaticReconcileStatus) DeepCopy() *AutomaticReconcileStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockingObject) DeepCopyInto(out *BlockingObject) {
+	*out = *in
+	out.Object = in.Object
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockingObject.
+func (in *BlockingObject) DeepCopy() *BlockingObject {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockingObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Blueprint) DeepCopyInto(out *Blueprint) {
 	*out = *in
@@ -134,6 +155,18 @@ func (in *Blueprint) DeepCopyInto(out *Blueprint) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TestExecutions != nil {
+		in, out := &in.TestExecutions, &out.TestExecutions
+		*out = make([]TemplateExecutor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxParallelDeployItems != nil {
+		in, out := &in.MaxParallelDeployItems, &out.MaxParallelDeployItems
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ExportExecutions != nil {
 		in, out := &in.ExportExecutions, &out.ExportExecutions
 		*out = make([]TemplateExecutor, len(*in))
@@ -641,6 +674,11 @@ func (in *CriticalProblemsStatus) DeepCopy() *CriticalProblemsStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataExport) DeepCopyInto(out *DataExport) {
 	*out = *in
+	if in.Aggregate != nil {
+		in, out := &in.Aggregate, &out.Aggregate
+		*out = new(DataExportAggregation)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataExport.
@@ -653,6 +691,21 @@ func (in *DataExport) DeepCopy() *DataExport {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataExportAggregation) DeepCopyInto(out *DataExportAggregation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataExportAggregation.
+func (in *DataExportAggregation) DeepCopy() *DataExportAggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(DataExportAggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataImport) DeepCopyInto(out *DataImport) {
 	*out = *in
@@ -666,6 +719,11 @@ func (in *DataImport) DeepCopyInto(out *DataImport) {
 		*out = new(LocalConfigMapReference)
 		**out = **in
 	}
+	if in.Aggregated != nil {
+		in, out := &in.Aggregated, &out.Aggregated
+		*out = new(DataImportAggregation)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImport.
@@ -678,6 +736,21 @@ func (in *DataImport) DeepCopy() *DataImport {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportAggregation) DeepCopyInto(out *DataImportAggregation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportAggregation.
+func (in *DataImportAggregation) DeepCopy() *DataImportAggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportAggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataObject) DeepCopyInto(out *DataObject) {
 	*out = *in
@@ -752,6 +825,42 @@ func (in *Default) DeepCopy() *Default {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionImpact) DeepCopyInto(out *DeletionImpact) {
+	*out = *in
+	in.ComputationTime.DeepCopyInto(&out.ComputationTime)
+	if in.Installations != nil {
+		in, out := &in.Installations, &out.Installations
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Executions != nil {
+		in, out := &in.Executions, &out.Executions
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedResources != nil {
+		in, out := &in.ManagedResources, &out.ManagedResources
+		*out = make([]ManagedResourceReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionImpact.
+func (in *DeletionImpact) DeepCopy() *DeletionImpact {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionImpact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DependentToTrigger) DeepCopyInto(out *DependentToTrigger) {
 	*out = *in
@@ -819,6 +928,26 @@ func (in *DeployItemCache) DeepCopy() *DeployItemCache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemForEach) DeepCopyInto(out *DeployItemForEach) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = new(AnyJSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemForEach.
+func (in *DeployItemForEach) DeepCopy() *DeployItemForEach {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemForEach)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemList) DeepCopyInto(out *DeployItemList) {
 	*out = *in
@@ -946,6 +1075,11 @@ func (in *DeployItemStatus) DeepCopyInto(out *DeployItemStatus) {
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemStatus.
@@ -993,6 +1127,21 @@ func (in *DeployItemTemplate) DeepCopyInto(out *DeployItemTemplate) {
 		*out = new(OnDeleteConfig)
 		**out = **in
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.When != nil {
+		in, out := &in.When, &out.When
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForEach != nil {
+		in, out := &in.ForEach, &out.ForEach
+		*out = new(DeployItemForEach)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemTemplate.
@@ -1167,6 +1316,16 @@ func (in *ExecutionSpec) DeepCopyInto(out *ExecutionSpec) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxParallel != nil {
+		in, out := &in.MaxParallel, &out.MaxParallel
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionSpec.
@@ -1213,6 +1372,25 @@ func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingDeletion != nil {
+		in, out := &in.PendingDeletion, &out.PendingDeletion
+		*out = new(PendingDeletion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingApproval != nil {
+		in, out := &in.PendingApproval, &out.PendingApproval
+		*out = new(PendingApproval)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionStatus.
@@ -1262,6 +1440,27 @@ func (in ExportDefinitionList) DeepCopy() ExportDefinitionList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedObject) DeepCopyInto(out *FailedObject) {
+	*out = *in
+	out.Object = in.Object
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]ErrorCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedObject.
+func (in *FailedObject) DeepCopy() *FailedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailedReconcile) DeepCopyInto(out *FailedReconcile) {
 	*out = *in
@@ -1357,6 +1556,26 @@ func (in ImportDefinitionList) DeepCopy() ImportDefinitionList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncompleteChild) DeepCopyInto(out *IncompleteChild) {
+	*out = *in
+	out.Object = in.Object
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncompleteChild.
+func (in *IncompleteChild) DeepCopy() *IncompleteChild {
+	if in == nil {
+		return nil
+	}
+	out := new(IncompleteChild)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InlineBlueprint) DeepCopyInto(out *InlineBlueprint) {
 	*out = *in
@@ -1406,7 +1625,9 @@ func (in *InstallationExports) DeepCopyInto(out *InstallationExports) {
 	if in.Data != nil {
 		in, out := &in.Data, &out.Data
 		*out = make([]DataExport, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Targets != nil {
 		in, out := &in.Targets, &out.Targets
@@ -1487,19 +1708,35 @@ func (in *InstallationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
+func (in *InstallationSet) DeepCopyInto(out *InstallationSet) {
 	*out = *in
-	if in.Verification != nil {
-		in, out := &in.Verification, &out.Verification
-		*out = new(Verification)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSet.
+func (in *InstallationSet) DeepCopy() *InstallationSet {
+	if in == nil {
+		return nil
 	}
-	if in.ComponentDescriptor != nil {
-		in, out := &in.ComponentDescriptor, &out.ComponentDescriptor
-		*out = new(ComponentDescriptorDefinition)
-		(*in).DeepCopyInto(*out)
+	out := new(InstallationSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstallationSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	in.Blueprint.DeepCopyInto(&out.Blueprint)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationSetElementOverride) DeepCopyInto(out *InstallationSetElementOverride) {
+	*out = *in
 	in.Imports.DeepCopyInto(&out.Imports)
 	if in.ImportDataMappings != nil {
 		in, out := &in.ImportDataMappings, &out.ImportDataMappings
@@ -1508,96 +1745,234 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
-	in.Exports.DeepCopyInto(&out.Exports)
-	if in.ExportDataMappings != nil {
-		in, out := &in.ExportDataMappings, &out.ExportDataMappings
-		*out = make(map[string]AnyJSON, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
-	}
-	if in.AutomaticReconcile != nil {
-		in, out := &in.AutomaticReconcile, &out.AutomaticReconcile
-		*out = new(AutomaticReconcile)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Optimization != nil {
-		in, out := &in.Optimization, &out.Optimization
-		*out = new(Optimization)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSpec.
-func (in *InstallationSpec) DeepCopy() *InstallationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSetElementOverride.
+func (in *InstallationSetElementOverride) DeepCopy() *InstallationSetElementOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(InstallationSpec)
+	out := new(InstallationSetElementOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
+func (in *InstallationSetGenerator) DeepCopyInto(out *InstallationSetGenerator) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.LastError != nil {
-		in, out := &in.LastError, &out.LastError
-		*out = new(Error)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SubInstCache != nil {
-		in, out := &in.SubInstCache, &out.SubInstCache
-		*out = new(SubInstCache)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = new(InstallationSetTargetsGenerator)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ExecutionReference != nil {
-		in, out := &in.ExecutionReference, &out.ExecutionReference
-		*out = new(ObjectReference)
-		**out = **in
-	}
-	if in.PhaseTransitionTime != nil {
-		in, out := &in.PhaseTransitionTime, &out.PhaseTransitionTime
-		*out = (*in).DeepCopy()
-	}
-	if in.AutomaticReconcileStatus != nil {
-		in, out := &in.AutomaticReconcileStatus, &out.AutomaticReconcileStatus
-		*out = new(AutomaticReconcileStatus)
-		(*in).DeepCopyInto(*out)
+	if in.TargetMap != nil {
+		in, out := &in.TargetMap, &out.TargetMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.DependentsToTrigger != nil {
-		in, out := &in.DependentsToTrigger, &out.DependentsToTrigger
-		*out = make([]DependentToTrigger, len(*in))
-		copy(*out, *in)
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]InstallationSetParameterSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.TransitionTimes != nil {
-		in, out := &in.TransitionTimes, &out.TransitionTimes
-		*out = new(TransitionTimes)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSetGenerator.
+func (in *InstallationSetGenerator) DeepCopy() *InstallationSetGenerator {
+	if in == nil {
+		return nil
 	}
+	out := new(InstallationSetGenerator)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationStatus.
-func (in *InstallationStatus) DeepCopy() *InstallationStatus {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationSetInstallationStatus) DeepCopyInto(out *InstallationSetInstallationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSetInstallationStatus.
+func (in *InstallationSetInstallationStatus) DeepCopy() *InstallationSetInstallationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InstallationStatus)
+	out := new(InstallationSetInstallationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstallationTemplate) DeepCopyInto(out *InstallationTemplate) {
+func (in *InstallationSetList) DeepCopyInto(out *InstallationSetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstallationSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSetList.
+func (in *InstallationSetList) DeepCopy() *InstallationSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstallationSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationSetParameterSet) DeepCopyInto(out *InstallationSetParameterSet) {
+	*out = *in
+	if in.ImportDataMappings != nil {
+		in, out := &in.ImportDataMappings, &out.ImportDataMappings
+		*out = make(map[string]AnyJSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSetParameterSet.
+func (in *InstallationSetParameterSet) DeepCopy() *InstallationSetParameterSet {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationSetParameterSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationSetSpec) DeepCopyInto(out *InstallationSetSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	in.Generator.DeepCopyInto(&out.Generator)
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]InstallationSetElementOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxProgressing != nil {
+		in, out := &in.MaxProgressing, &out.MaxProgressing
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSetSpec.
+func (in *InstallationSetSpec) DeepCopy() *InstallationSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationSetStatus) DeepCopyInto(out *InstallationSetStatus) {
+	*out = *in
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Installations != nil {
+		in, out := &in.Installations, &out.Installations
+		*out = make([]InstallationSetInstallationStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSetStatus.
+func (in *InstallationSetStatus) DeepCopy() *InstallationSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationSetTargetsGenerator) DeepCopyInto(out *InstallationSetTargetsGenerator) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSetTargetsGenerator.
+func (in *InstallationSetTargetsGenerator) DeepCopy() *InstallationSetTargetsGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationSetTargetsGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationSetTemplate) DeepCopyInto(out *InstallationSetTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSetTemplate.
+func (in *InstallationSetTemplate) DeepCopy() *InstallationSetTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationSetTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
+	*out = *in
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(Verification)
+		**out = **in
+	}
+	if in.ComponentDescriptor != nil {
+		in, out := &in.ComponentDescriptor, &out.ComponentDescriptor
+		*out = new(ComponentDescriptorDefinition)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Blueprint.DeepCopyInto(&out.Blueprint)
 	in.Imports.DeepCopyInto(&out.Imports)
 	if in.ImportDataMappings != nil {
@@ -1615,11 +1990,192 @@ func (in *InstallationTemplate) DeepCopyInto(out *InstallationTemplate) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.AutomaticReconcile != nil {
+		in, out := &in.AutomaticReconcile, &out.AutomaticReconcile
+		*out = new(AutomaticReconcile)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Optimization != nil {
 		in, out := &in.Optimization, &out.Optimization
 		*out = new(Optimization)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequireApproval != nil {
+		in, out := &in.RequireApproval, &out.RequireApproval
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSpec.
+func (in *InstallationSpec) DeepCopy() *InstallationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubInstCache != nil {
+		in, out := &in.SubInstCache, &out.SubInstCache
+		*out = new(SubInstCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExecutionReference != nil {
+		in, out := &in.ExecutionReference, &out.ExecutionReference
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.TestExecutionReference != nil {
+		in, out := &in.TestExecutionReference, &out.TestExecutionReference
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.PhaseTransitionTime != nil {
+		in, out := &in.PhaseTransitionTime, &out.PhaseTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AutomaticReconcileStatus != nil {
+		in, out := &in.AutomaticReconcileStatus, &out.AutomaticReconcileStatus
+		*out = new(AutomaticReconcileStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependentsToTrigger != nil {
+		in, out := &in.DependentsToTrigger, &out.DependentsToTrigger
+		*out = make([]DependentToTrigger, len(*in))
+		copy(*out, *in)
+	}
+	if in.TransitionTimes != nil {
+		in, out := &in.TransitionTimes, &out.TransitionTimes
+		*out = new(TransitionTimes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlockedBy != nil {
+		in, out := &in.BlockedBy, &out.BlockedBy
+		*out = make([]BlockingObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(SubtreeSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingDeletion != nil {
+		in, out := &in.PendingDeletion, &out.PendingDeletion
+		*out = new(PendingDeletion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionImpact != nil {
+		in, out := &in.DeletionImpact, &out.DeletionImpact
+		*out = new(DeletionImpact)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimedOut != nil {
+		in, out := &in.TimedOut, &out.TimedOut
+		*out = new(TimeoutDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = new(TestStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationStatus.
+func (in *InstallationStatus) DeepCopy() *InstallationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationTemplate) DeepCopyInto(out *InstallationTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Blueprint.DeepCopyInto(&out.Blueprint)
+	in.Imports.DeepCopyInto(&out.Imports)
+	if in.ImportDataMappings != nil {
+		in, out := &in.ImportDataMappings, &out.ImportDataMappings
+		*out = make(map[string]AnyJSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	in.Exports.DeepCopyInto(&out.Exports)
+	if in.ExportDataMappings != nil {
+		in, out := &in.ExportDataMappings, &out.ExportDataMappings
+		*out = make(map[string]AnyJSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Optimization != nil {
+		in, out := &in.Optimization, &out.Optimization
+		*out = new(Optimization)
+		**out = **in
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationTemplate.
@@ -1789,6 +2345,22 @@ func (in *LsHealthCheckList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedResourceReference) DeepCopyInto(out *ManagedResourceReference) {
+	*out = *in
+	out.DeployItem = in.DeployItem
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedResourceReference.
+func (in *ManagedResourceReference) DeepCopy() *ManagedResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedObjectReference) DeepCopyInto(out *NamedObjectReference) {
 	*out = *in
@@ -1850,72 +2422,259 @@ func (in *Optimization) DeepCopy() *Optimization {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingApproval) DeepCopyInto(out *PendingApproval) {
+	*out = *in
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingApproval.
+func (in *PendingApproval) DeepCopy() *PendingApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingDeletion) DeepCopyInto(out *PendingDeletion) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingDeletion.
+func (in *PendingDeletion) DeepCopy() *PendingDeletion {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingDeletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteBlueprintReference) DeepCopyInto(out *RemoteBlueprintReference) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteBlueprintReference.
-func (in *RemoteBlueprintReference) DeepCopy() *RemoteBlueprintReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteBlueprintReference.
+func (in *RemoteBlueprintReference) DeepCopy() *RemoteBlueprintReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteBlueprintReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Requirement) DeepCopyInto(out *Requirement) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Requirement.
+func (in *Requirement) DeepCopy() *Requirement {
+	if in == nil {
+		return nil
+	}
+	out := new(Requirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedTarget) DeepCopyInto(out *ResolvedTarget) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(Target)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedTarget.
+func (in *ResolvedTarget) DeepCopy() *ResolvedTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
+func (in *ResourceReference) DeepCopy() *ResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+	*out = *in
+	if in.InitialInterval != nil {
+		in, out := &in.InitialInterval, &out.InitialInterval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(int32)
+		**out = **in
+	}
+	if in.JitterPercentage != nil {
+		in, out := &in.JitterPercentage, &out.JitterPercentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
+func (in *RetryBackoff) DeepCopy() *RetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]ErrorCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.NoRetryOn != nil {
+		in, out := &in.NoRetryOn, &out.NoRetryOn
+		*out = make([]ErrorCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(RemoteBlueprintReference)
+	out := new(RetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Requirement) DeepCopyInto(out *Requirement) {
+func (in *RetryStatus) DeepCopyInto(out *RetryStatus) {
 	*out = *in
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Requirement.
-func (in *Requirement) DeepCopy() *Requirement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStatus.
+func (in *RetryStatus) DeepCopy() *RetryStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Requirement)
+	out := new(RetryStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResolvedTarget) DeepCopyInto(out *ResolvedTarget) {
+func (in *RolloutPolicy) DeepCopyInto(out *RolloutPolicy) {
 	*out = *in
-	if in.Target != nil {
-		in, out := &in.Target, &out.Target
-		*out = new(Target)
-		(*in).DeepCopyInto(*out)
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BatchPercentage != nil {
+		in, out := &in.BatchPercentage, &out.BatchPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PauseBetweenWaves != nil {
+		in, out := &in.PauseBetweenWaves, &out.PauseBetweenWaves
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.StopOnFailure != nil {
+		in, out := &in.StopOnFailure, &out.StopOnFailure
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedTarget.
-func (in *ResolvedTarget) DeepCopy() *ResolvedTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicy.
+func (in *RolloutPolicy) DeepCopy() *RolloutPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ResolvedTarget)
+	out := new(RolloutPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
 	*out = *in
+	if in.NextWaveTime != nil {
+		in, out := &in.NextWaveTime, &out.NextWaveTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
-func (in *ResourceReference) DeepCopy() *ResourceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceReference)
+	out := new(RolloutStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2085,6 +2844,49 @@ func (in SubinstallationTemplateList) DeepCopy() SubinstallationTemplateList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubtreeSummary) DeepCopyInto(out *SubtreeSummary) {
+	*out = *in
+	if in.Installations != nil {
+		in, out := &in.Installations, &out.Installations
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Executions != nil {
+		in, out := &in.Executions, &out.Executions
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FailedObjects != nil {
+		in, out := &in.FailedObjects, &out.FailedObjects
+		*out = make([]FailedObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubtreeSummary.
+func (in *SubtreeSummary) DeepCopy() *SubtreeSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(SubtreeSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SucceededReconcile) DeepCopyInto(out *SucceededReconcile) {
 	*out = *in
@@ -2201,6 +3003,7 @@ func (in *Target) DeepCopyInto(out *Target) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Target.
@@ -2354,6 +3157,36 @@ func (in *TargetSpec) DeepCopy() *TargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CredentialsExpirationTime != nil {
+		in, out := &in.CredentialsExpirationTime, &out.CredentialsExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetStatus.
+func (in *TargetStatus) DeepCopy() *TargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSync) DeepCopyInto(out *TargetSync) {
 	*out = *in
@@ -2381,6 +3214,21 @@ func (in *TargetSync) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSyncClusterAPISource) DeepCopyInto(out *TargetSyncClusterAPISource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSyncClusterAPISource.
+func (in *TargetSyncClusterAPISource) DeepCopy() *TargetSyncClusterAPISource {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSyncClusterAPISource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSyncList) DeepCopyInto(out *TargetSyncList) {
 	*out = *in
@@ -2413,10 +3261,71 @@ func (in *TargetSyncList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSyncSecretSelectorSource) DeepCopyInto(out *TargetSyncSecretSelectorSource) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSyncSecretSelectorSource.
+func (in *TargetSyncSecretSelectorSource) DeepCopy() *TargetSyncSecretSelectorSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSyncSecretSelectorSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSyncSourceStatus) DeepCopyInto(out *TargetSyncSourceStatus) {
+	*out = *in
+	if in.CreatedTargets != nil {
+		in, out := &in.CreatedTargets, &out.CreatedTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdatedTargets != nil {
+		in, out := &in.UpdatedTargets, &out.UpdatedTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovedTargets != nil {
+		in, out := &in.RemovedTargets, &out.RemovedTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConflictingTargets != nil {
+		in, out := &in.ConflictingTargets, &out.ConflictingTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSyncSourceStatus.
+func (in *TargetSyncSourceStatus) DeepCopy() *TargetSyncSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSyncSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSyncSpec) DeepCopyInto(out *TargetSyncSpec) {
 	*out = *in
 	out.SecretRef = in.SecretRef
+	if in.ClusterAPI != nil {
+		in, out := &in.ClusterAPI, &out.ClusterAPI
+		*out = new(TargetSyncClusterAPISource)
+		**out = **in
+	}
+	if in.SecretSelector != nil {
+		in, out := &in.SecretSelector, &out.SecretSelector
+		*out = new(TargetSyncSecretSelectorSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenRotation != nil {
 		in, out := &in.TokenRotation, &out.TokenRotation
 		*out = new(TokenRotation)
@@ -2450,6 +3359,13 @@ func (in *TargetSyncStatus) DeepCopyInto(out *TargetSyncStatus) {
 		in, out := &in.LastTokenRotationTime, &out.LastTokenRotationTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]TargetSyncSourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSyncStatus.
@@ -2492,6 +3408,84 @@ func (in *TargetTemplate) DeepCopy() *TargetTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTypeDefinition) DeepCopyInto(out *TargetTypeDefinition) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTypeDefinition.
+func (in *TargetTypeDefinition) DeepCopy() *TargetTypeDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetTypeDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetTypeDefinition) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTypeDefinitionList) DeepCopyInto(out *TargetTypeDefinitionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TargetTypeDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTypeDefinitionList.
+func (in *TargetTypeDefinitionList) DeepCopy() *TargetTypeDefinitionList {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetTypeDefinitionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetTypeDefinitionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTypeDefinitionSpec) DeepCopyInto(out *TargetTypeDefinitionSpec) {
+	*out = *in
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(JSONSchemaDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTypeDefinitionSpec.
+func (in *TargetTypeDefinitionSpec) DeepCopy() *TargetTypeDefinitionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetTypeDefinitionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateExecutor) DeepCopyInto(out *TemplateExecutor) {
 	*out = *in
@@ -2508,6 +3502,77 @@ func (in *TemplateExecutor) DeepCopy() *TemplateExecutor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestResult) DeepCopyInto(out *TestResult) {
+	*out = *in
+	if in.DeployItem != nil {
+		in, out := &in.DeployItem, &out.DeployItem
+		*out = new(ObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestResult.
+func (in *TestResult) DeepCopy() *TestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(TestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestStatus) DeepCopyInto(out *TestStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]TestResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestStatus.
+func (in *TestStatus) DeepCopy() *TestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeoutDetails) DeepCopyInto(out *TimeoutDetails) {
+	*out = *in
+	in.Deadline.DeepCopyInto(&out.Deadline)
+	if in.SlowestChild != nil {
+		in, out := &in.SlowestChild, &out.SlowestChild
+		*out = new(IncompleteChild)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeoutDetails.
+func (in *TimeoutDetails) DeepCopy() *TimeoutDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeoutDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenRotation) DeepCopyInto(out *TokenRotation) {
 	*out = *in
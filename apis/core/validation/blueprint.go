@@ -34,6 +34,8 @@ func ValidateBlueprint(blueprint *core.Blueprint) field.ErrorList {
 	allErrs = append(allErrs, ValidateBlueprintImportDefinitions(field.NewPath("imports"), blueprint.Imports)...)
 	allErrs = append(allErrs, ValidateBlueprintExportDefinitions(field.NewPath("exports"), blueprint.Exports)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("deployExecutions"), blueprint.DeployExecutions)...)
+	allErrs = append(allErrs, ValidateTestExecutions(field.NewPath("testExecutions"), blueprint.TestExecutions, blueprint.DeployExecutions)...)
+	allErrs = append(allErrs, ValidateMaxParallel(field.NewPath("maxParallelDeployItems"), blueprint.MaxParallelDeployItems)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("exportExecutions"), blueprint.ExportExecutions)...)
 	allErrs = append(allErrs, ValidateSubinstallations(field.NewPath("subinstallations"), blueprint.Subinstallations)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("subinstallationExecutions"), blueprint.SubinstallationExecutions)...)
@@ -229,6 +231,25 @@ func ValidateTemplateExecutorList(fldPath *field.Path, list []core.TemplateExecu
 	return allErrs
 }
 
+// ValidateTestExecutions validates the template executors of the test deploy items.
+// Their names must differ from the names of the deploy executors, because the templating state is stored by name.
+func ValidateTestExecutions(fldPath *field.Path, list []core.TemplateExecutor, deployExecutions []core.TemplateExecutor) field.ErrorList {
+	allErrs := ValidateTemplateExecutorList(fldPath, list)
+
+	deployNames := sets.New[string]()
+	for _, exec := range deployExecutions {
+		deployNames.Insert(exec.Name)
+	}
+
+	for i, exec := range list {
+		if len(exec.Name) != 0 && deployNames.Has(exec.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Key(exec.Name),
+				"name is already used by a deploy executor"))
+		}
+	}
+	return allErrs
+}
+
 // ValidateSubinstallations validates all inline subinstallation and installation templates from a file
 func ValidateSubinstallations(fldPath *field.Path, subinstallations []core.SubinstallationTemplate) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -253,12 +274,13 @@ func ValidateSubinstallations(fldPath *field.Path, subinstallations []core.Subin
 // Take care to also include all templated templates for proper validation.
 func ValidateInstallationTemplates(fldPath *field.Path, blueprintImportDefs []core.ImportDefinition, subinstallations []*core.InstallationTemplate) field.ErrorList {
 	var (
-		allErrs             = field.ErrorList{}
-		names               = sets.New[string]()
-		importedDataObjects = make([]Import, 0)
-		exportedDataObjects = map[string]string{}
-		importedTargets     = make([]Import, 0)
-		exportedTargets     = map[string]string{}
+		allErrs               = field.ErrorList{}
+		names                 = sets.New[string]()
+		importedDataObjects   = make([]Import, 0)
+		exportedDataObjects   = map[string]string{}
+		aggregatedDataObjects = map[string]map[string]string{}
+		importedTargets       = make([]Import, 0)
+		exportedTargets       = map[string]string{}
 
 		blueprintDataImports       = sets.New[string]()
 		blueprintTargetImports     = sets.New[string]()
@@ -316,8 +338,12 @@ func ValidateInstallationTemplates(fldPath *field.Path, blueprintImportDefs []co
 
 		for i, do := range instTmpl.Exports.Data {
 			dataPath := instPath.Child("exports").Child("data").Index(i).Key(fmt.Sprintf("%s/%s", do.Name, do.DataRef))
-			if dup, ok := exportedDataObjects[do.DataRef]; ok {
+			if do.Aggregate != nil {
+				allErrs = append(allErrs, validateAggregatedDataExport(dataPath, instTmpl.Name, do, exportedDataObjects, aggregatedDataObjects)...)
+			} else if dup, ok := exportedDataObjects[do.DataRef]; ok {
 				allErrs = append(allErrs, field.Forbidden(dataPath, fmt.Sprintf("data export '%s' is already exported by %s", do.DataRef, dup)))
+			} else if _, ok := aggregatedDataObjects[do.DataRef]; ok {
+				allErrs = append(allErrs, field.Forbidden(dataPath, fmt.Sprintf("data export '%s' is already exported as aggregated export", do.DataRef)))
 			} else {
 				exportedDataObjects[do.DataRef] = dataPath.String()
 			}
@@ -327,8 +353,9 @@ func ValidateInstallationTemplates(fldPath *field.Path, blueprintImportDefs []co
 		}
 		for i, do := range instTmpl.Imports.Data {
 			importedDataObjects = append(importedDataObjects, Import{
-				Name: do.DataRef,
-				Path: instPath.Child("imports").Child("data").Index(i).Key(do.Name),
+				Name:         do.DataRef,
+				Path:         instPath.Child("imports").Child("data").Index(i).Key(do.Name),
+				IsAggregated: do.Aggregated != nil,
 			})
 		}
 		for i, target := range instTmpl.Exports.Targets {
@@ -386,19 +413,76 @@ func ValidateInstallationTemplates(fldPath *field.Path, blueprintImportDefs []co
 		names.Insert(instTmpl.Name)
 	}
 
+	// validate that all explicit dependencies refer to a sibling
+	for i, instTmpl := range subinstallations {
+		instPath := fldPath.Index(i)
+		if len(instTmpl.Name) != 0 {
+			instPath = fldPath.Child(instTmpl.Name)
+		}
+		for j, dep := range instTmpl.DependsOn {
+			if len(dep) != 0 && !names.Has(dep) {
+				allErrs = append(allErrs, field.NotFound(instPath.Child("dependsOn").Index(j), dep))
+			}
+		}
+	}
+
 	// validate that all imported values are either satisfied by the blueprint or by another sibling
-	allErrs = append(allErrs, ValidateSatisfiedImports(blueprintDataImports, nil, nil, sets.KeySet(exportedDataObjects), importedDataObjects)...)
+	allErrs = append(allErrs, ValidateSatisfiedImports(blueprintDataImports, nil, nil, sets.KeySet(exportedDataObjects).Union(sets.KeySet(aggregatedDataObjects)), importedDataObjects)...)
+	allErrs = append(allErrs, ValidateAggregatedImports(sets.KeySet(aggregatedDataObjects), importedDataObjects)...)
 	allErrs = append(allErrs, ValidateSatisfiedImports(blueprintTargetImports, blueprintTargetListImports, blueprintTargetMapImports, sets.KeySet(exportedTargets), importedTargets)...)
 
 	return allErrs
 }
 
+// validateAggregatedDataExport validates an aggregated data export of a subinstallation against the exports of its siblings.
+// Valid aggregated exports are added to the given map of aggregated data objects.
+func validateAggregatedDataExport(dataPath *field.Path, instName string, do core.DataExport,
+	exportedDataObjects map[string]string, aggregatedDataObjects map[string]map[string]string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if dup, ok := exportedDataObjects[do.DataRef]; ok {
+		allErrs = append(allErrs, field.Forbidden(dataPath, fmt.Sprintf("data export '%s' is already exported as non-aggregated export by %s", do.DataRef, dup)))
+		return allErrs
+	}
+
+	key := do.Aggregate.Key
+	if len(key) == 0 {
+		key = instName
+	}
+	contributions, ok := aggregatedDataObjects[do.DataRef]
+	if !ok {
+		contributions = map[string]string{}
+		aggregatedDataObjects[do.DataRef] = contributions
+	}
+	if dup, ok := contributions[key]; ok {
+		allErrs = append(allErrs, field.Forbidden(dataPath, fmt.Sprintf("aggregation key '%s' of data export '%s' is already used by %s", key, do.DataRef, dup)))
+		return allErrs
+	}
+	contributions[key] = dataPath.String()
+	return allErrs
+}
+
 // Import defines a internal import struct for validation.
 type Import struct {
 	Name              string
 	Path              *field.Path
 	IsListImport      bool
 	IsTargetMapImport bool
+	IsAggregated      bool
+}
+
+// ValidateAggregatedImports validates that aggregated imports refer to aggregated exports
+// and that aggregated exports are only imported by aggregated imports.
+func ValidateAggregatedImports(aggregatedExports sets.Set[string], imports []Import) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, imp := range imports {
+		if imp.IsAggregated && !aggregatedExports.Has(imp.Name) {
+			allErrs = append(allErrs, field.Forbidden(imp.Path, "aggregated import does not refer to an aggregated export"))
+		} else if !imp.IsAggregated && aggregatedExports.Has(imp.Name) {
+			allErrs = append(allErrs, field.Forbidden(imp.Path, "import of an aggregated export has to be an aggregated import"))
+		}
+	}
+	return allErrs
 }
 
 // ValidateSatisfiedImports validates that all imports are satisfied.
@@ -458,6 +542,8 @@ func ValidateInstallationTemplate(fldPath *field.Path, template *core.Installati
 
 	allErrs = append(allErrs, ValidateInstallationTemplateImports(template.Imports, fldPath.Child("imports"))...)
 	allErrs = append(allErrs, ValidateInstallationExports(template.Exports, fldPath.Child("exports"))...)
+	allErrs = append(allErrs, ValidateRolloutPolicy(template.Rollout, fldPath.Child("rollout"))...)
+	allErrs = append(allErrs, ValidateDependsOn(template.Name, template.DependsOn, fldPath.Child("dependsOn"))...)
 
 	return allErrs
 }
@@ -493,6 +579,9 @@ func ValidateInstallationTemplateDataImports(imports []core.DataImport, fldPath
 		if imp.ConfigMapRef != nil {
 			allErrs = append(allErrs, field.Forbidden(impPath.Child("configMapRef"), "configMap references are not allowed in a installation template"))
 		}
+		if imp.Aggregated != nil {
+			allErrs = append(allErrs, ValidateDataImportAggregation(imp, impPath)...)
+		}
 
 		if imp.Name == "" {
 			allErrs = append(allErrs, field.Required(impPath.Child("name"), "name must not be empty"))
@@ -244,6 +244,21 @@ var _ = Describe("Blueprint", func() {
 				"Field": Equal("b[0][myname].type"),
 			}))))
 		})
+
+		It("should fail if a test executor has the name of a deploy executor", func() {
+			deployExecutor := core.TemplateExecutor{Name: "deploy", Type: "GoTemplate"}
+			testExecutors := []core.TemplateExecutor{
+				{Name: "test", Type: "GoTemplate"},
+				{Name: "deploy", Type: "GoTemplate"},
+			}
+
+			allErrs := validation.ValidateTestExecutions(field.NewPath("testExecutions"), testExecutors,
+				[]core.TemplateExecutor{deployExecutor})
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("testExecutions[1][deploy]"),
+			}))))
+		})
 	})
 
 	Context("InstallationTemplate", func() {
@@ -728,6 +743,169 @@ var _ = Describe("Blueprint", func() {
 					"Field": Equal("b[1].exports.targets[0][mysecondexport/mysecondexportref]"),
 				}))))
 			})
+
+			It("should pass if multiple subinstallations contribute to an aggregated data object", func() {
+				tmpl1 := &core.InstallationTemplate{Name: "a"}
+				tmpl1.Blueprint.Ref = "myref"
+				tmpl1.Exports.Data = []core.DataExport{
+					{
+						Name:      "myexport",
+						DataRef:   "aggregated",
+						Aggregate: &core.DataExportAggregation{},
+					},
+				}
+
+				tmpl2 := &core.InstallationTemplate{Name: "b"}
+				tmpl2.Blueprint.Ref = "myref"
+				tmpl2.Exports.Data = []core.DataExport{
+					{
+						Name:      "myexport",
+						DataRef:   "aggregated",
+						Aggregate: &core.DataExportAggregation{},
+					},
+				}
+
+				tmpl3 := &core.InstallationTemplate{Name: "c"}
+				tmpl3.Blueprint.Ref = "myref"
+				tmpl3.Imports.Data = []core.DataImport{
+					{
+						Name:       "myimport",
+						DataRef:    "aggregated",
+						Aggregated: &core.DataImportAggregation{Format: core.AggregationFormatList},
+					},
+				}
+
+				allErrs := validation.ValidateInstallationTemplates(
+					field.NewPath("b"),
+					nil,
+					[]*core.InstallationTemplate{tmpl1, tmpl2, tmpl3})
+				Expect(allErrs).To(HaveLen(0))
+			})
+
+			It("should fail if multiple subinstallations contribute to an aggregated data object with the same key", func() {
+				tmpl1 := &core.InstallationTemplate{Name: "a"}
+				tmpl1.Blueprint.Ref = "myref"
+				tmpl1.Exports.Data = []core.DataExport{
+					{
+						Name:      "myexport",
+						DataRef:   "aggregated",
+						Aggregate: &core.DataExportAggregation{Key: "key"},
+					},
+				}
+
+				tmpl2 := &core.InstallationTemplate{Name: "b"}
+				tmpl2.Blueprint.Ref = "myref"
+				tmpl2.Exports.Data = []core.DataExport{
+					{
+						Name:      "myexport",
+						DataRef:   "aggregated",
+						Aggregate: &core.DataExportAggregation{Key: "key"},
+					},
+				}
+
+				allErrs := validation.ValidateInstallationTemplates(
+					field.NewPath("b"),
+					nil,
+					[]*core.InstallationTemplate{tmpl1, tmpl2})
+				Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("b.b.exports.data[0][myexport/aggregated]"),
+				}))))
+			})
+
+			It("should fail if an aggregated data object is also exported as non-aggregated export", func() {
+				tmpl1 := &core.InstallationTemplate{Name: "a"}
+				tmpl1.Blueprint.Ref = "myref"
+				tmpl1.Exports.Data = []core.DataExport{
+					{
+						Name:      "myexport",
+						DataRef:   "aggregated",
+						Aggregate: &core.DataExportAggregation{},
+					},
+				}
+
+				tmpl2 := &core.InstallationTemplate{Name: "b"}
+				tmpl2.Blueprint.Ref = "myref"
+				tmpl2.Exports.Data = []core.DataExport{
+					{
+						Name:    "myexport",
+						DataRef: "aggregated",
+					},
+				}
+
+				allErrs := validation.ValidateInstallationTemplates(
+					field.NewPath("b"),
+					nil,
+					[]*core.InstallationTemplate{tmpl1, tmpl2})
+				Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("b.b.exports.data[0][myexport/aggregated]"),
+				}))))
+			})
+
+			It("should fail if an aggregated data object is imported by a non-aggregated import", func() {
+				tmpl1 := &core.InstallationTemplate{Name: "a"}
+				tmpl1.Blueprint.Ref = "myref"
+				tmpl1.Exports.Data = []core.DataExport{
+					{
+						Name:      "myexport",
+						DataRef:   "aggregated",
+						Aggregate: &core.DataExportAggregation{},
+					},
+				}
+
+				tmpl2 := &core.InstallationTemplate{Name: "b"}
+				tmpl2.Blueprint.Ref = "myref"
+				tmpl2.Imports.Data = []core.DataImport{
+					{
+						Name:    "myimport",
+						DataRef: "aggregated",
+					},
+				}
+
+				allErrs := validation.ValidateInstallationTemplates(
+					field.NewPath("b"),
+					nil,
+					[]*core.InstallationTemplate{tmpl1, tmpl2})
+				Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("b.b.imports.data[0][myimport]"),
+				}))))
+			})
+
+			It("should pass if a subinstallation depends on a sibling", func() {
+				tmpl1 := &core.InstallationTemplate{Name: "a"}
+				tmpl1.Blueprint.Ref = "myref"
+
+				tmpl2 := &core.InstallationTemplate{Name: "b", DependsOn: []string{"a"}}
+				tmpl2.Blueprint.Ref = "myref"
+
+				allErrs := validation.ValidateInstallationTemplates(
+					field.NewPath("b"),
+					nil,
+					[]*core.InstallationTemplate{tmpl1, tmpl2})
+				Expect(allErrs).To(HaveLen(0))
+			})
+
+			It("should fail if a subinstallation depends on an unknown or on itself", func() {
+				tmpl1 := &core.InstallationTemplate{Name: "a", DependsOn: []string{"a", "c"}}
+				tmpl1.Blueprint.Ref = "myref"
+
+				allErrs := validation.ValidateInstallationTemplates(
+					field.NewPath("b"),
+					nil,
+					[]*core.InstallationTemplate{tmpl1})
+				Expect(allErrs).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("b.a.dependsOn[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotFound),
+						"Field": Equal("b.a.dependsOn[1]"),
+					})),
+				))
+			})
 		})
 	})
 
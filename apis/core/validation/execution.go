@@ -5,6 +5,8 @@
 package validation
 
 import (
+	"encoding/json"
+
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -23,6 +25,27 @@ func ValidateExecution(execution *core.Execution) field.ErrorList {
 func ValidateExecutionSpec(fldpath *field.Path, spec core.ExecutionSpec) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, ValidateDeployItemTemplateList(fldpath.Child("deployItems"), spec.DeployItems)...)
+	allErrs = append(allErrs, ValidateMaxParallel(fldpath.Child("maxParallel"), spec.MaxParallel)...)
+	for i, tmpl := range spec.DeployItems {
+		// conditions and expansions are evaluated when the deploy executions of a blueprint are templated
+		if tmpl.When != nil {
+			allErrs = append(allErrs, field.Forbidden(fldpath.Child("deployItems").Index(i).Child("when"),
+				"is only supported in the deploy executions of blueprints"))
+		}
+		if tmpl.ForEach != nil {
+			allErrs = append(allErrs, field.Forbidden(fldpath.Child("deployItems").Index(i).Child("forEach"),
+				"is only supported in the deploy executions of blueprints"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateMaxParallel validates the maximum number of deploy items which are processed in parallel.
+func ValidateMaxParallel(fldPath *field.Path, maxParallel *int32) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if maxParallel != nil && *maxParallel < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *maxParallel, "must be greater than 0"))
+	}
 	return allErrs
 }
 
@@ -173,5 +196,60 @@ func ValidateDeployItemTemplate(fldPath *field.Path, tmpl core.DeployItemTemplat
 		allErrs = append(allErrs, metav1validation.ValidateLabels(tmpl.Labels, fldPath.Child("labels"))...)
 	}
 
+	allErrs = append(allErrs, ValidateRetryPolicy(tmpl.RetryPolicy, fldPath.Child("retryPolicy"))...)
+
+	return allErrs
+}
+
+// ValidateDeployItemExpansions validates the deploy item templates rendered by the deploy executions of a blueprint
+// before their conditions and forEach expansions are evaluated.
+func ValidateDeployItemExpansions(fldPath *field.Path, list core.DeployItemTemplateList) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+	for i, tmpl := range list {
+		tmplPath := fldPath.Index(i)
+		if len(tmpl.Name) == 0 {
+			allErrs = append(allErrs, field.Required(tmplPath.Child("name"), "name must not be empty"))
+		} else {
+			if names.Has(tmpl.Name) {
+				allErrs = append(allErrs, field.Duplicate(tmplPath, tmpl.Name))
+			}
+			names.Insert(tmpl.Name)
+			tmplPath = tmplPath.Key(tmpl.Name)
+		}
+
+		if tmpl.ForEach != nil {
+			allErrs = append(allErrs, ValidateDeployItemForEach(tmplPath.Child("forEach"), *tmpl.ForEach)...)
+		}
+	}
+	return allErrs
+}
+
+// ValidateDeployItemForEach validates the forEach expansion of a deploy item template.
+func ValidateDeployItemForEach(fldPath *field.Path, forEach core.DeployItemForEach) field.ErrorList {
+	allErrs := field.ErrorList{}
+	hasItems := forEach.Items != nil && len(forEach.Items.RawMessage) != 0
+
+	if !hasItems && len(forEach.TargetImport) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "either items or targetImport must be set"))
+		return allErrs
+	}
+	if hasItems && len(forEach.TargetImport) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("targetImport"), "must not be set together with items"))
+		return allErrs
+	}
+
+	if hasItems {
+		var items interface{}
+		if err := json.Unmarshal(forEach.Items.RawMessage, &items); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("items"), string(forEach.Items.RawMessage), err.Error()))
+			return allErrs
+		}
+		switch items.(type) {
+		case []interface{}, map[string]interface{}:
+		default:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("items"), string(forEach.Items.RawMessage), "must be a list or a map"))
+		}
+	}
 	return allErrs
 }
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/openmcp-project/landscaper/apis/core"
 	"github.com/openmcp-project/landscaper/apis/core/validation"
@@ -16,6 +17,23 @@ import (
 
 var _ = Describe("Execution", func() {
 
+	Context("ValidateExecutionSpec", func() {
+		It("should pass if maxParallel is positive", func() {
+			maxParallel := int32(3)
+			allErrs := validation.ValidateExecutionSpec(field.NewPath("spec"), core.ExecutionSpec{MaxParallel: &maxParallel})
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if maxParallel is not positive", func() {
+			maxParallel := int32(0)
+			allErrs := validation.ValidateExecutionSpec(field.NewPath("spec"), core.ExecutionSpec{MaxParallel: &maxParallel})
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.maxParallel"),
+			}))))
+		})
+	})
+
 	Context("ValidateDeployItemTemplate", func() {
 		It("should pass if a DeployItemTemplate is valid", func() {
 			tmpl := core.DeployItemTemplate{}
@@ -150,4 +168,95 @@ var _ = Describe("Execution", func() {
 
 	})
 
+	Context("ValidateDeployItemExpansions", func() {
+		It("should pass if the forEach expansions are valid", func() {
+			templates := []core.DeployItemTemplate{
+				{
+					Name:    "a",
+					When:    ptr.To(false),
+					ForEach: &core.DeployItemForEach{Items: core.NewAnyJSONPointer([]byte(`["x", "y"]`))},
+				},
+				{
+					Name:    "b",
+					ForEach: &core.DeployItemForEach{Items: core.NewAnyJSONPointer([]byte(`{"x": 1}`))},
+				},
+				{
+					Name:    "c",
+					ForEach: &core.DeployItemForEach{TargetImport: "clusters"},
+				},
+			}
+
+			allErrs := validation.ValidateDeployItemExpansions(field.NewPath("x"), templates)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if a forEach expansion is invalid", func() {
+			templates := []core.DeployItemTemplate{
+				{
+					Name:    "a",
+					ForEach: &core.DeployItemForEach{},
+				},
+				{
+					Name: "b",
+					ForEach: &core.DeployItemForEach{
+						Items:        core.NewAnyJSONPointer([]byte(`["x"]`)),
+						TargetImport: "clusters",
+					},
+				},
+				{
+					Name:    "c",
+					ForEach: &core.DeployItemForEach{Items: core.NewAnyJSONPointer([]byte(`"x"`))},
+				},
+				{
+					Name: "c",
+				},
+			}
+
+			allErrs := validation.ValidateDeployItemExpansions(field.NewPath("x"), templates)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("x[0][a].forEach"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("x[1][b].forEach.targetImport"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("x[2][c].forEach.items"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("x[3]"),
+				})),
+			))
+		})
+
+		It("should forbid conditions and expansions in executions", func() {
+			spec := core.ExecutionSpec{
+				DeployItems: []core.DeployItemTemplate{
+					{
+						Name:    "a",
+						Type:    "mytype",
+						When:    ptr.To(true),
+						ForEach: &core.DeployItemForEach{TargetImport: "clusters"},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateExecutionSpec(field.NewPath("spec"), spec)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.deployItems[0].when"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.deployItems[0].forEach"),
+				})),
+			))
+		})
+	})
+
 })
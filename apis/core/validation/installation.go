@@ -6,6 +6,7 @@ package validation
 
 import (
 	"regexp"
+	"strings"
 
 	"github.com/robfig/cron/v3"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/openmcp-project/landscaper/apis/core"
+	"github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 	"github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
 )
 
@@ -31,6 +33,13 @@ func ValidateInstallation(inst *core.Installation) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateInstallationObjectMeta(&inst.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateInstallationSpec(&inst.Spec, field.NewPath("spec"))...)
+
+	// subinstallations are referred to by the name of their installation template
+	name := inst.GetName()
+	if tmplName, ok := inst.GetAnnotations()[v1alpha1.SubinstallationNameAnnotation]; ok && len(tmplName) != 0 {
+		name = tmplName
+	}
+	allErrs = append(allErrs, ValidateDependsOn(name, inst.Spec.DependsOn, field.NewPath("spec", "dependsOn"))...)
 	return allErrs
 }
 
@@ -60,6 +69,17 @@ func ValidateInstallationSpec(spec *core.InstallationSpec, fldPath *field.Path)
 	allErrs = append(allErrs, ValidateInstallationComponentDescriptor(spec.ComponentDescriptor, fldPath.Child("componentDescriptor"))...)
 
 	allErrs = append(allErrs, ValidateInstallationAutomaticReconcile(spec.AutomaticReconcile, fldPath.Child("automaticReconcile"))...)
+	allErrs = append(allErrs, ValidateRolloutPolicy(spec.Rollout, fldPath.Child("rollout"))...)
+	allErrs = append(allErrs, ValidateRetryPolicy(spec.RetryPolicy, fldPath.Child("retryPolicy"))...)
+
+	if spec.Timeout != nil && spec.Timeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), spec.Timeout.String(), "must not be negative"))
+	}
+
+	if spec.RetryPolicy != nil && spec.AutomaticReconcile != nil && spec.AutomaticReconcile.FailedReconcile != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("retryPolicy"),
+			"retryPolicy must not be combined with automaticReconcile.failedReconcile"))
+	}
 
 	return allErrs
 }
@@ -129,6 +149,100 @@ func ValidateInstallationSucceededReconcile(succeededReconcile *core.SucceededRe
 	return allErrs
 }
 
+// ValidateRolloutPolicy validates the rollout policy of an Installation or InstallationTemplate
+func ValidateRolloutPolicy(rollout *core.RolloutPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rollout == nil {
+		return allErrs
+	}
+
+	if rollout.BatchSize != nil && rollout.BatchPercentage != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, rollout, "only one of batchSize and batchPercentage may be set"))
+	}
+	if rollout.BatchSize != nil && *rollout.BatchSize < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("batchSize"), *rollout.BatchSize, "must be greater than 0"))
+	}
+	if rollout.BatchPercentage != nil && (*rollout.BatchPercentage < 1 || *rollout.BatchPercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("batchPercentage"), *rollout.BatchPercentage, "must be between 1 and 100"))
+	}
+	if rollout.PauseBetweenWaves != nil && rollout.PauseBetweenWaves.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("pauseBetweenWaves"), rollout.PauseBetweenWaves.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+// ValidateRetryPolicy validates the retry policy of an Installation or DeployItemTemplate
+func ValidateRetryPolicy(retryPolicy *core.RetryPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if retryPolicy == nil {
+		return allErrs
+	}
+
+	for i, code := range retryPolicy.RetryOn {
+		if len(code) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("retryOn").Index(i), "error code must not be empty"))
+		}
+	}
+	for i, code := range retryPolicy.NoRetryOn {
+		if len(code) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("noRetryOn").Index(i), "error code must not be empty"))
+		}
+	}
+	if retryPolicy.MaxAttempts != nil && *retryPolicy.MaxAttempts < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAttempts"), *retryPolicy.MaxAttempts, "must be greater than 0"))
+	}
+
+	backoff := retryPolicy.Backoff
+	if backoff == nil {
+		return allErrs
+	}
+
+	backoffPath := fldPath.Child("backoff")
+	if backoff.InitialInterval != nil && backoff.InitialInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(backoffPath.Child("initialInterval"), backoff.InitialInterval.String(), "must be greater than 0"))
+	}
+	if backoff.MaxInterval != nil && backoff.MaxInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(backoffPath.Child("maxInterval"), backoff.MaxInterval.String(), "must be greater than 0"))
+	}
+	if backoff.InitialInterval != nil && backoff.MaxInterval != nil && backoff.MaxInterval.Duration < backoff.InitialInterval.Duration {
+		allErrs = append(allErrs, field.Invalid(backoffPath.Child("maxInterval"), backoff.MaxInterval.String(), "must not be less than initialInterval"))
+	}
+	if backoff.Factor != nil && *backoff.Factor < 1 {
+		allErrs = append(allErrs, field.Invalid(backoffPath.Child("factor"), *backoff.Factor, "must be greater than 0"))
+	}
+	if backoff.JitterPercentage != nil && (*backoff.JitterPercentage < 0 || *backoff.JitterPercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(backoffPath.Child("jitterPercentage"), *backoff.JitterPercentage, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
+// ValidateDependsOn validates the explicit dependencies of an installation or installation template with the given name.
+func ValidateDependsOn(name string, dependsOn []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+
+	for i, dep := range dependsOn {
+		depPath := fldPath.Index(i)
+		if len(dep) == 0 {
+			allErrs = append(allErrs, field.Required(depPath, "name must not be empty"))
+			continue
+		}
+		if names.Has(dep) {
+			allErrs = append(allErrs, field.Duplicate(depPath, dep))
+		}
+		names.Insert(dep)
+		if len(name) != 0 && dep == name {
+			allErrs = append(allErrs, field.Invalid(depPath, dep, "an installation must not depend on itself"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateInstallationImports validates the imports of an Installation
 func ValidateInstallationImports(imports core.InstallationImports, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -160,6 +274,10 @@ func ValidateInstallationDataImports(imports []core.DataImport, fldPath *field.P
 			allErrs = append(allErrs, ValidateLocalConfigMapReference(*imp.ConfigMapRef, impPath.Child("configMapRef"))...)
 		}
 
+		if imp.Aggregated != nil {
+			allErrs = append(allErrs, ValidateDataImportAggregation(imp, impPath)...)
+		}
+
 		if imp.Name == "" {
 			allErrs = append(allErrs, field.Required(impPath.Child("name"), "name must not be empty"))
 			continue
@@ -231,6 +349,9 @@ func ValidateInstallationDataExports(exports []core.DataExport, fldPath *field.P
 		if imp.DataRef == "" {
 			allErrs = append(allErrs, field.Required(fldPath.Index(idx).Child("dataRef"), "dataRef must not be empty"))
 		}
+		if imp.Aggregate != nil {
+			allErrs = append(allErrs, ValidateDataExportAggregation(imp, fldPath.Index(idx))...)
+		}
 		if imp.Name == "" {
 			allErrs = append(allErrs, field.Required(fldPath.Index(idx).Child("name"), "name must not be empty"))
 			continue
@@ -244,6 +365,43 @@ func ValidateInstallationDataExports(exports []core.DataExport, fldPath *field.P
 	return allErrs
 }
 
+// ValidateDataImportAggregation validates the aggregation configuration of a data import
+func ValidateDataImportAggregation(imp core.DataImport, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if imp.SecretRef != nil || imp.ConfigMapRef != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("aggregated"), "aggregated imports are only supported for dataRef imports"))
+	}
+	if strings.HasPrefix(imp.DataRef, helper.NonContextifiedPrefix) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("aggregated"), "aggregated imports are not supported for non-contextified data objects"))
+	}
+
+	switch imp.Aggregated.Format {
+	case "", core.AggregationFormatMap, core.AggregationFormatList:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("aggregated", "format"), imp.Aggregated.Format,
+			[]core.AggregationFormat{core.AggregationFormatMap, core.AggregationFormatList}))
+	}
+
+	return allErrs
+}
+
+// ValidateDataExportAggregation validates the aggregation configuration of a data export
+func ValidateDataExportAggregation(exp core.DataExport, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if strings.HasPrefix(exp.DataRef, helper.NonContextifiedPrefix) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("aggregate"), "aggregated exports are not supported for non-contextified data objects"))
+	}
+	if len(exp.Aggregate.Key) != 0 {
+		for _, msg := range validation.IsValidLabelValue(exp.Aggregate.Key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("aggregate", "key"), exp.Aggregate.Key, msg))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateInstallationTargetExports validates the target exports of an Installation
 func ValidateInstallationTargetExports(exports []core.TargetExport, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"text/template"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/openmcp-project/landscaper/apis/core"
+)
+
+// ValidateInstallationSet validates an InstallationSet
+func ValidateInstallationSet(set *core.InstallationSet) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateInstallationSetSpec(&set.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateInstallationSetSpec validates the spec of an InstallationSet
+func ValidateInstallationSetSpec(spec *core.InstallationSetSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateInstallationSpec(&spec.Template.Spec, fldPath.Child("template", "spec"))...)
+	allErrs = append(allErrs, ValidateInstallationSetGenerator(&spec.Generator, fldPath.Child("generator"))...)
+
+	if len(spec.NameTemplate) != 0 {
+		if _, err := template.New("name").Parse(spec.NameTemplate); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nameTemplate"), spec.NameTemplate, err.Error()))
+		}
+	}
+
+	elements := sets.New[string]()
+	for idx, override := range spec.Overrides {
+		overridePath := fldPath.Child("overrides").Index(idx)
+		if len(override.Element) == 0 {
+			allErrs = append(allErrs, field.Required(overridePath.Child("element"), "element must not be empty"))
+		} else if elements.Has(override.Element) {
+			allErrs = append(allErrs, field.Duplicate(overridePath.Child("element"), override.Element))
+		}
+		elements.Insert(override.Element)
+		allErrs = append(allErrs, ValidateInstallationImports(override.Imports, overridePath.Child("imports"))...)
+	}
+
+	if spec.MaxProgressing != nil && *spec.MaxProgressing < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxProgressing"), *spec.MaxProgressing, "must be at least 1"))
+	}
+
+	return allErrs
+}
+
+// ValidateInstallationSetGenerator validates the generator of an InstallationSet
+func ValidateInstallationSetGenerator(generator *core.InstallationSetGenerator, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateExactlyOneOf(fldPath, *generator, "Targets", "TargetMap", "Parameters")...)
+
+	if generator.Targets != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&generator.Targets.Selector,
+			metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("targets", "selector"))...)
+	}
+
+	if generator.Targets != nil || generator.TargetMap != nil {
+		if len(generator.TargetImport) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("targetImport"), "targetImport must be defined for targets and targetMap"))
+		}
+	}
+
+	for key, tg := range generator.TargetMap {
+		if !targetMapKeyRegExp.MatchString(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("targetMap").Key(key), key,
+				"key must contain only lower-case alphanumeric characters, dots, or dashes; "+
+					"it must begin and end with a lower-case alphanumeric character; "+
+					"it must not be empty, and not longer than 63 characters"))
+		}
+		if len(tg) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("targetMap").Key(key), "target must not be empty"))
+		}
+	}
+
+	names := sets.New[string]()
+	for idx, params := range generator.Parameters {
+		namePath := fldPath.Child("parameters").Index(idx).Child("name")
+		if !targetMapKeyRegExp.MatchString(params.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, params.Name,
+				"name must contain only lower-case alphanumeric characters, dots, or dashes; "+
+					"it must begin and end with a lower-case alphanumeric character; "+
+					"it must not be empty, and not longer than 63 characters"))
+		} else if names.Has(params.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, params.Name))
+		}
+		names.Insert(params.Name)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/openmcp-project/landscaper/apis/core"
+	"github.com/openmcp-project/landscaper/apis/core/validation"
+)
+
+var _ = Describe("InstallationSet", func() {
+
+	newInstallationSet := func(generator core.InstallationSetGenerator) *core.InstallationSet {
+		return &core.InstallationSet{
+			Spec: core.InstallationSetSpec{
+				Template: core.InstallationSetTemplate{
+					Spec: core.InstallationSpec{
+						Blueprint: core.BlueprintDefinition{
+							Reference: &core.RemoteBlueprintReference{ResourceName: "blueprint"},
+						},
+					},
+				},
+				Generator: generator,
+			},
+		}
+	}
+
+	It("should accept an InstallationSet with a targets generator", func() {
+		set := newInstallationSet(core.InstallationSetGenerator{
+			Targets: &core.InstallationSetTargetsGenerator{
+				Selector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+			},
+			TargetImport: "cluster",
+		})
+		set.Spec.MaxProgressing = ptr.To[int32](2)
+
+		allErrs := validation.ValidateInstallationSet(set)
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	It("should accept an InstallationSet with a parameters generator", func() {
+		set := newInstallationSet(core.InstallationSetGenerator{
+			Parameters: []core.InstallationSetParameterSet{{Name: "a"}, {Name: "b"}},
+		})
+		set.Spec.NameTemplate = "{{ .elementName }}-inst"
+
+		allErrs := validation.ValidateInstallationSet(set)
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	It("should reject an InstallationSet without generator", func() {
+		set := newInstallationSet(core.InstallationSetGenerator{})
+
+		allErrs := validation.ValidateInstallationSet(set)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.generator"),
+		}))))
+	})
+
+	It("should reject an InstallationSet with more than one generator", func() {
+		set := newInstallationSet(core.InstallationSetGenerator{
+			TargetMap:    map[string]string{"a": "target-a"},
+			TargetImport: "cluster",
+			Parameters:   []core.InstallationSetParameterSet{{Name: "a"}},
+		})
+
+		allErrs := validation.ValidateInstallationSet(set)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.generator"),
+		}))))
+	})
+
+	It("should reject a target map generator without target import", func() {
+		set := newInstallationSet(core.InstallationSetGenerator{
+			TargetMap: map[string]string{"a": "target-a"},
+		})
+
+		allErrs := validation.ValidateInstallationSet(set)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.generator.targetImport"),
+		}))))
+	})
+
+	It("should reject duplicate parameter sets", func() {
+		set := newInstallationSet(core.InstallationSetGenerator{
+			Parameters: []core.InstallationSetParameterSet{{Name: "a"}, {Name: "a"}},
+		})
+
+		allErrs := validation.ValidateInstallationSet(set)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeDuplicate),
+			"Field": Equal("spec.generator.parameters[1].name"),
+		}))))
+	})
+
+	It("should reject an invalid name template and maxProgressing", func() {
+		set := newInstallationSet(core.InstallationSetGenerator{
+			Parameters: []core.InstallationSetParameterSet{{Name: "a"}},
+		})
+		set.Spec.NameTemplate = "{{ .elementName"
+		set.Spec.MaxProgressing = ptr.To[int32](0)
+
+		allErrs := validation.ValidateInstallationSet(set)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.nameTemplate"),
+		}))))
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.maxProgressing"),
+		}))))
+	})
+})
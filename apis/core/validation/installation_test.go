@@ -5,10 +5,13 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/openmcp-project/landscaper/apis/core"
 	"github.com/openmcp-project/landscaper/apis/core/validation"
@@ -508,5 +511,249 @@ var _ = Describe("Installation", func() {
 				"Field": Equal("imports.data[0]"),
 			}))))
 		})
+
+		It("should fail if an aggregated import is not a dataRef import", func() {
+			imp := core.InstallationImports{
+				Data: []core.DataImport{
+					{
+						Name:       "imp",
+						SecretRef:  &core.LocalSecretReference{Name: "abc", Key: "key"},
+						Aggregated: &core.DataImportAggregation{},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationImports(imp, field.NewPath("imports"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("imports.data[0].aggregated"),
+			}))))
+		})
+
+		It("should fail if an aggregated import has an unknown format", func() {
+			imp := core.InstallationImports{
+				Data: []core.DataImport{
+					{
+						Name:       "imp",
+						DataRef:    "agg",
+						Aggregated: &core.DataImportAggregation{Format: "set"},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationImports(imp, field.NewPath("imports"))
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("imports.data[0].aggregated.format"),
+			}))))
+		})
+	})
+
+	Context("InstallationExports", func() {
+		It("should pass if an aggregated export is valid", func() {
+			exp := core.InstallationExports{
+				Data: []core.DataExport{
+					{
+						Name:      "exp",
+						DataRef:   "agg",
+						Aggregate: &core.DataExportAggregation{Key: "eu-west-1"},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationExports(exp, field.NewPath("exports"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if the aggregation key of an export is invalid", func() {
+			exp := core.InstallationExports{
+				Data: []core.DataExport{
+					{
+						Name:      "exp",
+						DataRef:   "agg",
+						Aggregate: &core.DataExportAggregation{Key: "invalid key!"},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationExports(exp, field.NewPath("exports"))
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("exports.data[0].aggregate.key"),
+			}))))
+		})
+
+		It("should fail if a non-contextified data object is exported as aggregated export", func() {
+			exp := core.InstallationExports{
+				Data: []core.DataExport{
+					{
+						Name:      "exp",
+						DataRef:   "#agg",
+						Aggregate: &core.DataExportAggregation{},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationExports(exp, field.NewPath("exports"))
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("exports.data[0].aggregate"),
+			}))))
+		})
+	})
+
+	Context("RolloutPolicy", func() {
+		It("should accept a rollout policy with a batch size", func() {
+			rollout := &core.RolloutPolicy{
+				BatchSize:         ptr.To[int32](2),
+				PauseBetweenWaves: &core.Duration{Duration: time.Minute},
+			}
+
+			allErrs := validation.ValidateRolloutPolicy(rollout, field.NewPath("rollout"))
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should reject a rollout policy with batch size and batch percentage", func() {
+			rollout := &core.RolloutPolicy{
+				BatchSize:       ptr.To[int32](2),
+				BatchPercentage: ptr.To[int32](20),
+			}
+
+			allErrs := validation.ValidateRolloutPolicy(rollout, field.NewPath("rollout"))
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("rollout"),
+			}))))
+		})
+
+		It("should reject an invalid batch percentage", func() {
+			rollout := &core.RolloutPolicy{
+				BatchPercentage: ptr.To[int32](150),
+			}
+
+			allErrs := validation.ValidateRolloutPolicy(rollout, field.NewPath("rollout"))
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("rollout.batchPercentage"),
+			}))))
+		})
+	})
+
+	Context("RetryPolicy", func() {
+		It("should accept a retry policy with backoff", func() {
+			retryPolicy := &core.RetryPolicy{
+				RetryOn:     []core.ErrorCode{core.ErrorTimeout, core.ErrorInternalProblem},
+				NoRetryOn:   []core.ErrorCode{core.ErrorConfigurationProblem},
+				MaxAttempts: ptr.To[int32](5),
+				Backoff: &core.RetryBackoff{
+					InitialInterval:  &core.Duration{Duration: 10 * time.Second},
+					MaxInterval:      &core.Duration{Duration: 5 * time.Minute},
+					Factor:           ptr.To[int32](3),
+					JitterPercentage: ptr.To[int32](20),
+				},
+			}
+
+			allErrs := validation.ValidateRetryPolicy(retryPolicy, field.NewPath("retryPolicy"))
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should reject invalid attempts and intervals", func() {
+			retryPolicy := &core.RetryPolicy{
+				MaxAttempts: ptr.To[int32](0),
+				Backoff: &core.RetryBackoff{
+					InitialInterval:  &core.Duration{Duration: time.Minute},
+					MaxInterval:      &core.Duration{Duration: time.Second},
+					JitterPercentage: ptr.To[int32](150),
+				},
+			}
+
+			allErrs := validation.ValidateRetryPolicy(retryPolicy, field.NewPath("retryPolicy"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("retryPolicy.maxAttempts"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("retryPolicy.backoff.maxInterval"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("retryPolicy.backoff.jitterPercentage"),
+				})),
+			))
+		})
+
+		It("should reject a retry policy together with an automatic reconcile of failed installations", func() {
+			spec := &core.InstallationSpec{
+				Blueprint: core.BlueprintDefinition{
+					Reference: &core.RemoteBlueprintReference{ResourceName: "blueprint"},
+				},
+				AutomaticReconcile: &core.AutomaticReconcile{
+					FailedReconcile: &core.FailedReconcile{},
+				},
+				RetryPolicy: &core.RetryPolicy{},
+			}
+
+			allErrs := validation.ValidateInstallationSpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.retryPolicy"),
+			}))))
+		})
+	})
+
+	Context("Timeout", func() {
+		It("should reject a negative timeout", func() {
+			spec := &core.InstallationSpec{
+				Blueprint: core.BlueprintDefinition{
+					Reference: &core.RemoteBlueprintReference{ResourceName: "blueprint"},
+				},
+				Timeout: &core.Duration{Duration: -time.Minute},
+			}
+
+			allErrs := validation.ValidateInstallationSpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.timeout"),
+			}))))
+		})
+
+		It("should accept a positive timeout", func() {
+			spec := &core.InstallationSpec{
+				Blueprint: core.BlueprintDefinition{
+					Reference: &core.RemoteBlueprintReference{ResourceName: "blueprint"},
+				},
+				Timeout: &core.Duration{Duration: time.Hour},
+			}
+
+			allErrs := validation.ValidateInstallationSpec(spec, field.NewPath("spec"))
+			Expect(allErrs).To(BeEmpty())
+		})
+	})
+
+	Context("DependsOn", func() {
+		It("should accept dependencies on other installations", func() {
+			allErrs := validation.ValidateDependsOn("a", []string{"b", "c"}, field.NewPath("dependsOn"))
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should reject empty, duplicate and self references", func() {
+			allErrs := validation.ValidateDependsOn("a", []string{"b", "", "b", "a"}, field.NewPath("dependsOn"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("dependsOn[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("dependsOn[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("dependsOn[3]"),
+				})),
+			))
+		})
 	})
 })
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/openmcp-project/landscaper/apis/core"
+)
+
+// ValidateTargetTypeDefinition validates a TargetTypeDefinition
+func ValidateTargetTypeDefinition(def *core.TargetTypeDefinition) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateTargetTypeDefinitionSpec(&def.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateTargetTypeDefinitionSpec validates the spec of a TargetTypeDefinition
+func ValidateTargetTypeDefinitionSpec(spec *core.TargetTypeDefinitionSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Type) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "type must be defined"))
+	}
+
+	if spec.Schema != nil {
+		// the schema itself is compiled by the target type definition webhook
+		var schema map[string]interface{}
+		if err := json.Unmarshal(spec.Schema.RawMessage, &schema); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("schema"), string(spec.Schema.RawMessage), "schema must be a json object"))
+		}
+	}
+
+	return allErrs
+}
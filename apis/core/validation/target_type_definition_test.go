@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/openmcp-project/landscaper/apis/core"
+	"github.com/openmcp-project/landscaper/apis/core/validation"
+)
+
+var _ = Describe("TargetTypeDefinition", func() {
+
+	It("should accept a TargetTypeDefinition with a type and a schema", func() {
+		def := &core.TargetTypeDefinition{
+			Spec: core.TargetTypeDefinitionSpec{
+				Type: "example.com/my-type",
+				Schema: &core.JSONSchemaDefinition{
+					RawMessage: json.RawMessage(`{"type": "object", "required": ["url"]}`),
+				},
+			},
+		}
+
+		allErrs := validation.ValidateTargetTypeDefinition(def)
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	It("should accept a TargetTypeDefinition without a schema", func() {
+		def := &core.TargetTypeDefinition{
+			Spec: core.TargetTypeDefinitionSpec{
+				Type: "example.com/my-type",
+			},
+		}
+
+		allErrs := validation.ValidateTargetTypeDefinition(def)
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	It("should reject a TargetTypeDefinition without a type", func() {
+		def := &core.TargetTypeDefinition{}
+
+		allErrs := validation.ValidateTargetTypeDefinition(def)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.type"),
+		}))))
+	})
+
+	It("should reject a TargetTypeDefinition with a schema that is not a json object", func() {
+		def := &core.TargetTypeDefinition{
+			Spec: core.TargetTypeDefinitionSpec{
+				Type: "example.com/my-type",
+				Schema: &core.JSONSchemaDefinition{
+					RawMessage: json.RawMessage(`["object"]`),
+				},
+			},
+		}
+
+		allErrs := validation.ValidateTargetTypeDefinition(def)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.schema"),
+		}))))
+	})
+})
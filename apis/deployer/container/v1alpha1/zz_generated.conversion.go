@@ -251,6 +251,7 @@ func Convert_container_ContainerStatus_To_v1alpha1_ContainerStatus(in *container
 
 func autoConvert_v1alpha1_Controller_To_container_Controller(in *Controller, out *container.Controller, s conversion.Scope) error {
 	out.CommonControllerConfig = in.CommonControllerConfig
+	out.MaxConcurrentDeployItemsPerTarget = in.MaxConcurrentDeployItemsPerTarget
 	return nil
 }
 
@@ -261,6 +262,7 @@ func Convert_v1alpha1_Controller_To_container_Controller(in *Controller, out *co
 
 func autoConvert_container_Controller_To_v1alpha1_Controller(in *container.Controller, out *Controller, s conversion.Scope) error {
 	out.CommonControllerConfig = in.CommonControllerConfig
+	out.MaxConcurrentDeployItemsPerTarget = in.MaxConcurrentDeployItemsPerTarget
 	return nil
 }
 
@@ -49,6 +49,12 @@ type ProviderConfiguration struct {
 	// Values are the values that are used for templating.
 	Values json.RawMessage `json:"values,omitempty"`
 
+	// ValuesFrom references values in Secrets and ConfigMaps, e.g. values containing credentials which should not
+	// be part of the DeployItem. Each referenced key has to contain a yaml document with values. The values are
+	// merged in the given order, and the inline Values are merged on top of them.
+	// +optional
+	ValuesFrom []managedresource.DataReference `json:"valuesFrom,omitempty"`
+
 	// ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer.
 	// +optional
 	// DEPRECATED
@@ -78,6 +84,23 @@ type ProviderConfiguration struct {
 	// DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.
 	// +optional
 	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition `json:"deletionGroupsDuringUpdate,omitempty"`
+
+	// TakeoverPolicy defines whether objects which are owned by another deploy item are taken over.
+	// Defaults to "never", i.e. an object owned by another deploy item is not updated and an ownership conflict is
+	// reported. Only relevant if HelmDeployment is false.
+	// +optional
+	TakeoverPolicy managedresource.TakeoverPolicy `json:"takeoverPolicy,omitempty"`
+
+	// PostRenderPatches are patches that are applied to the rendered manifests of the chart, similar to a helm
+	// post-renderer. The patches are applied in the given order, both for a helm deployment and for a manifest-only
+	// deployment.
+	// +optional
+	PostRenderPatches []PostRenderPatch `json:"postRenderPatches,omitempty"`
+
+	// RunTests defines whether the test hooks of the chart are run after the resources are deployed and ready.
+	// The DeployItem fails if a test fails.
+	// +optional
+	RunTests bool `json:"runTests,omitempty"`
 }
 
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
@@ -141,6 +164,65 @@ type RemoteArchiveAccess struct {
 	URL string `json:"url,omitempty"`
 }
 
+// PatchType defines the type of a post-render patch.
+type PatchType string
+
+const (
+	// PatchTypeJSON6902 is a JSON patch as defined in RFC 6902, i.e. a list of patch operations.
+	PatchTypeJSON6902 PatchType = "json6902"
+	// PatchTypeStrategicMerge is a strategic merge patch. For types that are not known to the deployer,
+	// e.g. custom resources, it is applied as a merge patch.
+	PatchTypeStrategicMerge PatchType = "strategicMerge"
+	// PatchTypeMerge is a JSON merge patch as defined in RFC 7386.
+	PatchTypeMerge PatchType = "merge"
+)
+
+// PostRenderPatch defines a patch that is applied to the rendered manifests of a chart.
+type PostRenderPatch struct {
+	// Type is the type of the patch.
+	// Supported values are "json6902", "strategicMerge" and "merge".
+	Type PatchType `json:"type"`
+
+	// Target selects the rendered manifests to which the patch is applied.
+	// The patch is applied to all rendered manifests if no target is given.
+	// +optional
+	Target *PatchTarget `json:"target,omitempty"`
+
+	// Patch is the patch document. A json6902 patch is a list of operations,
+	// a strategic merge patch and a merge patch are (partial) objects.
+	Patch json.RawMessage `json:"patch"`
+}
+
+// PatchTarget selects the rendered manifests to which a post-render patch is applied.
+// All fields that are set must match; empty fields match all manifests.
+type PatchTarget struct {
+	// Group is the API group of the selected manifests.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the selected manifests.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Kind is the kind of the selected manifests.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the name of the selected manifests.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Namespace is the namespace of the selected manifests.
+	// Manifests without namespace are considered to be in the release namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// LabelSelector is a label selector, e.g. "app=nginx,tier!=frontend", that the labels of the selected
+	// manifests must match.
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty"`
+}
+
 // HelmDeploymentConfiguration defines settings for a helm deployment.
 type HelmDeploymentConfiguration struct {
 	Install   map[string]lscore.AnyJSON `json:"install,omitempty"`
@@ -181,6 +263,17 @@ type ProviderStatus struct {
 
 	// ManagedResources contains all kubernetes resources that are deployed by the helm deployer.
 	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+
+	// Installed is set when the chart of a manifest-only deployment has been applied successfully for the first time.
+	// Afterwards, the upgrade hooks are run instead of the install hooks.
+	// +optional
+	Installed bool `json:"installed,omitempty"`
+
+	// DeleteHooksReference references the secret which contains the pre-delete and post-delete hooks of a
+	// manifest-only deployment. The secret is owned by the deploy item. The hooks are run from there when the
+	// deploy item is deleted, so that the chart need not be templated again.
+	// +optional
+	DeleteHooksReference *lsv1alpha1.ObjectReference `json:"deleteHooksReference,omitempty"`
 }
 
 // HelmChartRepoCredentials contains the credentials to access hepl chart repos
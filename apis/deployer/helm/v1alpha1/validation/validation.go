@@ -5,8 +5,10 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -34,6 +36,9 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 	allErrs = append(allErrs, ValidateHelmDeploymentConfiguration(field.NewPath("helmDeploymentConfig"), config.HelmDeploymentConfig)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
 	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	allErrs = append(allErrs, validation.ValidateTakeoverPolicy(field.NewPath("takeoverPolicy"), config.TakeoverPolicy)...)
+	allErrs = append(allErrs, ValidatePostRenderPatches(field.NewPath("postRenderPatches"), config.PostRenderPatches)...)
+	allErrs = append(allErrs, validation.ValidateDataReferences(field.NewPath("valuesFrom"), config.ValuesFrom)...)
 
 	if len(config.Name) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("name"), "must not be empty"))
@@ -221,3 +226,39 @@ func ValidateTimeout(fldPath *field.Path, timeout *lsv1alpha1.Duration) field.Er
 	}
 	return allErrs
 }
+
+// ValidatePostRenderPatches validates the post-render patches of a helm deployer configuration.
+func ValidatePostRenderPatches(fldPath *field.Path, patches []helmv1alpha1.PostRenderPatch) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, patch := range patches {
+		indexFldPath := fldPath.Index(i)
+
+		var decoded interface{}
+		if len(patch.Patch) == 0 {
+			allErrs = append(allErrs, field.Required(indexFldPath.Child("patch"), "must not be empty"))
+		} else if err := json.Unmarshal(patch.Patch, &decoded); err != nil {
+			allErrs = append(allErrs, field.Invalid(indexFldPath.Child("patch"), string(patch.Patch), err.Error()))
+		}
+
+		switch patch.Type {
+		case helmv1alpha1.PatchTypeJSON6902:
+			if _, ok := decoded.([]interface{}); decoded != nil && !ok {
+				allErrs = append(allErrs, field.Invalid(indexFldPath.Child("patch"), string(patch.Patch), "a json6902 patch must be a list of operations"))
+			}
+		case helmv1alpha1.PatchTypeStrategicMerge, helmv1alpha1.PatchTypeMerge:
+			if _, ok := decoded.(map[string]interface{}); decoded != nil && !ok {
+				allErrs = append(allErrs, field.Invalid(indexFldPath.Child("patch"), string(patch.Patch), "a merge patch must be an object"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(indexFldPath.Child("type"), patch.Type,
+				[]helmv1alpha1.PatchType{helmv1alpha1.PatchTypeJSON6902, helmv1alpha1.PatchTypeStrategicMerge, helmv1alpha1.PatchTypeMerge}))
+		}
+
+		if patch.Target != nil && len(patch.Target.LabelSelector) != 0 {
+			if _, err := labels.Parse(patch.Target.LabelSelector); err != nil {
+				allErrs = append(allErrs, field.Invalid(indexFldPath.Child("target", "labelSelector"), patch.Target.LabelSelector, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
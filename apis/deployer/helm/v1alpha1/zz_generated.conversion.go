@@ -150,6 +150,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PatchTarget)(nil), (*helm.PatchTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PatchTarget_To_helm_PatchTarget(a.(*PatchTarget), b.(*helm.PatchTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PatchTarget)(nil), (*PatchTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PatchTarget_To_v1alpha1_PatchTarget(a.(*helm.PatchTarget), b.(*PatchTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PostRenderPatch)(nil), (*helm.PostRenderPatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PostRenderPatch_To_helm_PostRenderPatch(a.(*PostRenderPatch), b.(*helm.PostRenderPatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PostRenderPatch)(nil), (*PostRenderPatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PostRenderPatch_To_v1alpha1_PostRenderPatch(a.(*helm.PostRenderPatch), b.(*PostRenderPatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*helm.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(a.(*ProviderConfiguration), b.(*helm.ProviderConfiguration), scope)
 	}); err != nil {
@@ -309,6 +329,7 @@ func Convert_helm_Configuration_To_v1alpha1_Configuration(in *helm.Configuration
 
 func autoConvert_v1alpha1_Controller_To_helm_Controller(in *Controller, out *helm.Controller, s conversion.Scope) error {
 	out.CommonControllerConfig = in.CommonControllerConfig
+	out.MaxConcurrentDeployItemsPerTarget = in.MaxConcurrentDeployItemsPerTarget
 	return nil
 }
 
@@ -319,6 +340,7 @@ func Convert_v1alpha1_Controller_To_helm_Controller(in *Controller, out *helm.Co
 
 func autoConvert_helm_Controller_To_v1alpha1_Controller(in *helm.Controller, out *Controller, s conversion.Scope) error {
 	out.CommonControllerConfig = in.CommonControllerConfig
+	out.MaxConcurrentDeployItemsPerTarget = in.MaxConcurrentDeployItemsPerTarget
 	return nil
 }
 
@@ -487,6 +509,60 @@ func Convert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfigurat
 	return autoConvert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_PatchTarget_To_helm_PatchTarget(in *PatchTarget, out *helm.PatchTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.LabelSelector = in.LabelSelector
+	return nil
+}
+
+// Convert_v1alpha1_PatchTarget_To_helm_PatchTarget is an autogenerated conversion function.
+func Convert_v1alpha1_PatchTarget_To_helm_PatchTarget(in *PatchTarget, out *helm.PatchTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PatchTarget_To_helm_PatchTarget(in, out, s)
+}
+
+func autoConvert_helm_PatchTarget_To_v1alpha1_PatchTarget(in *helm.PatchTarget, out *PatchTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.LabelSelector = in.LabelSelector
+	return nil
+}
+
+// Convert_helm_PatchTarget_To_v1alpha1_PatchTarget is an autogenerated conversion function.
+func Convert_helm_PatchTarget_To_v1alpha1_PatchTarget(in *helm.PatchTarget, out *PatchTarget, s conversion.Scope) error {
+	return autoConvert_helm_PatchTarget_To_v1alpha1_PatchTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_PostRenderPatch_To_helm_PostRenderPatch(in *PostRenderPatch, out *helm.PostRenderPatch, s conversion.Scope) error {
+	out.Type = helm.PatchType(in.Type)
+	out.Target = (*helm.PatchTarget)(unsafe.Pointer(in.Target))
+	out.Patch = *(*json.RawMessage)(unsafe.Pointer(&in.Patch))
+	return nil
+}
+
+// Convert_v1alpha1_PostRenderPatch_To_helm_PostRenderPatch is an autogenerated conversion function.
+func Convert_v1alpha1_PostRenderPatch_To_helm_PostRenderPatch(in *PostRenderPatch, out *helm.PostRenderPatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PostRenderPatch_To_helm_PostRenderPatch(in, out, s)
+}
+
+func autoConvert_helm_PostRenderPatch_To_v1alpha1_PostRenderPatch(in *helm.PostRenderPatch, out *PostRenderPatch, s conversion.Scope) error {
+	out.Type = PatchType(in.Type)
+	out.Target = (*PatchTarget)(unsafe.Pointer(in.Target))
+	out.Patch = *(*json.RawMessage)(unsafe.Pointer(&in.Patch))
+	return nil
+}
+
+// Convert_helm_PostRenderPatch_To_v1alpha1_PostRenderPatch is an autogenerated conversion function.
+func Convert_helm_PostRenderPatch_To_v1alpha1_PostRenderPatch(in *helm.PostRenderPatch, out *PostRenderPatch, s conversion.Scope) error {
+	return autoConvert_helm_PostRenderPatch_To_v1alpha1_PostRenderPatch(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in *ProviderConfiguration, out *helm.ProviderConfiguration, s conversion.Scope) error {
 	out.UpdateStrategy = helm.UpdateStrategy(in.UpdateStrategy)
 	out.ReadinessChecks = in.ReadinessChecks
@@ -497,6 +573,7 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in
 	out.Namespace = in.Namespace
 	out.CreateNamespace = in.CreateNamespace
 	out.Values = *(*json.RawMessage)(unsafe.Pointer(&in.Values))
+	out.ValuesFrom = *(*[]managedresource.DataReference)(unsafe.Pointer(&in.ValuesFrom))
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
@@ -504,6 +581,9 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in
 	out.HelmDeploymentConfig = (*helm.HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
+	out.TakeoverPolicy = managedresource.TakeoverPolicy(in.TakeoverPolicy)
+	out.PostRenderPatches = *(*[]helm.PostRenderPatch)(unsafe.Pointer(&in.PostRenderPatches))
+	out.RunTests = in.RunTests
 	return nil
 }
 
@@ -522,6 +602,7 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.Namespace = in.Namespace
 	out.CreateNamespace = in.CreateNamespace
 	out.Values = *(*json.RawMessage)(unsafe.Pointer(&in.Values))
+	out.ValuesFrom = *(*[]managedresource.DataReference)(unsafe.Pointer(&in.ValuesFrom))
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
@@ -529,6 +610,9 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.HelmDeploymentConfig = (*HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
+	out.TakeoverPolicy = managedresource.TakeoverPolicy(in.TakeoverPolicy)
+	out.PostRenderPatches = *(*[]PostRenderPatch)(unsafe.Pointer(&in.PostRenderPatches))
+	out.RunTests = in.RunTests
 	return nil
 }
 
@@ -539,6 +623,8 @@ func Convert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *he
 
 func autoConvert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus, out *helm.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Installed = in.Installed
+	out.DeleteHooksReference = (*corev1alpha1.ObjectReference)(unsafe.Pointer(in.DeleteHooksReference))
 	return nil
 }
 
@@ -549,6 +635,8 @@ func Convert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus,
 
 func autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Installed = in.Installed
+	out.DeleteHooksReference = (*corev1alpha1.ObjectReference)(unsafe.Pointer(in.DeleteHooksReference))
 	return nil
 }
 
@@ -26,6 +26,11 @@ type ProviderConfiguration struct {
 	ReadinessChecks health.ReadinessCheckConfiguration `json:"readiness,omitempty"`
 	// Manifests contains a list of manifests that should be applied in the target cluster
 	Manifests []managedresource.Manifest `json:"manifests,omitempty"`
+	// ManifestsFrom references manifests in Secrets and ConfigMaps, e.g. Secrets which should not be part of the
+	// DeployItem. Each referenced key can contain several yaml documents. The manifests are applied together with
+	// the inline Manifests.
+	// +optional
+	ManifestsFrom []ManifestsFromSource `json:"manifestsFrom,omitempty"`
 	// Exports describe the exports from the templated manifests that should be exported by the helm deployer.
 	// +optional
 	Exports *managedresource.Exports `json:"exports,omitempty"`
@@ -38,6 +43,19 @@ type ProviderConfiguration struct {
 	// DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.
 	// +optional
 	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition `json:"deletionGroupsDuringUpdate,omitempty"`
+	// TakeoverPolicy defines whether objects which are owned by another deploy item are taken over.
+	// Defaults to "never", i.e. an object owned by another deploy item is not updated and an ownership conflict is reported.
+	// +optional
+	TakeoverPolicy managedresource.TakeoverPolicy `json:"takeoverPolicy,omitempty"`
+}
+
+// ManifestsFromSource references manifests in a Secret or ConfigMap.
+type ManifestsFromSource struct {
+	managedresource.DataReference `json:",inline"`
+	// Policy defines the manage policy of the referenced manifests.
+	// Defaults to "manage".
+	// +optional
+	Policy managedresource.ManifestPolicy `json:"policy,omitempty"`
 }
 
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
@@ -126,6 +126,7 @@ func Convert_manifest_Configuration_To_v1alpha1_Configuration(in *manifest.Confi
 
 func autoConvert_v1alpha1_Controller_To_manifest_Controller(in *Controller, out *manifest.Controller, s conversion.Scope) error {
 	out.CommonControllerConfig = in.CommonControllerConfig
+	out.MaxConcurrentDeployItemsPerTarget = in.MaxConcurrentDeployItemsPerTarget
 	return nil
 }
 
@@ -136,6 +137,7 @@ func Convert_v1alpha1_Controller_To_manifest_Controller(in *Controller, out *man
 
 func autoConvert_manifest_Controller_To_v1alpha1_Controller(in *manifest.Controller, out *Controller, s conversion.Scope) error {
 	out.CommonControllerConfig = in.CommonControllerConfig
+	out.MaxConcurrentDeployItemsPerTarget = in.MaxConcurrentDeployItemsPerTarget
 	return nil
 }
 
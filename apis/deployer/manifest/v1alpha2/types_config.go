@@ -53,4 +53,10 @@ type HPAConfiguration struct {
 // Controller contains configuration concerning the controller framework.
 type Controller struct {
 	lsconfigv1alpha1.CommonControllerConfig
+
+	// MaxConcurrentDeployItemsPerTarget is the maximum number of deploy items with the same target
+	// which are processed concurrently by the deployer. Further deploy items are queued.
+	// If not set, the number is not limited.
+	// +optional
+	MaxConcurrentDeployItemsPerTarget int `json:"maxConcurrentDeployItemsPerTarget,omitempty"`
 }
@@ -67,6 +67,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManifestsFromSource)(nil), (*manifest.ManifestsFromSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ManifestsFromSource_To_manifest_ManifestsFromSource(a.(*ManifestsFromSource), b.(*manifest.ManifestsFromSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*manifest.ManifestsFromSource)(nil), (*ManifestsFromSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifest_ManifestsFromSource_To_v1alpha2_ManifestsFromSource(a.(*manifest.ManifestsFromSource), b.(*ManifestsFromSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*manifest.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(a.(*ProviderConfiguration), b.(*manifest.ProviderConfiguration), scope)
 	}); err != nil {
@@ -128,6 +138,7 @@ func Convert_manifest_Configuration_To_v1alpha2_Configuration(in *manifest.Confi
 
 func autoConvert_v1alpha2_Controller_To_manifest_Controller(in *Controller, out *manifest.Controller, s conversion.Scope) error {
 	out.CommonControllerConfig = in.CommonControllerConfig
+	out.MaxConcurrentDeployItemsPerTarget = in.MaxConcurrentDeployItemsPerTarget
 	return nil
 }
 
@@ -138,6 +149,7 @@ func Convert_v1alpha2_Controller_To_manifest_Controller(in *Controller, out *man
 
 func autoConvert_manifest_Controller_To_v1alpha2_Controller(in *manifest.Controller, out *Controller, s conversion.Scope) error {
 	out.CommonControllerConfig = in.CommonControllerConfig
+	out.MaxConcurrentDeployItemsPerTarget = in.MaxConcurrentDeployItemsPerTarget
 	return nil
 }
 
@@ -186,14 +198,38 @@ func Convert_manifest_HPAConfiguration_To_v1alpha2_HPAConfiguration(in *manifest
 	return autoConvert_manifest_HPAConfiguration_To_v1alpha2_HPAConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha2_ManifestsFromSource_To_manifest_ManifestsFromSource(in *ManifestsFromSource, out *manifest.ManifestsFromSource, s conversion.Scope) error {
+	out.DataReference = in.DataReference
+	out.Policy = managedresource.ManifestPolicy(in.Policy)
+	return nil
+}
+
+// Convert_v1alpha2_ManifestsFromSource_To_manifest_ManifestsFromSource is an autogenerated conversion function.
+func Convert_v1alpha2_ManifestsFromSource_To_manifest_ManifestsFromSource(in *ManifestsFromSource, out *manifest.ManifestsFromSource, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ManifestsFromSource_To_manifest_ManifestsFromSource(in, out, s)
+}
+
+func autoConvert_manifest_ManifestsFromSource_To_v1alpha2_ManifestsFromSource(in *manifest.ManifestsFromSource, out *ManifestsFromSource, s conversion.Scope) error {
+	out.DataReference = in.DataReference
+	out.Policy = managedresource.ManifestPolicy(in.Policy)
+	return nil
+}
+
+// Convert_manifest_ManifestsFromSource_To_v1alpha2_ManifestsFromSource is an autogenerated conversion function.
+func Convert_manifest_ManifestsFromSource_To_v1alpha2_ManifestsFromSource(in *manifest.ManifestsFromSource, out *ManifestsFromSource, s conversion.Scope) error {
+	return autoConvert_manifest_ManifestsFromSource_To_v1alpha2_ManifestsFromSource(in, out, s)
+}
+
 func autoConvert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(in *ProviderConfiguration, out *manifest.ProviderConfiguration, s conversion.Scope) error {
 	out.UpdateStrategy = manifest.UpdateStrategy(in.UpdateStrategy)
 	out.ReadinessChecks = in.ReadinessChecks
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
+	out.ManifestsFrom = *(*[]manifest.ManifestsFromSource)(unsafe.Pointer(&in.ManifestsFrom))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
+	out.TakeoverPolicy = managedresource.TakeoverPolicy(in.TakeoverPolicy)
 	return nil
 }
 
@@ -206,10 +242,12 @@ func autoConvert_manifest_ProviderConfiguration_To_v1alpha2_ProviderConfiguratio
 	out.UpdateStrategy = UpdateStrategy(in.UpdateStrategy)
 	out.ReadinessChecks = in.ReadinessChecks
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
+	out.ManifestsFrom = *(*[]ManifestsFromSource)(unsafe.Pointer(&in.ManifestsFrom))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
+	out.TakeoverPolicy = managedresource.TakeoverPolicy(in.TakeoverPolicy)
 	return nil
 }
 
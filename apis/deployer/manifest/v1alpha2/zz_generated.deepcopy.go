@@ -101,6 +101,22 @@ func (in *HPAConfiguration) DeepCopy() *HPAConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestsFromSource) DeepCopyInto(out *ManifestsFromSource) {
+	*out = *in
+	in.DataReference.DeepCopyInto(&out.DataReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestsFromSource.
+func (in *ManifestsFromSource) DeepCopy() *ManifestsFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestsFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
@@ -113,6 +129,13 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ManifestsFrom != nil {
+		in, out := &in.ManifestsFrom, &out.ManifestsFrom
+		*out = make([]ManifestsFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Exports != nil {
 		in, out := &in.Exports, &out.Exports
 		*out = new(managedresource.Exports)
@@ -10,6 +10,7 @@ import (
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 	manifestv1alpha2 "github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2"
 	crval "github.com/openmcp-project/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
 	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource/validation"
 	health "github.com/openmcp-project/landscaper/apis/deployer/utils/readinesschecks/validation"
 )
@@ -21,9 +22,30 @@ func ValidateProviderConfiguration(config *manifestv1alpha2.ProviderConfiguratio
 	allErrs = append(allErrs, health.ValidateReadinessCheckConfiguration(field.NewPath(""), &config.ReadinessChecks)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
 	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	allErrs = append(allErrs, validation.ValidateTakeoverPolicy(field.NewPath("takeoverPolicy"), config.TakeoverPolicy)...)
+	allErrs = append(allErrs, ValidateManifestsFrom(field.NewPath("manifestsFrom"), config.ManifestsFrom)...)
 	return allErrs.ToAggregate()
 }
 
+// ValidateManifestsFrom validates the references to manifests in Secrets and ConfigMaps.
+func ValidateManifestsFrom(fldPath *field.Path, sources []manifestv1alpha2.ManifestsFromSource) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, source := range sources {
+		allErrs = append(allErrs, validation.ValidateDataReference(fldPath.Index(i), source.DataReference)...)
+		switch source.Policy {
+		case "", managedresource.ManagePolicy, managedresource.FallbackPolicy, managedresource.KeepPolicy, managedresource.IgnorePolicy:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i).Child("policy"), source.Policy, []string{
+				string(managedresource.ManagePolicy),
+				string(managedresource.FallbackPolicy),
+				string(managedresource.KeepPolicy),
+				string(managedresource.IgnorePolicy),
+			}))
+		}
+	}
+	return allErrs
+}
+
 // ValidateTimeout validates a timeout.
 func ValidateTimeout(fldPath *field.Path, timeout *lsv1alpha1.Duration) field.ErrorList {
 	allErrs := field.ErrorList{}
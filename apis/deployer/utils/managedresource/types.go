@@ -6,6 +6,7 @@ package managedresource
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
@@ -31,6 +32,45 @@ const (
 	ImmutablePolicy ManifestPolicy = "immutable"
 )
 
+// OwnerDeployItemAnnotation is the annotation that the manifest and helm deployer add to the objects they apply to
+// the target cluster. It identifies the deploy item that owns the object, in the form "<namespace>/<name>".
+const OwnerDeployItemAnnotation = "landscaper.gardener.cloud/owner-deployitem"
+
+// TakeoverPolicy defines whether the deployer takes over objects which are owned by another deploy item.
+type TakeoverPolicy string
+
+const (
+	// TakeoverPolicyNever is the default policy, where the deployer refuses to update an object which is owned by
+	// another deploy item and reports an ownership conflict.
+	TakeoverPolicyNever TakeoverPolicy = "never"
+	// TakeoverPolicyAlways defines a policy where the deployer takes over objects which are owned by another deploy
+	// item, e.g. to migrate objects from one deploy item to another.
+	TakeoverPolicyAlways TakeoverPolicy = "always"
+)
+
+// ApplyState describes the result of the last attempt to apply a managed resource.
+type ApplyState string
+
+const (
+	// ApplyStateApplied indicates that the resource has been successfully applied to the target cluster.
+	ApplyStateApplied ApplyState = "Applied"
+	// ApplyStateFailed indicates that the resource could not be applied to the target cluster.
+	ApplyStateFailed ApplyState = "Failed"
+)
+
+// ReadinessState describes the result of the readiness checks of a managed resource.
+type ReadinessState string
+
+const (
+	// ReadinessStatePending indicates that the readiness of the resource has not yet been confirmed,
+	// e.g. because the readiness checks are still waiting for the resource or for another resource.
+	ReadinessStatePending ReadinessState = "Pending"
+	// ReadinessStateReady indicates that the resource has passed the readiness checks.
+	ReadinessStateReady ReadinessState = "Ready"
+	// ReadinessStateNotReady indicates that the resource did not pass the last readiness check.
+	ReadinessStateNotReady ReadinessState = "NotReady"
+)
+
 // Manifest defines a manifest that is managed by the deployer.
 type Manifest struct {
 	// Policy defines the manage policy for that resource.
@@ -79,6 +119,18 @@ func (mr ManagedResourceStatusList) TypedObjectReferenceList() []lsv1alpha1.Type
 	return list
 }
 
+// Find returns the entry of the list which describes the given resource.
+// Entries are matched by apiVersion, kind, namespace and name. It returns nil if no entry matches.
+func (mr ManagedResourceStatusList) Find(ref corev1.ObjectReference) *ManagedResourceStatus {
+	for i := range mr {
+		res := mr[i].Resource
+		if res.APIVersion == ref.APIVersion && res.Kind == ref.Kind && res.Namespace == ref.Namespace && res.Name == ref.Name {
+			return &mr[i]
+		}
+	}
+	return nil
+}
+
 // ManagedResourceStatus describes the managed resource and their metadata.
 type ManagedResourceStatus struct {
 	// AnnotateBeforeDelete defines annotations that are being set before the manifest is being deleted.
@@ -91,6 +143,29 @@ type ManagedResourceStatus struct {
 	Policy ManifestPolicy `json:"policy,omitempty"`
 	// Resources describes the managed kubernetes resource.
 	Resource corev1.ObjectReference `json:"resource"`
+	// ApplyState is the result of the last attempt to apply the resource.
+	// +optional
+	ApplyState ApplyState `json:"applyState,omitempty"`
+	// ReadinessState is the result of the readiness checks of the resource.
+	// +optional
+	ReadinessState ReadinessState `json:"readinessState,omitempty"`
+	// LastTransitionTime is the last time the apply state or the readiness state changed.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Message describes why the resource could not be applied or is not ready.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// SetState sets the apply state, the readiness state and the message of the managed resource.
+// The last transition time is only updated if the apply state or the readiness state changes.
+func (mr *ManagedResourceStatus) SetState(applyState ApplyState, readinessState ReadinessState, message string, now metav1.Time) {
+	if mr.LastTransitionTime == nil || mr.ApplyState != applyState || mr.ReadinessState != readinessState {
+		mr.LastTransitionTime = &now
+	}
+	mr.ApplyState = applyState
+	mr.ReadinessState = readinessState
+	mr.Message = message
 }
 
 // Exports describes one export that is read from a resource.
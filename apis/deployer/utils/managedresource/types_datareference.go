@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+)
+
+// DataReference references the data in a key of a Secret or a ConfigMap.
+// It allows to keep data like credentials out of the configuration of a deploy item, as the data is only read by the
+// deployer right before it is used. Exactly one of SecretRef and ConfigMapRef has to be set.
+type DataReference struct {
+	// SecretRef references a key of a Secret.
+	// +optional
+	SecretRef *lsv1alpha1.SecretReference `json:"secretRef,omitempty"`
+	// ConfigMapRef references a key of a ConfigMap.
+	// +optional
+	ConfigMapRef *lsv1alpha1.ConfigMapReference `json:"configMapRef,omitempty"`
+	// FromTarget defines whether the Secret or ConfigMap is read from the target cluster.
+	// By default, it is read from the landscaper cluster, where it has to be in the namespace of the deploy item.
+	// In the target cluster, the namespace of the Secret or ConfigMap is required.
+	// +optional
+	FromTarget bool `json:"fromTarget,omitempty"`
+}
+
+// HasTargetReference returns whether one of the data references reads from the target cluster.
+func HasTargetReference(refs []DataReference) bool {
+	for _, ref := range refs {
+		if ref.FromTarget {
+			return true
+		}
+	}
+	return false
+}
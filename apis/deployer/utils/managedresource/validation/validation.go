@@ -82,6 +82,60 @@ func ValidateFromObjectReference(fldPath *field.Path, ref *managedresource.FromO
 	return allErrs
 }
 
+// ValidateTakeoverPolicy validates the policy for objects which are owned by another deploy item.
+func ValidateTakeoverPolicy(fldPath *field.Path, policy managedresource.TakeoverPolicy) field.ErrorList {
+	var allErrs field.ErrorList
+	switch policy {
+	case "", managedresource.TakeoverPolicyNever, managedresource.TakeoverPolicyAlways:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, policy, []string{
+			string(managedresource.TakeoverPolicyNever),
+			string(managedresource.TakeoverPolicyAlways),
+		}))
+	}
+	return allErrs
+}
+
+// ValidateDataReferences validates references to data in Secrets and ConfigMaps.
+func ValidateDataReferences(fldPath *field.Path, refs []managedresource.DataReference) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, ref := range refs {
+		allErrs = append(allErrs, ValidateDataReference(fldPath.Index(i), ref)...)
+	}
+	return allErrs
+}
+
+// ValidateDataReference validates a reference to data in a Secret or ConfigMap.
+func ValidateDataReference(fldPath *field.Path, ref managedresource.DataReference) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var (
+		name, namespace, key string
+		refPath              *field.Path
+	)
+	switch {
+	case ref.SecretRef != nil && ref.ConfigMapRef != nil:
+		return append(allErrs, field.Forbidden(fldPath.Child("configMapRef"), "must not be set if secretRef is set"))
+	case ref.SecretRef != nil:
+		name, namespace, key, refPath = ref.SecretRef.Name, ref.SecretRef.Namespace, ref.SecretRef.Key, fldPath.Child("secretRef")
+	case ref.ConfigMapRef != nil:
+		name, namespace, key, refPath = ref.ConfigMapRef.Name, ref.ConfigMapRef.Namespace, ref.ConfigMapRef.Key, fldPath.Child("configMapRef")
+	default:
+		return append(allErrs, field.Required(fldPath, "either secretRef or configMapRef must be set"))
+	}
+
+	if len(name) == 0 {
+		allErrs = append(allErrs, field.Required(refPath.Child("name"), "must not be empty"))
+	}
+	if len(key) == 0 {
+		allErrs = append(allErrs, field.Required(refPath.Child("key"), "must not be empty"))
+	}
+	if ref.FromTarget && len(namespace) == 0 {
+		allErrs = append(allErrs, field.Required(refPath.Child("namespace"), "must not be empty if fromTarget is set"))
+	}
+	return allErrs
+}
+
 func ValidateDeletionGroups(fldPath *field.Path, groups []managedresource.DeletionGroupDefinition) field.ErrorList {
 	var allErrs field.ErrorList
 	for i, g := range groups {
@@ -28,6 +28,61 @@ var _ = Describe("Validation", func() {
 		fld = field.NewPath("a")
 	)
 
+	Context("TakeoverPolicy", func() {
+		It("should accept the supported policies", func() {
+			Expect(validation.ValidateTakeoverPolicy(fld, "")).To(BeEmpty())
+			Expect(validation.ValidateTakeoverPolicy(fld, managedresource.TakeoverPolicyNever)).To(BeEmpty())
+			Expect(validation.ValidateTakeoverPolicy(fld, managedresource.TakeoverPolicyAlways)).To(BeEmpty())
+		})
+
+		It("should deny an unknown policy", func() {
+			allErrs := validation.ValidateTakeoverPolicy(fld, "sometimes")
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("a"),
+			}))))
+		})
+	})
+
+	Context("DataReference", func() {
+		It("should accept a reference to a key of a secret or configmap", func() {
+			refs := []managedresource.DataReference{
+				{SecretRef: &lsv1alpha1.SecretReference{ObjectReference: lsv1alpha1.ObjectReference{Name: "values"}, Key: "values.yaml"}},
+				{
+					ConfigMapRef: &lsv1alpha1.ConfigMapReference{ObjectReference: lsv1alpha1.ObjectReference{Name: "values", Namespace: "default"}, Key: "values.yaml"},
+					FromTarget:   true,
+				},
+			}
+			Expect(validation.ValidateDataReferences(fld, refs)).To(BeEmpty())
+		})
+
+		It("should deny a reference without secret and configmap", func() {
+			allErrs := validation.ValidateDataReferences(fld, []managedresource.DataReference{{}})
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("a[0]"),
+			}))))
+		})
+
+		It("should deny a reference without key and a target reference without namespace", func() {
+			ref := managedresource.DataReference{
+				SecretRef:  &lsv1alpha1.SecretReference{ObjectReference: lsv1alpha1.ObjectReference{Name: "values"}},
+				FromTarget: true,
+			}
+			allErrs := validation.ValidateDataReference(fld, ref)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("a.secretRef.key"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("a.secretRef.namespace"),
+				})),
+			))
+		})
+	})
+
 	Context("Export", func() {
 		It("should accept if a key and a jsonpath is set", func() {
 			export := &managedresource.Export{
@@ -30,6 +30,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/config.ContextsController":                                        schema_openmcp_project_landscaper_apis_config_ContextsController(ref),
 		"github.com/openmcp-project/landscaper/apis/config.Controllers":                                               schema_openmcp_project_landscaper_apis_config_Controllers(ref),
 		"github.com/openmcp-project/landscaper/apis/config.CrdManagementConfiguration":                                schema_openmcp_project_landscaper_apis_config_CrdManagementConfiguration(ref),
+		"github.com/openmcp-project/landscaper/apis/config.DeletionSafeguard":                                         schema_openmcp_project_landscaper_apis_config_DeletionSafeguard(ref),
 		"github.com/openmcp-project/landscaper/apis/config.DeployItemTimeouts":                                        schema_openmcp_project_landscaper_apis_config_DeployItemTimeouts(ref),
 		"github.com/openmcp-project/landscaper/apis/config.DeployItemsController":                                     schema_openmcp_project_landscaper_apis_config_DeployItemsController(ref),
 		"github.com/openmcp-project/landscaper/apis/config.ExecutionsController":                                      schema_openmcp_project_landscaper_apis_config_ExecutionsController(ref),
@@ -43,6 +44,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/config.OCICacheConfiguration":                                     schema_openmcp_project_landscaper_apis_config_OCICacheConfiguration(ref),
 		"github.com/openmcp-project/landscaper/apis/config.OCIConfiguration":                                          schema_openmcp_project_landscaper_apis_config_OCIConfiguration(ref),
 		"github.com/openmcp-project/landscaper/apis/config.RegistryConfiguration":                                     schema_openmcp_project_landscaper_apis_config_RegistryConfiguration(ref),
+		"github.com/openmcp-project/landscaper/apis/config.TargetChangeControllerConfig":                              schema_openmcp_project_landscaper_apis_config_TargetChangeControllerConfig(ref),
+		"github.com/openmcp-project/landscaper/apis/config.TargetChangesController":                                   schema_openmcp_project_landscaper_apis_config_TargetChangesController(ref),
+		"github.com/openmcp-project/landscaper/apis/config.TargetControllerConfig":                                    schema_openmcp_project_landscaper_apis_config_TargetControllerConfig(ref),
+		"github.com/openmcp-project/landscaper/apis/config.TargetsController":                                         schema_openmcp_project_landscaper_apis_config_TargetsController(ref),
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.AdditionalDeployments":                            schema_landscaper_apis_config_v1alpha1_AdditionalDeployments(ref),
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.BlueprintStore":                                   schema_landscaper_apis_config_v1alpha1_BlueprintStore(ref),
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.CommonControllerConfig":                           schema_landscaper_apis_config_v1alpha1_CommonControllerConfig(ref),
@@ -51,6 +56,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.ContextsController":                               schema_landscaper_apis_config_v1alpha1_ContextsController(ref),
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.Controllers":                                      schema_landscaper_apis_config_v1alpha1_Controllers(ref),
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.CrdManagementConfiguration":                       schema_landscaper_apis_config_v1alpha1_CrdManagementConfiguration(ref),
+		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.DeletionSafeguard":                                schema_landscaper_apis_config_v1alpha1_DeletionSafeguard(ref),
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.DeployItemTimeouts":                               schema_landscaper_apis_config_v1alpha1_DeployItemTimeouts(ref),
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.DeployItemsController":                            schema_landscaper_apis_config_v1alpha1_DeployItemsController(ref),
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.ExecutionsController":                             schema_landscaper_apis_config_v1alpha1_ExecutionsController(ref),
@@ -64,9 +70,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.OCICacheConfiguration":                            schema_landscaper_apis_config_v1alpha1_OCICacheConfiguration(ref),
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.OCIConfiguration":                                 schema_landscaper_apis_config_v1alpha1_OCIConfiguration(ref),
 		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.RegistryConfiguration":                            schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref),
+		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetChangeControllerConfig":                     schema_landscaper_apis_config_v1alpha1_TargetChangeControllerConfig(ref),
+		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetChangesController":                          schema_landscaper_apis_config_v1alpha1_TargetChangesController(ref),
+		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetControllerConfig":                           schema_landscaper_apis_config_v1alpha1_TargetControllerConfig(ref),
+		"github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetsController":                                schema_landscaper_apis_config_v1alpha1_TargetsController(ref),
 		"github.com/openmcp-project/landscaper/apis/core.AnyJSON":                                                     schema_openmcp_project_landscaper_apis_core_AnyJSON(ref),
 		"github.com/openmcp-project/landscaper/apis/core.AutomaticReconcile":                                          schema_openmcp_project_landscaper_apis_core_AutomaticReconcile(ref),
 		"github.com/openmcp-project/landscaper/apis/core.AutomaticReconcileStatus":                                    schema_openmcp_project_landscaper_apis_core_AutomaticReconcileStatus(ref),
+		"github.com/openmcp-project/landscaper/apis/core.BlockingObject":                                              schema_openmcp_project_landscaper_apis_core_BlockingObject(ref),
 		"github.com/openmcp-project/landscaper/apis/core.Blueprint":                                                   schema_openmcp_project_landscaper_apis_core_Blueprint(ref),
 		"github.com/openmcp-project/landscaper/apis/core.BlueprintDefinition":                                         schema_openmcp_project_landscaper_apis_core_BlueprintDefinition(ref),
 		"github.com/openmcp-project/landscaper/apis/core.BlueprintStaticDataSource":                                   schema_openmcp_project_landscaper_apis_core_BlueprintStaticDataSource(ref),
@@ -88,13 +99,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core.CriticalProblemsSpec":                                        schema_openmcp_project_landscaper_apis_core_CriticalProblemsSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core.CriticalProblemsStatus":                                      schema_openmcp_project_landscaper_apis_core_CriticalProblemsStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core.DataExport":                                                  schema_openmcp_project_landscaper_apis_core_DataExport(ref),
+		"github.com/openmcp-project/landscaper/apis/core.DataExportAggregation":                                       schema_openmcp_project_landscaper_apis_core_DataExportAggregation(ref),
 		"github.com/openmcp-project/landscaper/apis/core.DataImport":                                                  schema_openmcp_project_landscaper_apis_core_DataImport(ref),
+		"github.com/openmcp-project/landscaper/apis/core.DataImportAggregation":                                       schema_openmcp_project_landscaper_apis_core_DataImportAggregation(ref),
 		"github.com/openmcp-project/landscaper/apis/core.DataObject":                                                  schema_openmcp_project_landscaper_apis_core_DataObject(ref),
 		"github.com/openmcp-project/landscaper/apis/core.DataObjectList":                                              schema_openmcp_project_landscaper_apis_core_DataObjectList(ref),
 		"github.com/openmcp-project/landscaper/apis/core.Default":                                                     schema_openmcp_project_landscaper_apis_core_Default(ref),
+		"github.com/openmcp-project/landscaper/apis/core.DeletionImpact":                                              schema_openmcp_project_landscaper_apis_core_DeletionImpact(ref),
 		"github.com/openmcp-project/landscaper/apis/core.DependentToTrigger":                                          schema_openmcp_project_landscaper_apis_core_DependentToTrigger(ref),
 		"github.com/openmcp-project/landscaper/apis/core.DeployItem":                                                  schema_openmcp_project_landscaper_apis_core_DeployItem(ref),
 		"github.com/openmcp-project/landscaper/apis/core.DeployItemCache":                                             schema_openmcp_project_landscaper_apis_core_DeployItemCache(ref),
+		"github.com/openmcp-project/landscaper/apis/core.DeployItemForEach":                                           schema_openmcp_project_landscaper_apis_core_DeployItemForEach(ref),
 		"github.com/openmcp-project/landscaper/apis/core.DeployItemList":                                              schema_openmcp_project_landscaper_apis_core_DeployItemList(ref),
 		"github.com/openmcp-project/landscaper/apis/core.DeployItemSpec":                                              schema_openmcp_project_landscaper_apis_core_DeployItemSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core.DeployItemStatus":                                            schema_openmcp_project_landscaper_apis_core_DeployItemStatus(ref),
@@ -108,14 +123,26 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core.ExecutionSpec":                                               schema_openmcp_project_landscaper_apis_core_ExecutionSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core.ExecutionStatus":                                             schema_openmcp_project_landscaper_apis_core_ExecutionStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core.ExportDefinition":                                            schema_openmcp_project_landscaper_apis_core_ExportDefinition(ref),
+		"github.com/openmcp-project/landscaper/apis/core.FailedObject":                                                schema_openmcp_project_landscaper_apis_core_FailedObject(ref),
 		"github.com/openmcp-project/landscaper/apis/core.FailedReconcile":                                             schema_openmcp_project_landscaper_apis_core_FailedReconcile(ref),
 		"github.com/openmcp-project/landscaper/apis/core.FieldValueDefinition":                                        schema_openmcp_project_landscaper_apis_core_FieldValueDefinition(ref),
 		"github.com/openmcp-project/landscaper/apis/core.ImportDefinition":                                            schema_openmcp_project_landscaper_apis_core_ImportDefinition(ref),
+		"github.com/openmcp-project/landscaper/apis/core.IncompleteChild":                                             schema_openmcp_project_landscaper_apis_core_IncompleteChild(ref),
 		"github.com/openmcp-project/landscaper/apis/core.InlineBlueprint":                                             schema_openmcp_project_landscaper_apis_core_InlineBlueprint(ref),
 		"github.com/openmcp-project/landscaper/apis/core.Installation":                                                schema_openmcp_project_landscaper_apis_core_Installation(ref),
 		"github.com/openmcp-project/landscaper/apis/core.InstallationExports":                                         schema_openmcp_project_landscaper_apis_core_InstallationExports(ref),
 		"github.com/openmcp-project/landscaper/apis/core.InstallationImports":                                         schema_openmcp_project_landscaper_apis_core_InstallationImports(ref),
 		"github.com/openmcp-project/landscaper/apis/core.InstallationList":                                            schema_openmcp_project_landscaper_apis_core_InstallationList(ref),
+		"github.com/openmcp-project/landscaper/apis/core.InstallationSet":                                             schema_openmcp_project_landscaper_apis_core_InstallationSet(ref),
+		"github.com/openmcp-project/landscaper/apis/core.InstallationSetElementOverride":                              schema_openmcp_project_landscaper_apis_core_InstallationSetElementOverride(ref),
+		"github.com/openmcp-project/landscaper/apis/core.InstallationSetGenerator":                                    schema_openmcp_project_landscaper_apis_core_InstallationSetGenerator(ref),
+		"github.com/openmcp-project/landscaper/apis/core.InstallationSetInstallationStatus":                           schema_openmcp_project_landscaper_apis_core_InstallationSetInstallationStatus(ref),
+		"github.com/openmcp-project/landscaper/apis/core.InstallationSetList":                                         schema_openmcp_project_landscaper_apis_core_InstallationSetList(ref),
+		"github.com/openmcp-project/landscaper/apis/core.InstallationSetParameterSet":                                 schema_openmcp_project_landscaper_apis_core_InstallationSetParameterSet(ref),
+		"github.com/openmcp-project/landscaper/apis/core.InstallationSetSpec":                                         schema_openmcp_project_landscaper_apis_core_InstallationSetSpec(ref),
+		"github.com/openmcp-project/landscaper/apis/core.InstallationSetStatus":                                       schema_openmcp_project_landscaper_apis_core_InstallationSetStatus(ref),
+		"github.com/openmcp-project/landscaper/apis/core.InstallationSetTargetsGenerator":                             schema_openmcp_project_landscaper_apis_core_InstallationSetTargetsGenerator(ref),
+		"github.com/openmcp-project/landscaper/apis/core.InstallationSetTemplate":                                     schema_openmcp_project_landscaper_apis_core_InstallationSetTemplate(ref),
 		"github.com/openmcp-project/landscaper/apis/core.InstallationSpec":                                            schema_openmcp_project_landscaper_apis_core_InstallationSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core.InstallationStatus":                                          schema_openmcp_project_landscaper_apis_core_InstallationStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core.InstallationTemplate":                                        schema_openmcp_project_landscaper_apis_core_InstallationTemplate(ref),
@@ -125,14 +152,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core.LocalSecretReference":                                        schema_openmcp_project_landscaper_apis_core_LocalSecretReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core.LsHealthCheck":                                               schema_openmcp_project_landscaper_apis_core_LsHealthCheck(ref),
 		"github.com/openmcp-project/landscaper/apis/core.LsHealthCheckList":                                           schema_openmcp_project_landscaper_apis_core_LsHealthCheckList(ref),
+		"github.com/openmcp-project/landscaper/apis/core.ManagedResourceReference":                                    schema_openmcp_project_landscaper_apis_core_ManagedResourceReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core.NamedObjectReference":                                        schema_openmcp_project_landscaper_apis_core_NamedObjectReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core.ObjectReference":                                             schema_openmcp_project_landscaper_apis_core_ObjectReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core.OnDeleteConfig":                                              schema_openmcp_project_landscaper_apis_core_OnDeleteConfig(ref),
 		"github.com/openmcp-project/landscaper/apis/core.Optimization":                                                schema_openmcp_project_landscaper_apis_core_Optimization(ref),
+		"github.com/openmcp-project/landscaper/apis/core.PendingApproval":                                             schema_openmcp_project_landscaper_apis_core_PendingApproval(ref),
+		"github.com/openmcp-project/landscaper/apis/core.PendingDeletion":                                             schema_openmcp_project_landscaper_apis_core_PendingDeletion(ref),
 		"github.com/openmcp-project/landscaper/apis/core.RemoteBlueprintReference":                                    schema_openmcp_project_landscaper_apis_core_RemoteBlueprintReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core.Requirement":                                                 schema_openmcp_project_landscaper_apis_core_Requirement(ref),
 		"github.com/openmcp-project/landscaper/apis/core.ResolvedTarget":                                              schema_openmcp_project_landscaper_apis_core_ResolvedTarget(ref),
 		"github.com/openmcp-project/landscaper/apis/core.ResourceReference":                                           schema_openmcp_project_landscaper_apis_core_ResourceReference(ref),
+		"github.com/openmcp-project/landscaper/apis/core.RetryBackoff":                                                schema_openmcp_project_landscaper_apis_core_RetryBackoff(ref),
+		"github.com/openmcp-project/landscaper/apis/core.RetryPolicy":                                                 schema_openmcp_project_landscaper_apis_core_RetryPolicy(ref),
+		"github.com/openmcp-project/landscaper/apis/core.RetryStatus":                                                 schema_openmcp_project_landscaper_apis_core_RetryStatus(ref),
+		"github.com/openmcp-project/landscaper/apis/core.RolloutPolicy":                                               schema_openmcp_project_landscaper_apis_core_RolloutPolicy(ref),
+		"github.com/openmcp-project/landscaper/apis/core.RolloutStatus":                                               schema_openmcp_project_landscaper_apis_core_RolloutStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core.SecretLabelSelectorRef":                                      schema_openmcp_project_landscaper_apis_core_SecretLabelSelectorRef(ref),
 		"github.com/openmcp-project/landscaper/apis/core.SecretReference":                                             schema_openmcp_project_landscaper_apis_core_SecretReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core.StaticDataSource":                                            schema_openmcp_project_landscaper_apis_core_StaticDataSource(ref),
@@ -140,6 +175,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core.SubInstCache":                                                schema_openmcp_project_landscaper_apis_core_SubInstCache(ref),
 		"github.com/openmcp-project/landscaper/apis/core.SubNamePair":                                                 schema_openmcp_project_landscaper_apis_core_SubNamePair(ref),
 		"github.com/openmcp-project/landscaper/apis/core.SubinstallationTemplate":                                     schema_openmcp_project_landscaper_apis_core_SubinstallationTemplate(ref),
+		"github.com/openmcp-project/landscaper/apis/core.SubtreeSummary":                                              schema_openmcp_project_landscaper_apis_core_SubtreeSummary(ref),
 		"github.com/openmcp-project/landscaper/apis/core.SucceededReconcile":                                          schema_openmcp_project_landscaper_apis_core_SucceededReconcile(ref),
 		"github.com/openmcp-project/landscaper/apis/core.SyncObject":                                                  schema_openmcp_project_landscaper_apis_core_SyncObject(ref),
 		"github.com/openmcp-project/landscaper/apis/core.SyncObjectList":                                              schema_openmcp_project_landscaper_apis_core_SyncObjectList(ref),
@@ -151,12 +187,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core.TargetList":                                                  schema_openmcp_project_landscaper_apis_core_TargetList(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TargetSelector":                                              schema_openmcp_project_landscaper_apis_core_TargetSelector(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TargetSpec":                                                  schema_openmcp_project_landscaper_apis_core_TargetSpec(ref),
+		"github.com/openmcp-project/landscaper/apis/core.TargetStatus":                                                schema_openmcp_project_landscaper_apis_core_TargetStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TargetSync":                                                  schema_openmcp_project_landscaper_apis_core_TargetSync(ref),
+		"github.com/openmcp-project/landscaper/apis/core.TargetSyncClusterAPISource":                                  schema_openmcp_project_landscaper_apis_core_TargetSyncClusterAPISource(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TargetSyncList":                                              schema_openmcp_project_landscaper_apis_core_TargetSyncList(ref),
+		"github.com/openmcp-project/landscaper/apis/core.TargetSyncSecretSelectorSource":                              schema_openmcp_project_landscaper_apis_core_TargetSyncSecretSelectorSource(ref),
+		"github.com/openmcp-project/landscaper/apis/core.TargetSyncSourceStatus":                                      schema_openmcp_project_landscaper_apis_core_TargetSyncSourceStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TargetSyncSpec":                                              schema_openmcp_project_landscaper_apis_core_TargetSyncSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TargetSyncStatus":                                            schema_openmcp_project_landscaper_apis_core_TargetSyncStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TargetTemplate":                                              schema_openmcp_project_landscaper_apis_core_TargetTemplate(ref),
+		"github.com/openmcp-project/landscaper/apis/core.TargetTypeDefinition":                                        schema_openmcp_project_landscaper_apis_core_TargetTypeDefinition(ref),
+		"github.com/openmcp-project/landscaper/apis/core.TargetTypeDefinitionList":                                    schema_openmcp_project_landscaper_apis_core_TargetTypeDefinitionList(ref),
+		"github.com/openmcp-project/landscaper/apis/core.TargetTypeDefinitionSpec":                                    schema_openmcp_project_landscaper_apis_core_TargetTypeDefinitionSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TemplateExecutor":                                            schema_openmcp_project_landscaper_apis_core_TemplateExecutor(ref),
+		"github.com/openmcp-project/landscaper/apis/core.TestResult":                                                  schema_openmcp_project_landscaper_apis_core_TestResult(ref),
+		"github.com/openmcp-project/landscaper/apis/core.TestStatus":                                                  schema_openmcp_project_landscaper_apis_core_TestStatus(ref),
+		"github.com/openmcp-project/landscaper/apis/core.TimeoutDetails":                                              schema_openmcp_project_landscaper_apis_core_TimeoutDetails(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TokenRotation":                                               schema_openmcp_project_landscaper_apis_core_TokenRotation(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TransitionTimes":                                             schema_openmcp_project_landscaper_apis_core_TransitionTimes(ref),
 		"github.com/openmcp-project/landscaper/apis/core.TypedObjectReference":                                        schema_openmcp_project_landscaper_apis_core_TypedObjectReference(ref),
@@ -168,6 +214,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON":                                            schema_landscaper_apis_core_v1alpha1_AnyJSON(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AutomaticReconcile":                                 schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus":                           schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.BlockingObject":                                     schema_landscaper_apis_core_v1alpha1_BlockingObject(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Blueprint":                                          schema_landscaper_apis_core_v1alpha1_Blueprint(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.BlueprintDefinition":                                schema_landscaper_apis_core_v1alpha1_BlueprintDefinition(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.BlueprintStaticDataSource":                          schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataSource(ref),
@@ -189,13 +236,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblemsSpec":                               schema_landscaper_apis_core_v1alpha1_CriticalProblemsSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblemsStatus":                             schema_landscaper_apis_core_v1alpha1_CriticalProblemsStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataExport":                                         schema_landscaper_apis_core_v1alpha1_DataExport(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataExportAggregation":                              schema_landscaper_apis_core_v1alpha1_DataExportAggregation(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataImport":                                         schema_landscaper_apis_core_v1alpha1_DataImport(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataImportAggregation":                              schema_landscaper_apis_core_v1alpha1_DataImportAggregation(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataObject":                                         schema_landscaper_apis_core_v1alpha1_DataObject(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataObjectList":                                     schema_landscaper_apis_core_v1alpha1_DataObjectList(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Default":                                            schema_landscaper_apis_core_v1alpha1_Default(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeletionImpact":                                     schema_landscaper_apis_core_v1alpha1_DeletionImpact(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DependentToTrigger":                                 schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItem":                                         schema_landscaper_apis_core_v1alpha1_DeployItem(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemCache":                                    schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemForEach":                                  schema_landscaper_apis_core_v1alpha1_DeployItemForEach(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemList":                                     schema_landscaper_apis_core_v1alpha1_DeployItemList(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemSpec":                                     schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemStatus":                                   schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref),
@@ -209,14 +260,26 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExecutionSpec":                                      schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExecutionStatus":                                    schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExportDefinition":                                   schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.FailedObject":                                       schema_landscaper_apis_core_v1alpha1_FailedObject(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.FailedReconcile":                                    schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.FieldValueDefinition":                               schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ImportDefinition":                                   schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.IncompleteChild":                                    schema_landscaper_apis_core_v1alpha1_IncompleteChild(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InlineBlueprint":                                    schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Installation":                                       schema_landscaper_apis_core_v1alpha1_Installation(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports":                                schema_landscaper_apis_core_v1alpha1_InstallationExports(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports":                                schema_landscaper_apis_core_v1alpha1_InstallationImports(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationList":                                   schema_landscaper_apis_core_v1alpha1_InstallationList(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSet":                                    schema_landscaper_apis_core_v1alpha1_InstallationSet(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetElementOverride":                     schema_landscaper_apis_core_v1alpha1_InstallationSetElementOverride(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetGenerator":                           schema_landscaper_apis_core_v1alpha1_InstallationSetGenerator(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetInstallationStatus":                  schema_landscaper_apis_core_v1alpha1_InstallationSetInstallationStatus(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetList":                                schema_landscaper_apis_core_v1alpha1_InstallationSetList(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetParameterSet":                        schema_landscaper_apis_core_v1alpha1_InstallationSetParameterSet(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetSpec":                                schema_landscaper_apis_core_v1alpha1_InstallationSetSpec(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetStatus":                              schema_landscaper_apis_core_v1alpha1_InstallationSetStatus(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetTargetsGenerator":                    schema_landscaper_apis_core_v1alpha1_InstallationSetTargetsGenerator(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetTemplate":                            schema_landscaper_apis_core_v1alpha1_InstallationSetTemplate(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSpec":                                   schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationStatus":                                 schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationTemplate":                               schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref),
@@ -226,14 +289,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference":                               schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.LsHealthCheck":                                      schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.LsHealthCheckList":                                  schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ManagedResourceReference":                           schema_landscaper_apis_core_v1alpha1_ManagedResourceReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.NamedObjectReference":                               schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference":                                    schema_landscaper_apis_core_v1alpha1_ObjectReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.OnDeleteConfig":                                     schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization":                                       schema_landscaper_apis_core_v1alpha1_Optimization(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.PendingApproval":                                    schema_landscaper_apis_core_v1alpha1_PendingApproval(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.PendingDeletion":                                    schema_landscaper_apis_core_v1alpha1_PendingDeletion(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.RemoteBlueprintReference":                           schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Requirement":                                        schema_landscaper_apis_core_v1alpha1_Requirement(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ResolvedTarget":                                     schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ResourceReference":                                  schema_landscaper_apis_core_v1alpha1_ResourceReference(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryBackoff":                                       schema_landscaper_apis_core_v1alpha1_RetryBackoff(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryPolicy":                                        schema_landscaper_apis_core_v1alpha1_RetryPolicy(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryStatus":                                        schema_landscaper_apis_core_v1alpha1_RetryStatus(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutPolicy":                                      schema_landscaper_apis_core_v1alpha1_RolloutPolicy(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutStatus":                                      schema_landscaper_apis_core_v1alpha1_RolloutStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef":                             schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SecretReference":                                    schema_landscaper_apis_core_v1alpha1_SecretReference(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.StaticDataSource":                                   schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref),
@@ -241,6 +312,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubInstCache":                                       schema_landscaper_apis_core_v1alpha1_SubInstCache(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubNamePair":                                        schema_landscaper_apis_core_v1alpha1_SubNamePair(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubinstallationTemplate":                            schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubtreeSummary":                                     schema_landscaper_apis_core_v1alpha1_SubtreeSummary(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SucceededReconcile":                                 schema_landscaper_apis_core_v1alpha1_SucceededReconcile(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObject":                                         schema_landscaper_apis_core_v1alpha1_SyncObject(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObjectList":                                     schema_landscaper_apis_core_v1alpha1_SyncObjectList(ref),
@@ -252,12 +324,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetList":                                         schema_landscaper_apis_core_v1alpha1_TargetList(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSelector":                                     schema_landscaper_apis_core_v1alpha1_TargetSelector(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSpec":                                         schema_landscaper_apis_core_v1alpha1_TargetSpec(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetStatus":                                       schema_landscaper_apis_core_v1alpha1_TargetStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSync":                                         schema_landscaper_apis_core_v1alpha1_TargetSync(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncClusterAPISource":                         schema_landscaper_apis_core_v1alpha1_TargetSyncClusterAPISource(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncList":                                     schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSecretSelectorSource":                     schema_landscaper_apis_core_v1alpha1_TargetSyncSecretSelectorSource(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSourceStatus":                             schema_landscaper_apis_core_v1alpha1_TargetSyncSourceStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSpec":                                     schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncStatus":                                   schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetTemplate":                                     schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetTypeDefinition":                               schema_landscaper_apis_core_v1alpha1_TargetTypeDefinition(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetTypeDefinitionList":                           schema_landscaper_apis_core_v1alpha1_TargetTypeDefinitionList(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetTypeDefinitionSpec":                           schema_landscaper_apis_core_v1alpha1_TargetTypeDefinitionSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor":                                   schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TestResult":                                         schema_landscaper_apis_core_v1alpha1_TestResult(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TestStatus":                                         schema_landscaper_apis_core_v1alpha1_TestStatus(ref),
+		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TimeoutDetails":                                     schema_landscaper_apis_core_v1alpha1_TimeoutDetails(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TokenRotation":                                      schema_landscaper_apis_core_v1alpha1_TokenRotation(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes":                                    schema_landscaper_apis_core_v1alpha1_TransitionTimes(ref),
 		"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TypedObjectReference":                               schema_landscaper_apis_core_v1alpha1_TypedObjectReference(ref),
@@ -314,6 +396,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration":               schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.HelmInstallConfiguration":                  schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.HelmUninstallConfiguration":                schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref),
+		"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.PatchTarget":                               schema_apis_deployer_helm_v1alpha1_PatchTarget(ref),
+		"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.PostRenderPatch":                           schema_apis_deployer_helm_v1alpha1_PostRenderPatch(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.ProviderStatus":                            schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess":                       schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref),
@@ -335,6 +419,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2.Controller":                            schema_apis_deployer_manifest_v1alpha2_Controller(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration":                   schema_apis_deployer_manifest_v1alpha2_ExportConfiguration(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2.HPAConfiguration":                      schema_apis_deployer_manifest_v1alpha2_HPAConfiguration(ref),
+		"github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2.ManifestsFromSource":                   schema_apis_deployer_manifest_v1alpha2_ManifestsFromSource(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2.ProviderConfiguration":                 schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2.ProviderStatus":                        schema_apis_deployer_manifest_v1alpha2_ProviderStatus(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/mock.Configuration":                                      schema_landscaper_apis_deployer_mock_Configuration(ref),
@@ -343,6 +428,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/openmcp-project/landscaper/apis/deployer/mock/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec":       schema_apis_deployer_utils_continuousreconcile_ContinuousReconcileSpec(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.CustomResourceGroup":               schema_apis_deployer_utils_managedresource_CustomResourceGroup(ref),
+		"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.DataReference":                     schema_apis_deployer_utils_managedresource_DataReference(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition":           schema_apis_deployer_utils_managedresource_DeletionGroupDefinition(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.Export":                            schema_apis_deployer_utils_managedresource_Export(ref),
 		"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.Exports":                           schema_apis_deployer_utils_managedresource_Exports(ref),
@@ -902,12 +988,26 @@ func schema_openmcp_project_landscaper_apis_config_Controllers(ref common.Refere
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/config.ContextsController"),
 						},
 					},
+					"Targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets contains the controller config that probes targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/config.TargetsController"),
+						},
+					},
+					"TargetChanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetChanges contains the controller config that reconciles deploy items again whose target has changed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/config.TargetChangesController"),
+						},
+					},
 				},
 				Required: []string{"SyncPeriod", "Installations", "Executions", "DeployItems", "Contexts"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/config.ContextsController", "github.com/openmcp-project/landscaper/apis/config.DeployItemsController", "github.com/openmcp-project/landscaper/apis/config.ExecutionsController", "github.com/openmcp-project/landscaper/apis/config.InstallationsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/openmcp-project/landscaper/apis/config.ContextsController", "github.com/openmcp-project/landscaper/apis/config.DeployItemsController", "github.com/openmcp-project/landscaper/apis/config.ExecutionsController", "github.com/openmcp-project/landscaper/apis/config.InstallationsController", "github.com/openmcp-project/landscaper/apis/config.TargetChangesController", "github.com/openmcp-project/landscaper/apis/config.TargetsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -939,6 +1039,33 @@ func schema_openmcp_project_landscaper_apis_config_CrdManagementConfiguration(re
 	}
 }
 
+func schema_openmcp_project_landscaper_apis_config_DeletionSafeguard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeletionSafeguard limits the deletion of orphaned subinstallations and deploy items during a reconciliation. If the deletion exceeds a limit, the reconciliation stops until the deletion is confirmed by an annotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"MaxDeletions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDeletions is the maximal number of orphaned subinstallations of an installation, or orphaned deploy items of an execution, which are deleted without confirmation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"MaxDeletionPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDeletionPercentage is the maximal percentage of the subinstallations of an installation, or of the deploy items of an execution, which are deleted without confirmation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_openmcp_project_landscaper_apis_config_DeployItemTimeouts(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1164,6 +1291,12 @@ func schema_openmcp_project_landscaper_apis_config_LandscaperConfiguration(ref c
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/config.DeployItemTimeouts"),
 						},
 					},
+					"DeletionSafeguard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionSafeguard limits the number of orphaned objects which are deleted during a reconciliation without confirmation.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/config.DeletionSafeguard"),
+						},
+					},
 					"LsDeployments": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LsDeployments contains the names of the landscaper deployments",
@@ -1189,7 +1322,7 @@ func schema_openmcp_project_landscaper_apis_config_LandscaperConfiguration(ref c
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/config.BlueprintStore", "github.com/openmcp-project/landscaper/apis/config.Controllers", "github.com/openmcp-project/landscaper/apis/config.CrdManagementConfiguration", "github.com/openmcp-project/landscaper/apis/config.DeployItemTimeouts", "github.com/openmcp-project/landscaper/apis/config.HPAMainConfiguration", "github.com/openmcp-project/landscaper/apis/config.LsDeployments", "github.com/openmcp-project/landscaper/apis/config.MetricsConfiguration", "github.com/openmcp-project/landscaper/apis/config.RegistryConfiguration", "github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta"},
+			"github.com/openmcp-project/landscaper/apis/config.BlueprintStore", "github.com/openmcp-project/landscaper/apis/config.Controllers", "github.com/openmcp-project/landscaper/apis/config.CrdManagementConfiguration", "github.com/openmcp-project/landscaper/apis/config.DeletionSafeguard", "github.com/openmcp-project/landscaper/apis/config.DeployItemTimeouts", "github.com/openmcp-project/landscaper/apis/config.HPAMainConfiguration", "github.com/openmcp-project/landscaper/apis/config.LsDeployments", "github.com/openmcp-project/landscaper/apis/config.MetricsConfiguration", "github.com/openmcp-project/landscaper/apis/config.RegistryConfiguration", "github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta"},
 	}
 }
 
@@ -1407,6 +1540,126 @@ func schema_openmcp_project_landscaper_apis_config_RegistryConfiguration(ref com
 	}
 }
 
+func schema_openmcp_project_landscaper_apis_config_TargetChangeControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetChangeControllerConfig contains the configuration for the reconciliation of deploy items on target changes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable disables the target change controller. If disabled, a change of a target does not start a new job of the deploy items with reconcileOnTargetChange.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"Disable"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_config_TargetChangesController(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetChangesController contains all configuration for the target change controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"CommonControllerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/config.CommonControllerConfig"),
+						},
+					},
+					"Config": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/config.TargetChangeControllerConfig"),
+						},
+					},
+				},
+				Required: []string{"CommonControllerConfig", "Config"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/config.CommonControllerConfig", "github.com/openmcp-project/landscaper/apis/config.TargetChangeControllerConfig"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_config_TargetControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetControllerConfig contains the configuration for the probing of targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable disables the target controller. If disabled, targets are not probed and their status is not maintained.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ProbeInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProbeInterval defines how often a target is probed. Defaults to 10 minutes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"ProbeTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProbeTimeout defines how long a single probe may take before the target is considered unreachable. Defaults to 10 seconds.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"CredentialsExpirationWarning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsExpirationWarning defines how long before the expiration of the credentials of a target a warning is raised. Defaults to 7 days.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"Disable"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_config_TargetsController(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetsController contains all configuration for the target controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"CommonControllerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/config.CommonControllerConfig"),
+						},
+					},
+					"Config": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/config.TargetControllerConfig"),
+						},
+					},
+				},
+				Required: []string{"CommonControllerConfig", "Config"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/config.CommonControllerConfig", "github.com/openmcp-project/landscaper/apis/config.TargetControllerConfig"},
+	}
+}
+
 func schema_landscaper_apis_config_v1alpha1_AdditionalDeployments(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1648,12 +1901,26 @@ func schema_landscaper_apis_config_v1alpha1_Controllers(ref common.ReferenceCall
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.ContextsController"),
 						},
 					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets contains the controller config that probes targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetsController"),
+						},
+					},
+					"targetChanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetChanges contains the controller config that reconciles deploy items again whose target has changed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetChangesController"),
+						},
+					},
 				},
 				Required: []string{"syncPeriod", "installations", "executions", "deployItems", "contexts"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/config/v1alpha1.ContextsController", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.DeployItemsController", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.ExecutionsController", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.InstallationsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/openmcp-project/landscaper/apis/config/v1alpha1.ContextsController", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.DeployItemsController", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.ExecutionsController", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.InstallationsController", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetChangesController", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetsController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1685,6 +1952,33 @@ func schema_landscaper_apis_config_v1alpha1_CrdManagementConfiguration(ref commo
 	}
 }
 
+func schema_landscaper_apis_config_v1alpha1_DeletionSafeguard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeletionSafeguard limits the deletion of orphaned subinstallations and deploy items during a reconciliation. If the deletion exceeds a limit, the reconciliation stops until the deletion is confirmed by an annotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxDeletions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDeletions is the maximal number of orphaned subinstallations of an installation, or orphaned deploy items of an execution, which are deleted without confirmation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxDeletionPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDeletionPercentage is the maximal percentage of the subinstallations of an installation, or of the deploy items of an execution, which are deleted without confirmation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_config_v1alpha1_DeployItemTimeouts(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1915,6 +2209,12 @@ func schema_landscaper_apis_config_v1alpha1_LandscaperConfiguration(ref common.R
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.DeployItemTimeouts"),
 						},
 					},
+					"deletionSafeguard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionSafeguard limits the number of orphaned objects which are deleted during a reconciliation without confirmation.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.DeletionSafeguard"),
+						},
+					},
 					"lsDeployments": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LsDeployments contains the names of the landscaper deployments",
@@ -1940,7 +2240,7 @@ func schema_landscaper_apis_config_v1alpha1_LandscaperConfiguration(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/config/v1alpha1.BlueprintStore", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.Controllers", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.CrdManagementConfiguration", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.DeployItemTimeouts", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.HPAMainConfiguration", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.LsDeployments", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.MetricsConfiguration", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.RegistryConfiguration", "github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+			"github.com/openmcp-project/landscaper/apis/config/v1alpha1.BlueprintStore", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.Controllers", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.CrdManagementConfiguration", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.DeletionSafeguard", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.DeployItemTimeouts", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.HPAMainConfiguration", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.LsDeployments", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.MetricsConfiguration", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.RegistryConfiguration", "github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
 	}
 }
 
@@ -2157,127 +2457,300 @@ func schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref common.Ref
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_config_v1alpha1_TargetChangeControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated",
-				Type:        core.AnyJSON{}.OpenAPISchemaType(),
-				Format:      core.AnyJSON{}.OpenAPISchemaFormat(),
+				Description: "TargetChangeControllerConfig contains the configuration for the reconciliation of deploy items on target changes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable disables the target change controller. If disabled, a change of a target does not start a new job of the deploy items with reconcileOnTargetChange.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"disable"},
 			},
 		},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_config_v1alpha1_TargetChangesController(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+				Description: "TargetChangesController contains all configuration for the target change controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"succeededReconcile": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SucceededReconcile"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
-					"failedReconcile": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.FailedReconcile"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetChangeControllerConfig"),
 						},
 					},
 				},
+				Required: []string{"CommonControllerConfig", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.FailedReconcile", "github.com/openmcp-project/landscaper/apis/core.SucceededReconcile"},
+			"github.com/openmcp-project/landscaper/apis/config/v1alpha1.CommonControllerConfig", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetChangeControllerConfig"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_config_v1alpha1_TargetControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+				Description: "TargetControllerConfig contains the configuration for the probing of targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"generation": {
+					"disable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Generation describes the generation of the installation for which the status holds.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Disable disables the target controller. If disabled, targets are not probed and their status is not maintained.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"numberOfReconciles": {
+					"probeInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "ProbeInterval defines how often a target is probed. Defaults to 10 minutes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"lastReconcileTime": {
+					"probeTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "ProbeTimeout defines how long a single probe may take before the target is considered unreachable. Defaults to 10 seconds.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"onFailed": {
+					"credentialsExpirationWarning": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "CredentialsExpirationWarning defines how long before the expiration of the credentials of a target a warning is raised. Defaults to 7 days.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
+				Required: []string{"disable"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_config_v1alpha1_TargetsController(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Blueprint contains the configuration of a component",
+				Description: "TargetsController contains all configuration for the target controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
-					"annotations": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetControllerConfig"),
 						},
 					},
-					"jsonSchemaVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
+				},
+				Required: []string{"CommonControllerConfig", "config"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/config/v1alpha1.CommonControllerConfig", "github.com/openmcp-project/landscaper/apis/config/v1alpha1.TargetControllerConfig"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated",
+				Type:        core.AnyJSON{}.OpenAPISchemaType(),
+				Format:      core.AnyJSON{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"succeededReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SucceededReconcile"),
+						},
+					},
+					"failedReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.FailedReconcile"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.FailedReconcile", "github.com/openmcp-project/landscaper/apis/core.SucceededReconcile"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation describes the generation of the installation for which the status holds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"numberOfReconciles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReconcileTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"onFailed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_BlockingObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlockingObject describes an object for which an installation is waiting, together with the reason.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"object": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Object is the reference to the blocking object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TypedObjectReference"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a machine-readable reason why the object blocks the installation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable description why the object blocks the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports lists the imports of the installation which are exported by the blocking object.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"object", "reason"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.TypedObjectReference"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Blueprint contains the configuration of a component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"jsonSchemaVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -2381,6 +2854,27 @@ func schema_openmcp_project_landscaper_apis_core_Blueprint(ref common.ReferenceC
 							},
 						},
 					},
+					"testExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TestExecutions defines the templating executors for the test deploy items of the blueprint. The templates must return a list of deploy item templates. The test deploy items are executed after all other deploy items and all subinstallations have succeeded. The installation only succeeds if all tests succeed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.TemplateExecutor"),
+									},
+								},
+							},
+						},
+					},
+					"maxParallelDeployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelDeployItems is the maximum number of deploy items of the blueprint which are processed in parallel. If not set, all deploy items whose dependencies are satisfied are started at once.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"exportExecutions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExportExecutions defines the templating executors that are used to generate the exports.",
@@ -2910,6 +3404,13 @@ func schema_openmcp_project_landscaper_apis_core_Context(ref common.ReferenceCal
 							},
 						},
 					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval specifies that changes of the deploy items of the installations using this context have to be approved before they are applied. It can be overwritten by the installations.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2987,6 +3488,13 @@ func schema_openmcp_project_landscaper_apis_core_ContextConfiguration(ref common
 							},
 						},
 					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval specifies that changes of the deploy items of the installations using this context have to be approved before they are applied. It can be overwritten by the installations.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3240,10 +3748,38 @@ func schema_openmcp_project_landscaper_apis_core_DataExport(ref common.Reference
 							Format:      "",
 						},
 					},
+					"aggregate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Aggregate defines that the exported data is a keyed contribution to an aggregated data object. Multiple installations are allowed to export the same data object if all of them use an aggregated export.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.DataExportAggregation"),
+						},
+					},
 				},
 				Required: []string{"name", "dataRef"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.DataExportAggregation"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_DataExportAggregation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataExportAggregation configures the contribution of an export to an aggregated data object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the key under which the exported data is contributed. Defaults to the name of the exporting installation, or the name of the installation template for subinstallations.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -3289,12 +3825,38 @@ func schema_openmcp_project_landscaper_apis_core_DataImport(ref common.Reference
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.LocalConfigMapReference"),
 						},
 					},
+					"aggregated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Aggregated defines that the imported data is merged from the contributions of all installations that export the referenced data object as aggregated export. Can only be used in combination with DataRef.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.DataImportAggregation"),
+						},
+					},
 				},
 				Required: []string{"name", "dataRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.LocalConfigMapReference", "github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"},
+			"github.com/openmcp-project/landscaper/apis/core.DataImportAggregation", "github.com/openmcp-project/landscaper/apis/core.LocalConfigMapReference", "github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_DataImportAggregation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataImportAggregation configures the import of an aggregated data object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format defines whether the contributions are merged into a map or a list. Defaults to \"map\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -3410,26 +3972,139 @@ func schema_openmcp_project_landscaper_apis_core_Default(ref common.ReferenceCal
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_DeletionImpact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DeletionImpact describes the objects which would be deleted together with an installation.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"computationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the dependent installation",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ComputationTime is the time when the deletion impact was computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_openmcp_project_landscaper_apis_core_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+					"installations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installations lists the subinstallations in the subtree of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"executions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Executions lists the executions in the subtree of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems lists the deploy items in the subtree of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"managedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedResources lists the resources in the target clusters which are deleted by the deployers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.ManagedResourceReference"),
+									},
+								},
+							},
+						},
+					},
+					"totalInstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalInstallations is the number of subinstallations in the subtree of the installation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"totalExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalExecutions is the number of executions in the subtree of the installation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"totalDeployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalDeployItems is the number of deploy items in the subtree of the installation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"totalManagedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalManagedResources is the number of resources in the target clusters which are deleted by the deployers.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"truncated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Truncated is true if at least one of the lists is truncated, because it exceeds the maximum number of entries. The total numbers count all objects.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"computationTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.ManagedResourceReference", "github.com/openmcp-project/landscaper/apis/core.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the dependent installation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3519,6 +4194,34 @@ func schema_openmcp_project_landscaper_apis_core_DeployItemCache(ref common.Refe
 	}
 }
 
+func schema_openmcp_project_landscaper_apis_core_DeployItemForEach(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemForEach defines the elements over which a deploy item template is expanded. Exactly one of Items and TargetImport has to be set. The generated deploy items are named \"<name>-<key>\", where the key is the index of a list element or the key of a map element. The placeholders \"$(each.key)\" and \"$(each.value)\" in the deploy item template are replaced by the key and the value of the element; fields of the value are referenced by paths like \"$(each.value.name)\".",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is a list or a map of values.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+						},
+					},
+					"targetImport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetImport is the name of a targetList or targetMap import of the blueprint. The target of each generated deploy item is the respective element of the import.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON"},
+	}
+}
+
 func schema_openmcp_project_landscaper_apis_core_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3615,6 +4318,13 @@ func schema_openmcp_project_landscaper_apis_core_DeployItemSpec(ref common.Refer
 							Format:      "",
 						},
 					},
+					"reconcileOnTargetChange": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcileOnTargetChange specifies that the deploy item is reconciled again when the resolved content of its target changes, e.g. because the secret referenced by the target has been rotated.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"onDelete": {
 						SchemaProps: spec.SchemaProps{
 							Description: "OnDelete specifies particular setting when deleting a deploy item",
@@ -3736,6 +4446,20 @@ func schema_openmcp_project_landscaper_apis_core_DeployItemStatus(ref common.Ref
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"targetContentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetContentHash is the hash of the resolved content of the target with which the deploy item has been reconciled the last time. It is only maintained if ReconcileOnTargetChange is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"referencedDataHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReferencedDataHash is the hash of the data in Secrets and ConfigMaps which is referenced by the configuration of the deploy item, e.g. by the valuesFrom of a helm deploy item, with which the deploy item has been reconciled the last time. It is used to reconcile a deploy item with UpdateOnChangeOnly again if the referenced data has changed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"deployItemPhase": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
@@ -3749,12 +4473,18 @@ func schema_openmcp_project_landscaper_apis_core_DeployItemStatus(ref common.Ref
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TransitionTimes"),
 						},
 					},
+					"retry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retry describes the retries of the failed deploy item according to the retry policy of its template.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RetryStatus"),
+						},
+					},
 				},
 				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.Condition", "github.com/openmcp-project/landscaper/apis/core.DeployerInformation", "github.com/openmcp-project/landscaper/apis/core.Error", "github.com/openmcp-project/landscaper/apis/core.ObjectReference", "github.com/openmcp-project/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/openmcp-project/landscaper/apis/core.Condition", "github.com/openmcp-project/landscaper/apis/core.DeployerInformation", "github.com/openmcp-project/landscaper/apis/core.Error", "github.com/openmcp-project/landscaper/apis/core.ObjectReference", "github.com/openmcp-project/landscaper/apis/core.RetryStatus", "github.com/openmcp-project/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -3837,18 +4567,51 @@ func schema_openmcp_project_landscaper_apis_core_DeployItemTemplate(ref common.R
 							Format:      "",
 						},
 					},
+					"reconcileOnTargetChange": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcileOnTargetChange specifies that the deploy item is reconciled again when the resolved content of its target changes, e.g. because the secret referenced by the target has been rotated.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"rolloutGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutGroup identifies the element of a targetList or targetMap import the deploy item deploys to. Deploy items with a rollout group are released wave by wave according to the rollout policy of the execution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"onDelete": {
 						SchemaProps: spec.SchemaProps{
 							Description: "OnDelete specifies particular setting when deleting a deploy item",
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.OnDeleteConfig"),
 						},
 					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy configures automatic retries of the deploy item when it has failed, depending on the error codes of the failure. The execution waits for the retries before it fails.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RetryPolicy"),
+						},
+					},
+					"when": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When specifies whether the deploy item is created. The deploy item is omitted if the value is false. The field is evaluated when the deploy executions of a blueprint are templated and must not be set in executions.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"forEach": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForEach expands the deploy item into one deploy item per element of a list, a map, or a targetList or targetMap import. The field is evaluated when the deploy executions of a blueprint are templated and must not be set in executions.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.DeployItemForEach"),
+						},
+					},
 				},
 				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.Duration", "github.com/openmcp-project/landscaper/apis/core.ObjectReference", "github.com/openmcp-project/landscaper/apis/core.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/openmcp-project/landscaper/apis/core.DeployItemForEach", "github.com/openmcp-project/landscaper/apis/core.Duration", "github.com/openmcp-project/landscaper/apis/core.ObjectReference", "github.com/openmcp-project/landscaper/apis/core.OnDeleteConfig", "github.com/openmcp-project/landscaper/apis/core.RetryPolicy", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -4127,11 +4890,31 @@ func schema_openmcp_project_landscaper_apis_core_ExecutionSpec(ref common.Refere
 							Format:      "byte",
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout configures the progressive rollout of the deploy items with a rollout group. If not set, all deploy items are updated at once.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RolloutPolicy"),
+						},
+					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval specifies that changes of the deploy items have to be approved before they are applied.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"maxParallel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallel is the maximum number of deploy items which are processed in parallel. Further runnable deploy items are queued until running deploy items have finished. If not set, all runnable deploy items are started at once.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.DeployItemTemplate"},
+			"github.com/openmcp-project/landscaper/apis/core.DeployItemTemplate", "github.com/openmcp-project/landscaper/apis/core.RolloutPolicy"},
 	}
 }
 
@@ -4215,12 +4998,36 @@ func schema_openmcp_project_landscaper_apis_core_ExecutionStatus(ref common.Refe
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TransitionTimes"),
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout describes the progress of the rollout of the deploy items. It is only maintained if the execution has a rollout policy.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RolloutStatus"),
+						},
+					},
+					"nextRetryTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextRetryTime is the time of the next scheduled retry of a failed deploy item according to its retry policy.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"pendingDeletion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingDeletion describes the deletion of orphaned deploy items, which waits for a confirmation because it exceeds the deletion safeguard.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.PendingDeletion"),
+						},
+					},
+					"pendingApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingApproval describes the change of the deploy items which waits for approval.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.PendingApproval"),
+						},
+					},
 				},
 				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.Condition", "github.com/openmcp-project/landscaper/apis/core.DeployItemCache", "github.com/openmcp-project/landscaper/apis/core.Error", "github.com/openmcp-project/landscaper/apis/core.ObjectReference", "github.com/openmcp-project/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core.Condition", "github.com/openmcp-project/landscaper/apis/core.DeployItemCache", "github.com/openmcp-project/landscaper/apis/core.Error", "github.com/openmcp-project/landscaper/apis/core.ObjectReference", "github.com/openmcp-project/landscaper/apis/core.PendingApproval", "github.com/openmcp-project/landscaper/apis/core.PendingDeletion", "github.com/openmcp-project/landscaper/apis/core.RolloutStatus", "github.com/openmcp-project/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -4268,6 +5075,51 @@ func schema_openmcp_project_landscaper_apis_core_ExportDefinition(ref common.Ref
 	}
 }
 
+func schema_openmcp_project_landscaper_apis_core_FailedObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FailedObject describes a failed object in the subtree of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"object": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Object is the reference to the failed object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TypedObjectReference"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason of the last error of the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"codes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Codes are the error codes of the last error of the object.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"object"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.TypedObjectReference"},
+	}
+}
+
 func schema_openmcp_project_landscaper_apis_core_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4412,38 +5264,74 @@ func schema_openmcp_project_landscaper_apis_core_ImportDefinition(ref common.Ref
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_IncompleteChild(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InlineBlueprint defines an inline blueprint with component descriptor and filesystem.",
+				Description: "IncompleteChild describes a subinstallation or execution, which has not finished the current job.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"filesystem": {
+					"object": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+							Description: "Object is the reference to the subinstallation or execution.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TypedObjectReference"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the subinstallation or execution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the subinstallation or execution has started the current job.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"filesystem"},
+				Required: []string{"object"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.AnyJSON"},
+			"github.com/openmcp-project/landscaper/apis/core.TypedObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Installation contains the configuration of a installation",
+				Description: "InlineBlueprint defines an inline blueprint with component descriptor and filesystem.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"filesystem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"filesystem"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Installation contains the configuration of a installation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -4619,70 +5507,81 @@ func schema_openmcp_project_landscaper_apis_core_InstallationList(ref common.Ref
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_InstallationSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationSpec defines a component installation.",
+				Description: "InstallationSet generates one root installation from a template for every element of a generator.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"context": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the installation.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"verification": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Verification defines the necessary data to verify the signature of the refered component",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Verification"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"componentDescriptor": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.ComponentDescriptorDefinition"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"blueprint": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the definition.",
+							Description: "Spec contains the specification of the installation set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.BlueprintDefinition"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationSetSpec"),
 						},
 					},
-					"imports": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
+							Description: "Status contains the status of the installation set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationImports"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationSetStatus"),
 						},
 					},
-					"importDataMappings": {
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.InstallationSetSpec", "github.com/openmcp-project/landscaper/apis/core.InstallationSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_InstallationSetElementOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationSetElementOverride replaces imports of the template for a particular element.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"element": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
+							Description: "Element is the name of the element.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exports": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
+							Description: "Imports replace the imports of the template with the same name or are added to them.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationExports"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationImports"),
 						},
 					},
-					"exportDataMappings": {
+					"importDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Description: "ImportDataMappings replace the import data mappings of the template with the same name or are added to them.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -4694,189 +5593,177 @@ func schema_openmcp_project_landscaper_apis_core_InstallationSpec(ref common.Ref
 							},
 						},
 					},
-					"automaticReconcile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AutomaticReconcile"),
-						},
-					},
-					"optimization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Optimization"),
-						},
-					},
 				},
-				Required: []string{"blueprint"},
+				Required: []string{"element"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.AutomaticReconcile", "github.com/openmcp-project/landscaper/apis/core.BlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core.ComponentDescriptorDefinition", "github.com/openmcp-project/landscaper/apis/core.InstallationExports", "github.com/openmcp-project/landscaper/apis/core.InstallationImports", "github.com/openmcp-project/landscaper/apis/core.Optimization", "github.com/openmcp-project/landscaper/apis/core.Verification"},
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.InstallationImports"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_InstallationSetGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationStatus contains the current status of a Installation.",
+				Description: "InstallationSetGenerator defines the elements of an InstallationSet. Exactly one of Targets, TargetMap and Parameters has to be specified.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Targets generates an element for every target in the namespace of the InstallationSet that matches a label selector. The element has the name of the target.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationSetTargetsGenerator"),
 						},
 					},
-					"conditions": {
+					"targetMap": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a installation",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "TargetMap generates an element for every entry of the map. The element has the name of the key, the value is the name of the target.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.Condition"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"lastError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Error"),
-						},
-					},
-					"subInstCache": {
+					"targetImport": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SubInstCache"),
+							Description: "TargetImport is the name of the target import of the generated installations, which is set to the target of an element. Required for Targets and TargetMap.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"executionRef": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
+							Description: "Parameters generates an element for every parameter set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.InstallationSetParameterSet"),
+									},
+								},
+							},
 						},
 					},
-					"jobID": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.InstallationSetParameterSet", "github.com/openmcp-project/landscaper/apis/core.InstallationSetTargetsGenerator"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_InstallationSetInstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationSetInstallationStatus contains the status of an installation generated by an InstallationSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"element": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
+							Description: "Element is the name of the element.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDFinished": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
+							Description: "Name is the name of the installation.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstallationPhase is the current phase of the installation.",
+							Description: "Phase is the phase of the installation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phaseTransitionTime": {
+				},
+				Required: []string{"element", "name"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_InstallationSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationSetList contains a list of InstallationSets",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PhaseTransitionTime is the time when the phase last changed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"importsHash": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportsHash is the hash of the import data.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"automaticReconcileStatus": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AutomaticReconcileStatus"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"dependentsToTrigger": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependentsToTrigger lists dependent installations to be triggered",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.DependentToTrigger"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.InstallationSet"),
 									},
 								},
 							},
 						},
 					},
-					"transitionTimes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TransitionTimes"),
-						},
-					},
 				},
-				Required: []string{"observedGeneration"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.AutomaticReconcileStatus", "github.com/openmcp-project/landscaper/apis/core.Condition", "github.com/openmcp-project/landscaper/apis/core.DependentToTrigger", "github.com/openmcp-project/landscaper/apis/core.Error", "github.com/openmcp-project/landscaper/apis/core.ObjectReference", "github.com/openmcp-project/landscaper/apis/core.SubInstCache", "github.com/openmcp-project/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core.InstallationSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_InstallationSetParameterSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Description: "InstallationSetParameterSet is an element of an InstallationSet defined by parameters.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
+							Description: "Name is the name of the element.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
-						},
-					},
-					"imports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationImports"),
-						},
-					},
 					"importDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Description: "ImportDataMappings are added to the import data mappings of the generated installation.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -4888,287 +5775,3238 @@ func schema_openmcp_project_landscaper_apis_core_InstallationTemplate(ref common
 							},
 						},
 					},
-					"exports": {
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_InstallationSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationSetSpec contains the specification of an InstallationSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
+							Description: "Template is the template of the generated installations.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationExports"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationSetTemplate"),
 						},
 					},
-					"exportDataMappings": {
+					"generator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Generator defines the elements for which installations are generated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationSetGenerator"),
+						},
+					},
+					"nameTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NameTemplate is a go template which computes the name of the installation of an element. The template gets the values \"setName\", \"elementName\" and \"targetName\". Defaults to \"{{ .setName }}-{{ .elementName }}\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"overrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overrides replace imports of the template for particular elements.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.InstallationSetElementOverride"),
 									},
 								},
 							},
 						},
 					},
-					"optimization": {
+					"maxProgressing": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Optimization"),
+							Description: "MaxProgressing is the maximal number of generated installations which are progressing at the same time. Installations of further elements are created or updated when progressing installations have finished. If not set, all installations are created or updated at once.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"name", "blueprint"},
+				Required: []string{"template", "generator"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.InstallationExports", "github.com/openmcp-project/landscaper/apis/core.InstallationImports", "github.com/openmcp-project/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core.Optimization"},
+			"github.com/openmcp-project/landscaper/apis/core.InstallationSetElementOverride", "github.com/openmcp-project/landscaper/apis/core.InstallationSetGenerator", "github.com/openmcp-project/landscaper/apis/core.InstallationSetTemplate"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_InstallationSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
+				Description: "InstallationSetStatus contains the status of an InstallationSet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"filesystem": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+							Description: "LastError describes the last error of the installation set.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Error"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.AnyJSON"},
-	}
-}
-
-func schema_openmcp_project_landscaper_apis_core_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JSONSchemaDefinition defines a jsonschema.",
-				Type:        core.JSONSchemaDefinition{}.OpenAPISchemaType(),
-				Format:      core.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_openmcp_project_landscaper_apis_core_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LocalConfigMapReference is a reference to data in a configmap.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"installationCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the configmap",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "InstallationCount is the number of generated installations.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"key": {
+					"progressing": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Progressing is the number of generated installations which are currently progressing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pending": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pending is the number of elements whose installation waits to be created, updated or deleted, because MaxProgressing installations are already progressing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"installations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installations contains the status of the generated installations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.InstallationSetInstallationStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.Error", "github.com/openmcp-project/landscaper/apis/core.InstallationSetInstallationStatus"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_InstallationSetTargetsGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalSecretReference is a reference to data in a secret.",
+				Description: "InstallationSetTargetsGenerator selects the targets for which installations are generated.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the secret",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"key": {
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Selector selects the targets by their labels.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"selector"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_InstallationSetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
+				Description: "InstallationSetTemplate is the template of the installations of an InstallationSet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"status": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the overall status of the landscaper and its standard deployers.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Labels are added to the generated installations.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"lastUpdateTime": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the check was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Annotations are added to the generated installations.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"description": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains description of the problem(s)",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Spec is the specification of the generated installations.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationSpec"),
 						},
 					},
 				},
-				Required: []string{"status", "lastUpdateTime", "description"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core.InstallationSpec"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Description: "InstallationSpec defines a component installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Context defines the current context of the installation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"verification": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Verification defines the necessary data to verify the signature of the refered component",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Verification"),
 						},
 					},
-					"metadata": {
+					"componentDescriptor": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.ComponentDescriptorDefinition"),
 						},
 					},
-					"items": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Blueprint is the resolved reference to the definition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.BlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.LsHealthCheck"),
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_openmcp_project_landscaper_apis_core_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NamedObjectReference is a named reference to a specific resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationExports"),
 						},
 					},
-					"ref": {
+					"exportDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"automaticReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AutomaticReconcile"),
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Optimization"),
+						},
+					},
+					"reconcileOnTargetChange": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcileOnTargetChange specifies that all deploy items of the installation are reconciled again when the resolved content of their target changes, e.g. because the secret referenced by the target has been rotated.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout configures the progressive rollout of the deploy items and subinstallations of the installation which deploy to an element of a targetList or targetMap import. If not set, all of them are updated at once.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RolloutPolicy"),
+						},
+					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval specifies that changes of the deploy items of the installation have to be approved before they are applied. If not set, the setting of the context of the installation is used.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend pauses the reconciliation of the installation and of its subtree, i.e. its subinstallations, its execution and its deploy items. When the installation is resumed, the reconciliation continues in the current phase.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn lists the names of sibling installations which have to be finished successfully before this installation is reconciled, additionally to the dependencies defined by the imports. Root installations refer to the names of other root installations in the same namespace, subinstallations to the names of the installation templates in the blueprint of their parent.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy configures automatic retries of the installation when it has failed, depending on the error codes of the failure. It must not be combined with automaticReconcile.failedReconcile.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RetryPolicy"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the maximal duration of a reconciliation of the installation including its whole subtree, measured from the start of the job. When it is exceeded, the installation fails with error code ERR_TIMEOUT, and the running deploy items of the subtree are interrupted. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). There is no timeout if not specified.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Duration"),
+						},
+					},
+				},
+				Required: []string{"blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.AutomaticReconcile", "github.com/openmcp-project/landscaper/apis/core.BlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core.ComponentDescriptorDefinition", "github.com/openmcp-project/landscaper/apis/core.Duration", "github.com/openmcp-project/landscaper/apis/core.InstallationExports", "github.com/openmcp-project/landscaper/apis/core.InstallationImports", "github.com/openmcp-project/landscaper/apis/core.Optimization", "github.com/openmcp-project/landscaper/apis/core.RetryPolicy", "github.com/openmcp-project/landscaper/apis/core.RolloutPolicy", "github.com/openmcp-project/landscaper/apis/core.Verification"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationStatus contains the current status of a Installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the actual condition of a installation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Error"),
+						},
+					},
+					"subInstCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SubInstCache"),
+						},
+					},
+					"executionRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
+						},
+					},
+					"testExecutionRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TestExecutionReference is the reference to the execution of the test deploy items of the blueprint.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationPhase is the current phase of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phaseTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PhaseTransitionTime is the time when the phase last changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"automaticReconcileStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AutomaticReconcileStatus"),
+						},
+					},
+					"dependentsToTrigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependentsToTrigger lists dependent installations to be triggered",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.DependentToTrigger"),
+									},
+								},
+							},
+						},
+					},
+					"transitionTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TransitionTimes"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout describes the progress of the rollout of the subinstallations. It is only maintained if the installation has a rollout policy.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RolloutStatus"),
+						},
+					},
+					"retry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retry describes the retries of the failed installation according to its retry policy.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RetryStatus"),
+						},
+					},
+					"blockedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlockedBy lists the objects for which the installation is waiting. It is only set while the installation is in phase Init or Progressing.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.BlockingObject"),
+									},
+								},
+							},
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary aggregates the status of the subinstallations, executions and deploy items in the subtree of the installation. For a root installation, it covers the whole tree.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SubtreeSummary"),
+						},
+					},
+					"pendingDeletion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingDeletion describes the deletion of orphaned subinstallations, which waits for a confirmation because it exceeds the deletion safeguard.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.PendingDeletion"),
+						},
+					},
+					"deletionImpact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionImpact describes the objects which would be deleted together with the installation. It is computed on request by the operation annotation deletion-impact.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.DeletionImpact"),
+						},
+					},
+					"timedOut": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimedOut describes the exceeded timeout, if the current job of the installation has failed because of its timeout.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TimeoutDetails"),
+						},
+					},
+					"tests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tests describes the latest run of the tests defined by the testExecutions of the blueprint.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TestStatus"),
+						},
+					},
+				},
+				Required: []string{"observedGeneration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AutomaticReconcileStatus", "github.com/openmcp-project/landscaper/apis/core.BlockingObject", "github.com/openmcp-project/landscaper/apis/core.Condition", "github.com/openmcp-project/landscaper/apis/core.DeletionImpact", "github.com/openmcp-project/landscaper/apis/core.DependentToTrigger", "github.com/openmcp-project/landscaper/apis/core.Error", "github.com/openmcp-project/landscaper/apis/core.ObjectReference", "github.com/openmcp-project/landscaper/apis/core.PendingDeletion", "github.com/openmcp-project/landscaper/apis/core.RetryStatus", "github.com/openmcp-project/landscaper/apis/core.RolloutStatus", "github.com/openmcp-project/landscaper/apis/core.SubInstCache", "github.com/openmcp-project/landscaper/apis/core.SubtreeSummary", "github.com/openmcp-project/landscaper/apis/core.TestStatus", "github.com/openmcp-project/landscaper/apis/core.TimeoutDetails", "github.com/openmcp-project/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Optimization"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout configures the progressive rollout of the deploy items and subinstallations of the installation which deploy to an element of a targetList or targetMap import.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RolloutPolicy"),
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn lists the names of sibling installation templates which have to be finished successfully before this subinstallation is reconciled, additionally to the dependencies defined by the imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.InstallationExports", "github.com/openmcp-project/landscaper/apis/core.InstallationImports", "github.com/openmcp-project/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core.Optimization", "github.com/openmcp-project/landscaper/apis/core.RolloutPolicy"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JSONSchemaDefinition defines a jsonschema.",
+				Type:        core.JSONSchemaDefinition{}.OpenAPISchemaType(),
+				Format:      core.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the configmap",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalSecretReference is a reference to data in a secret.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status defines the overall status of the landscaper and its standard deployers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime contains last time the check was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description contains description of the problem(s)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"status", "lastUpdateTime", "description"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.LsHealthCheck"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_ManagedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManagedResourceReference is the reference to a resource in a target cluster, which is managed by a deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deployItem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItem is the deploy item which manages the resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the group and version of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"deployItem", "apiVersion", "kind", "name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.ObjectReference"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name", "ref"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.ObjectReference"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectReference is the reference to a kubernetes object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"skipUninstallIfClusterRemoved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Optimization contains settings to improve execution preformance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hasNoSiblingImports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasNoSiblingExports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_PendingApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PendingApproval describes a change of the deploy items of an execution which waits for approval.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Digest identifies the pending change. The change is approved by setting the approve annotation of the execution to this digest.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems contains the names of the deploy items which are created, updated or deleted by the change.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"digest"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_PendingDeletion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PendingDeletion describes the deletion of orphaned objects, which exceeds the deletion safeguard and therefore waits for a confirmation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Digest identifies the pending deletion. The deletion is confirmed by the annotation landscaper.gardener.cloud/confirm-deletion with the digest as value.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"objects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Objects lists the names of the orphaned objects which would be deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"totalObjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalObjects is the number of objects of the same kind, of which the orphaned objects would be deleted.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"digest", "totalObjects"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"key", "operator"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target contains the original target.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Target"),
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "content"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.Target"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentName", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_RetryBackoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryBackoff configures an exponential backoff between retries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"initialInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialInterval is the waiting time before the first retry. Defaults to 30 seconds.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Duration"),
+						},
+					},
+					"maxInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxInterval is the upper limit of the waiting time before a retry. Defaults to 10 minutes.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Duration"),
+						},
+					},
+					"factor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Factor is the factor by which the waiting time grows with every retry. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"jitterPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JitterPercentage is the maximal percentage by which the waiting time is randomly extended, so that objects which failed at the same time are not retried at the same time. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.Duration"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_RetryPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryPolicy configures automatic retries of a failed object depending on the error codes of its last error. The waiting time before a retry grows exponentially with the number of retries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"retryOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryOn lists the error codes for which a failed object is retried. If empty, a failed object is retried for all error codes which are not listed in NoRetryOn.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"noRetryOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NoRetryOn lists the error codes for which a failed object is never retried. It takes precedence over RetryOn.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"maxAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAttempts is the maximal number of retries. If not set, no upper limit exists.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff configures the waiting time before a retry.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RetryBackoff"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.RetryBackoff"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_RetryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryStatus describes the retries of a failed object according to its retry policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the object for which the retries are counted.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts is the number of retries which have been started.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastAttemptTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastAttemptTime is the time when the last retry was started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextRetryTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextRetryTime is the time when the next retry is started, if a retry is scheduled.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"attempts"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_RolloutPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutPolicy configures the progressive rollout of the deploy items and subinstallations of an installation which deploy to an element of a targetList or targetMap import. The elements are released in waves. A wave is only released when the objects of the previous waves have finished.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"batchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchSize is the number of elements which are released in one wave.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"batchPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchPercentage is the percentage of all elements which are released in one wave. The resulting number of elements is rounded up. Only one of BatchSize and BatchPercentage may be set. If none of them is set, all elements are released in one wave.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pauseBetweenWaves": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseBetweenWaves is the time to wait after a wave has finished before the next wave is released.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Duration"),
+						},
+					},
+					"stopOnFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StopOnFailure specifies that no further waves are released when an object of a released wave has failed. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.Duration"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStatus describes the progress of a rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the job the rollout belongs to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentWave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentWave is the number of the latest released wave, starting with 1.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"waves": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Waves is the total number of waves.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nextWaveTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextWaveTime is the time when the next wave is released, if the rollout is paused between two waves.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"currentWave", "waves"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is a map of labels to select specific secrets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The key of the secret to select from.  Must be a valid secret key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"selector", "key"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataSource defines a static data source",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+						},
+					},
+					"valueFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.StaticDataValueFrom"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.StaticDataValueFrom"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key of a secret in the installations's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"secretLabelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SecretLabelSelectorRef"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubInstCache contains the existing sub installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"activeSubs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.SubNamePair"),
+									},
+								},
+							},
+						},
+					},
+					"orphanedSubs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.SubNamePair"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"specName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"objectName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File references a subinstallation template stored in another file.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Optimization"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout configures the progressive rollout of the deploy items and subinstallations of the installation which deploy to an element of a targetList or targetMap import.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.RolloutPolicy"),
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn lists the names of sibling installation templates which have to be finished successfully before this subinstallation is reconciled, additionally to the dependencies defined by the imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.InstallationExports", "github.com/openmcp-project/landscaper/apis/core.InstallationImports", "github.com/openmcp-project/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core.Optimization", "github.com/openmcp-project/landscaper/apis/core.RolloutPolicy"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SubtreeSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubtreeSummary aggregates the status of the objects in the subtree of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"installations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installations counts the subinstallations in the subtree by phase.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"executions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Executions counts the executions in the subtree by phase.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems counts the deploy items in the subtree by phase.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"failedObjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedObjects lists the failed objects in the subtree whose failure is not caused by failed children, i.e. the objects where the failures originate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.FailedObject"),
+									},
+								},
+							},
+						},
+					},
+					"finishedObjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FinishedObjects is the number of objects in the subtree which have finished the current job.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"totalObjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalObjects is the number of objects in the subtree.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress is the percentage of the objects in the subtree which have finished the current job.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"finishedObjects", "totalObjects", "progress"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.FailedObject"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Duration"),
+						},
+					},
+					"cronSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.Duration"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "The SyncObject helps to sync access to deploy items.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SyncObjectSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SyncObjectStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.SyncObjectSpec", "github.com/openmcp-project/landscaper/apis/core.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncObjectList contains a list of SyncObject objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.SyncObject"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncObjectSpec contains the specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodName describes the name of the pod of the responsible deployer",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind describes the kind of object that is being locked by this SyncObject",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the object that is being locked by this SyncObject",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime contains last time the object was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the prefix of the name of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncObjectStatus contains the status.",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/core.TargetSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the observed state of the target.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TargetStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.TargetSpec", "github.com/openmcp-project/landscaper/apis/core.TargetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetExport is a single target export.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name the internal name of the exported target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the name of the in-cluster target object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetImport is either a single target or a target list import.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name the internal name of the imported target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetListRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetMap": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetList contains a list of Targets",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.Target"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSelector describes a selector that matches specific targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations matches a target based on annotations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.Requirement"),
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels matches a target based on its labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.Requirement"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.ObjectReference", "github.com/openmcp-project/landscaper/apis/core.Requirement"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSpec contains the definition of a target.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetStatus contains the observed state of a target. The status is only maintained for targets of type kubernetes-cluster which are periodically probed by the landscaper.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the target that has been probed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the last observed conditions of the target.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"serverVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerVersion is the version of the api server of the target cluster observed by the last successful probe.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsExpirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsExpirationTime is the time when the client certificate or token of the target's kubeconfig expires. It is not set if the credentials do not expire or their expiration time cannot be determined.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastProbeTime is the time when the target was probed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "The TargetSync is created targets from secrets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TargetSyncSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TargetSyncStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.TargetSyncSpec", "github.com/openmcp-project/landscaper/apis/core.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetSyncClusterAPISource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncClusterAPISource defines the sync of Cluster API clusters.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterNameExpression defines the names of the Cluster API clusters for which targets are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. If not set, targets are created for all clusters.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncList contains a list of TargetSync objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.TargetSync"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetSyncSecretSelectorSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncSecretSelectorSource defines the sync of secrets selected by labels.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector selects the secrets in the source namespace which are synced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"kubeconfigKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeconfigKey is the key in the data of the selected secrets which contains the kubeconfig. Defaults to \"kubeconfig\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"labelSelector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetSyncSourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncSourceStatus contains the result of the last sync of a source of a TargetSync.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the source.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"createdTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreatedTargets contains the names of the targets which were created by the last sync.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"updatedTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedTargets contains the names of the targets which were updated by the last sync.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"removedTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovedTargets contains the names of the targets which were removed by the last sync.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"conflictingTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConflictingTargets contains the names of the targets which were not synced from this source, because another source of the TargetSync produces a target with the same name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"),
+						},
+					},
+					"createTargetToSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"targetToSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shootNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterAPI": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterAPI defines that targets are created for the Cluster API clusters in the source namespace. The kubeconfigs of the clusters are taken from the secrets \"<cluster name>-kubeconfig\" maintained by Cluster API. if not set no targets for Cluster API clusters are created",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TargetSyncClusterAPISource"),
+						},
+					},
+					"secretSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretSelector defines that targets are created for the secrets in the source namespace which match a label selector. This allows to sync the kubeconfigs of cluster managers which publish them in their own secret formats. if not set no secrets are selected by labels",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TargetSyncSecretSelectorSource"),
+						},
+					},
+					"tokenRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TokenRotation"),
+						},
+					},
+				},
+				Required: []string{"sourceNamespace", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.LocalSecretReference", "github.com/openmcp-project/landscaper/apis/core.TargetSyncClusterAPISource", "github.com/openmcp-project/landscaper/apis/core.TargetSyncSecretSelectorSource", "github.com/openmcp-project/landscaper/apis/core.TokenRotation"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncStatus contains the status of a TargetSync.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the status was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastErrors describe the last errors",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastTokenRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the token was rotated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"sources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sources contains the result of the last sync for every source of the TargetSync.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.TargetSyncSourceStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.TargetSyncSourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"),
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetTypeDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetTypeDefinition registers a target type in the landscaper cluster. It declares the schema of the configuration of all targets of the type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of the target type.",
 							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TargetTypeDefinitionSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.TargetTypeDefinitionSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetTypeDefinitionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetTypeDefinitionList contains a list of TargetTypeDefinitions",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.TargetTypeDefinition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.TargetTypeDefinition", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TargetTypeDefinitionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetTypeDefinitionSpec contains the specification of a target type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the registered target type, e.g. \"landscaper.gardener.cloud/kubernetes-cluster\". A target type must not be registered by more than one TargetTypeDefinition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema is the json schema that the configuration of targets of this type has to satisfy. The schema is applied to the inline configuration of a target as well as to the content of the referenced secret.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.JSONSchemaDefinition"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.JSONSchemaDefinition"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateExecutor describes a templating mechanism and configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the template",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type describes the templating mechanism.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File is the path to the template in the blueprint's content.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"name", "type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TestResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TestResult describes the result of a test deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the test deploy item as defined by the testExecutions of the blueprint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deployItem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItem is the reference to the test deploy item.",
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
 						},
 					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the test deploy item.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes the error of a failed test.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.ObjectReference"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TestStatus describes the latest run of the tests of an installation, i.e. of the deploy items which are defined by the testExecutions of its blueprint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the job of the test execution in which the tests have run.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the test execution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the tests have been started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time when the tests have finished.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"results": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Results lists the results of the single test deploy items.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.TestResult"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"name", "ref"},
+				Required: []string{"jobID"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.ObjectReference"},
+			"github.com/openmcp-project/landscaper/apis/core.TestResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_TimeoutDetails(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ObjectReference is the reference to a kubernetes object.",
+				Description: "TimeoutDetails describes the exceeded timeout of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deadline is the time when the timeout was exceeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"slowestChild": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SlowestChild is the subinstallation or execution, which had been incomplete for the longest time when the timeout was exceeded.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.IncompleteChild"),
+						},
+					},
+				},
+				Required: []string{"deadline"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.IncompleteChild", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled defines if automatic token is executed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"triggerTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggerTime is the time when the jobID is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"initTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitTime is the time when the Init phase starts.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"waitTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitTime is the time when the work is done.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"finishedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FinishedTime is the time when the finished phase is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the type of resource being referenced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Name is the name of the kubernetes object.",
@@ -5186,194 +9024,326 @@ func schema_openmcp_project_landscaper_apis_core_ObjectReference(ref common.Refe
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"apiVersion", "kind", "name"},
 			},
 		},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_Verification(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Description: "Verification defines the necessary data to verify the signature of the refered component",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"skipUninstallIfClusterRemoved": {
+					"signatureName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
-							Type:        []string{"boolean"},
+							Description: "SignatureName defines the name of the signature that is verified",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"signatureName"},
 			},
 		},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_VerificationSignature(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Optimization contains settings to improve execution preformance",
+				Description: "VerificationSignatures contains the trusted verification information",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"hasNoSiblingImports": {
+					"publicKeySecretReference": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
+							Description: "PublicKeySecretReference contains a secret reference to a public key in PEM format that is used to verify the component signature",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SecretReference"),
+						},
+					},
+					"caCertificateSecretReference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaCertificateSecretReference contains a secret reference to one or more certificates in PEM format that are used to verify the compnent signature",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.SecretReference"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hasNoSiblingExports": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.VersionedObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name", "ref"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core.VersionedObjectReference"},
+	}
+}
+
+func schema_openmcp_project_landscaper_apis_core_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
+				Required: []string{"name", "observedGeneration"},
 			},
 		},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openmcp_project_landscaper_apis_core_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionedResourceReference defines the reference to a resource with its version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version defines the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentName", "resourceName", "version"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated.",
+				Type:        v1alpha1.AnyJSON{}.OpenAPISchemaType(),
+				Format:      v1alpha1.AnyJSON{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resourceName": {
+					"succeededReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SucceededReconcile"),
+						},
+					},
+					"failedReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.FailedReconcile"),
 						},
 					},
 				},
-				Required: []string{"resourceName"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.FailedReconcile", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SucceededReconcile"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
+					"generation": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Generation describes the generation of the installation for which the status holds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"operator": {
+					"numberOfReconciles": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"values": {
+					"lastReconcileTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"onFailed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"key", "operator"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_BlockingObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Description: "BlockingObject describes an object for which an installation is waiting, together with the reason.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"target": {
+					"object": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target contains the original target.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Target"),
+							Description: "Object is the reference to the blocking object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TypedObjectReference"),
 						},
 					},
-					"content": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Description: "Reason is a machine-readable reason why the object blocks the installation.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable description why the object blocks the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports lists the imports of the installation which are exported by the blocking object.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"target", "content"},
+				Required: []string{"object", "reason"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.Target"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TypedObjectReference"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Description: "Blueprint contains the configuration of a component",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"componentName", "resourceName"},
-			},
-		},
-	}
-}
-
-func schema_openmcp_project_landscaper_apis_core_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"selector": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a map of labels to select specific secrets.",
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5387,296 +9357,349 @@ func schema_openmcp_project_landscaper_apis_core_SecretLabelSelectorRef(ref comm
 							},
 						},
 					},
-					"key": {
+					"jsonSchemaVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The key of the secret to select from.  Must be a valid secret key.",
+							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"localTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalTypes defines additional blueprint local schemas",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+									},
+								},
+							},
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the import values that are needed for the definition and its sub-definitions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ImportDefinition"),
+									},
+								},
+							},
+						},
+					},
+					"importExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of errors",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported values of the definition and its sub-definitions",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExportDefinition"),
+									},
+								},
+							},
+						},
+					},
+					"subinstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subinstallations defines an optional list of subinstallations (for aggregating blueprints).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubinstallationTemplate"),
+									},
+								},
+							},
+						},
+					},
+					"subinstallationExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubinstallationExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of installation templates. Both subinstallations and SubinstallationExecutions are valid options and will be merged.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
+						},
+					},
+					"deployExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of deploy item templates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
+						},
+					},
+					"testExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TestExecutions defines the templating executors for the test deploy items of the blueprint. The templates must return a list of deploy item templates. The test deploy items are executed after all other deploy items and all subinstallations have succeeded. The installation only succeeds if all tests succeed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
+						},
+					},
+					"maxParallelDeployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelDeployItems is the maximum number of deploy items of the blueprint which are processed in parallel. If not set, all deploy items whose dependencies are satisfied are started at once.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"exportExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportExecutions defines the templating executors that are used to generate the exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"selector", "key"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExportDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubinstallationTemplate", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_BlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Description: "BlueprintDefinition defines the blueprint that should be used for the installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"namespace": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Reference defines a remote reference to a blueprint",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"),
 						},
 					},
-					"key": {
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Inline defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InlineBlueprint"),
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InlineBlueprint", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataSource defines a static data source",
+				Description: "BlueprintStaticDataSource defines a static data source for a blueprint",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"value": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 					"valueFrom": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.StaticDataValueFrom"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.StaticDataValueFrom"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Description: "BlueprintStaticDataValueFrom defines static data that is read from a external resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
+					"localPath": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Selects a key of a secret in the installations's namespace",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
-						},
-					},
-					"secretLabelSelector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SecretLabelSelectorRef"),
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubInstCache contains the existing sub installations",
+				Description: "ComponentDescriptorDefinition defines the component descriptor that should be used for the installation",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"activeSubs": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.SubNamePair"),
-									},
-								},
-							},
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
 						},
 					},
-					"orphanedSubs": {
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.SubNamePair"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentDescriptorReference", "github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Description: "ComponentDescriptorReference is the reference to a component descriptor. given an optional context.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"specName": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-					"objectName": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version defines the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"componentName", "version"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Description: "ComponentVersionOverwrite defines an overwrite for a specific component and/or version of a component.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"file": {
-						SchemaProps: spec.SchemaProps{
-							Description: "File references a subinstallation template stored in another file.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
-						},
-					},
-					"imports": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
+							Description: "Source defines the component that should be replaced.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationImports"),
-						},
-					},
-					"importDataMappings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
 						},
 					},
-					"exports": {
+					"substitution": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
+							Description: "Substitution defines the replacement target for the component or version.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.InstallationExports"),
-						},
-					},
-					"exportDataMappings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"optimization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Optimization"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
 						},
 					},
 				},
-				Required: []string{"name", "blueprint"},
+				Required: []string{"source", "substitution"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.InstallationExports", "github.com/openmcp-project/landscaper/apis/core.InstallationImports", "github.com/openmcp-project/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core.Optimization"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwriteReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Description: "ComponentVersionOverwriteReference defines a component reference by",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"interval": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.Duration"),
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-					"cronSpec": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version defines the version of the component.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5685,15 +9708,15 @@ func schema_openmcp_project_landscaper_apis_core_SucceededReconcile(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.Duration"},
+			"github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The SyncObject helps to sync access to deploy items.",
+				Description: "ComponentVersionOverwrites contain overwrites for specific (versions of) components.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5713,37 +9736,36 @@ func schema_openmcp_project_landscaper_apis_core_SyncObject(ref common.Reference
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SyncObjectSpec"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"status": {
+					"overwrites": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SyncObjectStatus"),
+							Description: "Overwrites defines a list of component overwrites",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.SyncObjectSpec", "github.com/openmcp-project/landscaper/apis/core.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectList contains a list of SyncObject objects",
+				Description: "ComponentVersionOverwritesList contains a list of ComponentVersionOverwrites",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5773,7 +9795,7 @@ func schema_openmcp_project_landscaper_apis_core_SyncObjectList(ref common.Refer
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.SyncObject"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites"),
 									},
 								},
 							},
@@ -5784,57 +9806,78 @@ func schema_openmcp_project_landscaper_apis_core_SyncObjectList(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectSpec contains the specification.",
+				Description: "Condition holds the information about the state of a resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName describes the name of the pod of the responsible deployer",
+							Description: "DataType of the Shoot condition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind describes the kind of object that is being locked by this SyncObject",
+							Description: "Status of the condition, one of True, False, Unknown.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the object that is being locked by this SyncObject",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the object was updated.",
+							Description: "Last time the condition was updated.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"prefix": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prefix is the prefix of the name of the object.",
+							Description: "The reason for the condition's last transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"codes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Well-defined error codes in case the condition reports a problem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
+				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
@@ -5842,22 +9885,49 @@ func schema_openmcp_project_landscaper_apis_core_SyncObjectSpec(ref common.Refer
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectStatus contains the status.",
+				Description: "ConfigMapReference is reference to data in a configmap. The configmap can also be in a different namespace.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
+				Description: "Context is a resource that contains shared information of installations. This includes information about the repository context like the context itself or secrets to access the oci artifacts. But it can also contain deployer specific config.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5880,127 +9950,173 @@ func schema_openmcp_project_landscaper_apis_core_Target(ref common.ReferenceCall
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"spec": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/openmcp-project/landscaper/apis/core.TargetSpec"),
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-				},
-				Required: []string{"spec"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_openmcp_project_landscaper_apis_core_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TargetExport is a single target export.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"ocmConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the exported target.",
+							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"registryPullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"configurations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"componentVersionOverwrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"verificationSignatures": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object.",
-							Type:        []string{"string"},
+							Description: "VerificationSignatures maps a signature name to the trusted verification information",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.VerificationSignature"),
+									},
+								},
+							},
+						},
+					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval specifies that changes of the deploy items of the installations using this context have to be approved before they are applied. It can be overwritten by the installations.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.VerificationSignature", "github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ContextConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetImport is either a single target or a target list import.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"repositoryContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported target.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
 						},
 					},
-					"target": {
+					"ocmConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"targets": {
+					"registryPullSecrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
 									},
 								},
 							},
 						},
 					},
-					"targetListRef": {
+					"configurations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"componentVersionOverwrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetMap": {
+					"verificationSignatures": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "VerificationSignatures maps a signature name to the trusted verification information",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.VerificationSignature"),
 									},
 								},
 							},
 						},
 					},
-					"targetMapRef": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval specifies that changes of the deploy items of the installations using this context have to be approved before they are applied. It can be overwritten by the installations.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.VerificationSignature", "github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetList contains a list of Targets",
+				Description: "ContextList contains a list of Contexts",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6030,7 +10146,7 @@ func schema_openmcp_project_landscaper_apis_core_TargetList(ref common.Reference
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.Target"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Context"),
 									},
 								},
 							},
@@ -6041,108 +10157,50 @@ func schema_openmcp_project_landscaper_apis_core_TargetList(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_openmcp_project_landscaper_apis_core_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TargetSelector describes a selector that matches specific targets.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"targets": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.ObjectReference"),
-									},
-								},
-							},
-						},
-					},
-					"annotations": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Annotations matches a target based on annotations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.Requirement"),
-									},
-								},
-							},
-						},
-					},
-					"labels": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Labels matches a target based on its labels.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.Requirement"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.ObjectReference", "github.com/openmcp-project/landscaper/apis/core.Requirement"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Context", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_CriticalProblem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSpec contains the definition of a target.",
+				Description: "CriticalProblem contains information about one critical problem.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
+							Description: "PodName contains the name of the pod where the problem occurred",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"creationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+							Description: "CreationTime contains the timestamp when the problem occurred",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"secretRef": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"),
+							Description: "Description contains an error description",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_CriticalProblems(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The TargetSync is created targets from secrets.",
+				Description: "CriticalProblems contains a list of critical landscaper problems.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6169,14 +10227,14 @@ func schema_openmcp_project_landscaper_apis_core_TargetSync(ref common.Reference
 						SchemaProps: spec.SchemaProps{
 							Description: "Spec contains the specification",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TargetSyncSpec"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblemsSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Status contains the status",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TargetSyncStatus"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblemsStatus"),
 						},
 					},
 				},
@@ -6184,15 +10242,15 @@ func schema_openmcp_project_landscaper_apis_core_TargetSync(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.TargetSyncSpec", "github.com/openmcp-project/landscaper/apis/core.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblemsSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblemsStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_CriticalProblemsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncList contains a list of TargetSync objects",
+				Description: "CriticalProblemsList contains a list of critical landscaper problems objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6222,7 +10280,7 @@ func schema_openmcp_project_landscaper_apis_core_TargetSyncList(ref common.Refer
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core.TargetSync"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblems"),
 									},
 								},
 							},
@@ -6233,604 +10291,843 @@ func schema_openmcp_project_landscaper_apis_core_TargetSyncList(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblems", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_CriticalProblemsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Description: "CriticalProblemsSpec contains the specification for a CriticalProblems object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sourceNamespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"),
-						},
-					},
-					"createTargetToSource": {
+					"criticalProblem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblem"),
+									},
+								},
+							},
 						},
 					},
-					"targetToSourceName": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblem"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_CriticalProblemsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CriticalProblemsStatus contains the status of a CriticalProblems object.",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataExport is a data object export.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
+							Description: "Name the internal name of the imported/exported data.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretNameExpression": {
+					"dataRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
+							Description: "DataRef is the name of the in-cluster data object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"shootNameExpression": {
+					"aggregate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Aggregate defines that the exported data is a keyed contribution to an aggregated data object. Multiple installations are allowed to export the same data object if all of them use an aggregated export.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataExportAggregation"),
 						},
 					},
-					"tokenRotation": {
+				},
+				Required: []string{"name", "dataRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataExportAggregation"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DataExportAggregation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataExportAggregation configures the contribution of an export to an aggregated data object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.TokenRotation"),
+							Description: "Key is the key under which the exported data is contributed. Defaults to the name of the exporting installation, or the name of the installation template for subinstallations.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"sourceNamespace", "secretRef"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.LocalSecretReference", "github.com/openmcp-project/landscaper/apis/core.TokenRotation"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncStatus contains the status of a TargetSync.",
+				Description: "DataImport is a data object import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Name the internal name of the imported/exported data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
+					"dataRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the status was updated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "DataRef is the name of the in-cluster data object. The reference can also be a namespaces name. E.g. \"default/mydataref\"",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastErrors": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastErrors describe the last errors",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Version specifies the imported data version. defaults to \"v1\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef defines a data reference from a secret. This method is not allowed in installation templates.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef defines a data reference from a configmap. This method is not allowed in installation templates.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalConfigMapReference"),
 						},
 					},
-					"lastTokenRotationTime": {
+					"aggregated": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the token was rotated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Aggregated defines that the imported data is merged from the contributions of all installations that export the referenced data object as aggregated export. Can only be used in combination with DataRef.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataImportAggregation"),
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataImportAggregation", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalConfigMapReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataImportAggregation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
+				Description: "DataImportAggregation configures the import of an aggregated data object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"format": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
+							Description: "Format defines whether the contributions are merged into a map or a list. Defaults to \"map\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DataObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataObject are resources that can hold any kind json or yaml data.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secretRef": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"annotations": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Data contains the data of the object as string.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"data"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.AnyJSON", "github.com/openmcp-project/landscaper/apis/core.LocalSecretReference"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateExecutor describes a templating mechanism and configuration.",
+				Description: "DataObjectList contains a list of DataObject",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the template",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type describes the templating mechanism.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"file": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File is the path to the template in the blueprint's content.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"template": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.AnyJSON"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataObject"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "type"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.AnyJSON"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Default(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Default defines a default value (future idea: also reference?).",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled defines if automatic token is executed",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 				},
+				Required: []string{"value"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeletionImpact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DeletionImpact describes the objects which would be deleted together with an installation.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"triggerTime": {
+					"computationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TriggerTime is the time when the jobID is set.",
+							Description: "ComputationTime is the time when the deletion impact was computed.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"initTime": {
+					"installations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitTime is the time when the Init phase starts.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Installations lists the subinstallations in the subtree of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
 						},
 					},
-					"waitTime": {
+					"executions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitTime is the time when the work is done.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Executions lists the executions in the subtree of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
 						},
 					},
-					"finishedTime": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FinishedTime is the time when the finished phase is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "DeployItems lists the deploy items in the subtree of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"managedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedResources lists the resources in the target clusters which are deleted by the deployers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ManagedResourceReference"),
+									},
+								},
+							},
+						},
+					},
+					"totalInstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalInstallations is the number of subinstallations in the subtree of the installation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"totalExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalExecutions is the number of executions in the subtree of the installation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"totalDeployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalDeployItems is the number of deploy items in the subtree of the installation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"totalManagedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalManagedResources is the number of resources in the target clusters which are deleted by the deployers.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"truncated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Truncated is true if at least one of the lists is truncated, because it exceeds the maximum number of entries. The total numbers count all objects.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"computationTime"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ManagedResourceReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is the type of resource being referenced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "Name is the name of the dependent installation",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"apiVersion", "kind", "name"},
 			},
 		},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_Verification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Verification defines the necessary data to verify the signature of the refered component",
+				Description: "DeployItem defines a resource that should be processed by a external deployer",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"signatureName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SignatureName defines the name of the signature that is verified",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemStatus"),
+						},
+					},
 				},
-				Required: []string{"signatureName"},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_VerificationSignature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VerificationSignatures contains the trusted verification information",
+				Description: "DeployItemCache contains the existing deploy items",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"publicKeySecretReference": {
+					"activeDIs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PublicKeySecretReference contains a secret reference to a public key in PEM format that is used to verify the component signature",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SecretReference"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DiNamePair"),
+									},
+								},
+							},
 						},
 					},
-					"caCertificateSecretReference": {
+					"orphanedDIs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CaCertificateSecretReference contains a secret reference to one or more certificates in PEM format that are used to verify the compnent signature",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.SecretReference"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.SecretReference"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DiNamePair"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemForEach(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "DeployItemForEach defines the elements over which a deploy item template is expanded. Exactly one of Items and TargetImport has to be set. The generated deploy items are named \"<name>-<key>\", where the key is the index of a list element or the key of a map element. The placeholders \"$(each.key)\" and \"$(each.value)\" in the deploy item template are replaced by the key and the value of the element; fields of the value are referenced by paths like \"$(each.value.name)\".",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Items is a list or a map of values.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
-					"ref": {
+					"targetImport": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core.VersionedObjectReference"),
+							Description: "TargetImport is the name of a targetList or targetMap import of the blueprint. The target of each generated deploy item is the respective element of the import.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core.VersionedObjectReference"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "DeployItemList contains a list of DeployItems",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItem"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "observedGeneration"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItem", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_openmcp_project_landscaper_apis_core_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedResourceReference defines the reference to a resource with its version.",
+				Description: "DeployItemSpec contains the definition of a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
+							Description: "Type is the type of the deployer that should handle the item.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
+							Description: "Target specifies an optional target of the deploy item. In most cases it contains the secrets to access a evironment. It is also used by the deployers to determine the ownernship.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"context": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Context defines the current context of the deployitem.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "Configuration contains the deployer type specific configuration.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"updateOnChangeOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"reconcileOnTargetChange": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcileOnTargetChange specifies that the deploy item is reconciled again when the resolved content of its target changes, e.g. because the secret referenced by the target has been rotated.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"onDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnDelete specifies particular setting when deleting a deploy item",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+						},
+					},
 				},
-				Required: []string{"componentName", "resourceName", "version"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated.",
-				Type:        v1alpha1.AnyJSON{}.OpenAPISchemaType(),
-				Format:      v1alpha1.AnyJSON{}.OpenAPISchemaFormat(),
+				Required: []string{"type"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+				Description: "DeployItemStatus contains the status of a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"succeededReconcile": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SucceededReconcile"),
+							Description: "Phase is the current phase of the DeployItem",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this DeployItem. It corresponds to the DeployItem generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the actual condition of a deploy item",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"lastErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorHistory describes the last n errors that occurred since JobID was changed the last time.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error"),
+									},
+								},
+							},
+						},
+					},
+					"firstError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FirstError describes the first error that occurred since JobID was changed the last time.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"lastReconcileTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReconcileTime indicates when the reconciliation of the last change to the deploy item has started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deployer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployer describes the deployer that has reconciled the deploy item.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployerInformation"),
+						},
+					},
+					"providerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderStatus contains the provider specific status",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
-					"failedReconcile": {
+					"exportRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.FailedReconcile"),
+							Description: "ExportReference is the reference to the object that contains the exported values.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.FailedReconcile", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SucceededReconcile"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"generation": {
+					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Generation describes the generation of the installation for which the status holds.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"numberOfReconciles": {
+					"jobIDFinished": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastReconcileTime": {
+					"jobIDGenerationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
+							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"onFailed": {
+					"targetContentHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
-							Type:        []string{"boolean"},
+							Description: "TargetContentHash is the hash of the resolved content of the target with which the deploy item has been reconciled the last time. It is only maintained if ReconcileOnTargetChange is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"referencedDataHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReferencedDataHash is the hash of the data in Secrets and ConfigMaps which is referenced by the configuration of the deploy item, e.g. by the valuesFrom of a helm deploy item, with which the deploy item has been reconciled the last time. It is used to reconcile a deploy item with UpdateOnChangeOnly again if the referenced data has changed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deployItemPhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"transitionTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes"),
+						},
+					},
+					"retry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retry describes the retries of the failed deploy item according to the retry policy of its template.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryStatus"),
+						},
+					},
 				},
+				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployerInformation", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryStatus", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Blueprint contains the configuration of a component",
+				Description: "DeployItemTemplate defines a execution element that is translated into a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the unique name of the execution.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "DataType is the DeployItem type of the execution.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"annotations": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
+							Description: "Target is the object reference to the target that the deploy item should deploy to.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels is the map of labels to be added to the deploy item.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -6844,200 +11141,143 @@ func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback
 							},
 						},
 					},
-					"jsonSchemaVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"localTypes": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LocalTypes defines additional blueprint local schemas",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
-									},
-								},
-							},
+							Description: "ProviderConfiguration contains the type specific configuration for the execution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
-					"imports": {
+					"dependsOn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the import values that are needed for the definition and its sub-definitions.",
+							Description: "DependsOn lists deploy items that need to be executed before this one",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ImportDefinition"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"importExecutions": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of errors",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"),
-									},
-								},
-							},
+							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"exports": {
+					"updateOnChangeOnly": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported values of the definition and its sub-definitions",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExportDefinition"),
-									},
-								},
-							},
+							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"subinstallations": {
+					"reconcileOnTargetChange": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subinstallations defines an optional list of subinstallations (for aggregating blueprints).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubinstallationTemplate"),
-									},
-								},
-							},
+							Description: "ReconcileOnTargetChange specifies that the deploy item is reconciled again when the resolved content of its target changes, e.g. because the secret referenced by the target has been rotated.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"subinstallationExecutions": {
+					"rolloutGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubinstallationExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of installation templates. Both subinstallations and SubinstallationExecutions are valid options and will be merged.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"),
-									},
-								},
-							},
+							Description: "RolloutGroup identifies the element of a targetList or targetMap import the deploy item deploys to. Deploy items with a rollout group are released wave by wave according to the rollout policy of the execution.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"deployExecutions": {
+					"onDelete": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of deploy item templates.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"),
-									},
-								},
-							},
+							Description: "OnDelete specifies particular setting when deleting a deploy item",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
 						},
 					},
-					"exportExecutions": {
+					"retryPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportExecutions defines the templating executors that are used to generate the exports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"),
-									},
-								},
-							},
+							Description: "RetryPolicy configures automatic retries of the deploy item when it has failed, depending on the error codes of the failure. The execution waits for the retries before it fails.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryPolicy"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExportDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubinstallationTemplate", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TemplateExecutor"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_BlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintDefinition defines the blueprint that should be used for the installation.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
+					"when": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a remote reference to a blueprint",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"),
+							Description: "When specifies whether the deploy item is created. The deploy item is omitted if the value is false. The field is evaluated when the deploy executions of a blueprint are templated and must not be set in executions.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"inline": {
+					"forEach": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Inline defines a inline yaml filesystem with a blueprint.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InlineBlueprint"),
+							Description: "ForEach expands the deploy item into one deploy item per element of a list, a map, or a targetList or targetMap import. The field is evaluated when the deploy executions of a blueprint are templated and must not be set in executions.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemForEach"),
 						},
 					},
 				},
+				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InlineBlueprint", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemForEach", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.OnDeleteConfig", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryPolicy", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintStaticDataSource defines a static data source for a blueprint",
+				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Identity describes the unique identity of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"valueFrom": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
+							Description: "Name is the name of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"identity", "name", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DiNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintStaticDataValueFrom defines static data that is read from a external resource.",
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"localPath": {
+					"specName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"objectName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
@@ -7046,143 +11286,139 @@ func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataValueFrom(ref commo
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentDescriptorDefinition defines the component descriptor that should be used for the installation",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptorReference is the reference to a component descriptor",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
-						},
-					},
-					"inline": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InlineDescriptorReference defines an inline component descriptor",
-							Ref:         ref("github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"),
-						},
-					},
-				},
+				Description: "Duration is a wrapper for time.Duration that implements JSON marshalling and openapi scheme.",
+				Type:        v1alpha1.Duration{}.OpenAPISchemaType(),
+				Format:      v1alpha1.Duration{}.OpenAPISchemaFormat(),
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentDescriptorReference", "github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Error(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentDescriptorReference is the reference to a component descriptor. given an optional context.",
+				Description: "Error holds information about an error that occurred.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
+					"operation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Description: "Operation describes the operator where the error occurred.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"componentName": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
+							Description: "A human readable message indicating details about the transition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"codes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Well-defined error codes in case the condition reports a problem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"componentName", "version"},
+				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwrite defines an overwrite for a specific component and/or version of a component.",
+				Description: "Execution contains the configuration of a execution and deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"source": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source defines the component that should be replaced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"substitution": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Substitution defines the replacement target for the component or version.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"source", "substitution"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwriteReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwriteReference defines a component reference by",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"repositoryContext": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"componentName": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Spec defines a execution and its items",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExecutionSpec"),
 						},
 					},
-					"version": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Status contains the current status of the execution.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExecutionStatus"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExecutionSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExecutionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwrites contain overwrites for specific (versions of) components.",
+				Description: "ExecutionList contains a list of Executions‚",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7202,182 +11438,237 @@ func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"overwrites": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Overwrites defines a list of component overwrites",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Execution"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Execution", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwritesList contains a list of ComponentVersionOverwrites",
+				Description: "ExecutionSpec defines a execution plan.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Context defines the current context of the execution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems defines all execution items that need to be scheduled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
+									},
+								},
+							},
+						},
+					},
+					"deployItemsCompressed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItemsCompressed as zipped byte array",
 							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout configures the progressive rollout of the deploy items with a rollout group. If not set, all deploy items are updated at once.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutPolicy"),
+						},
+					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval specifies that changes of the deploy items have to be approved before they are applied.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"maxParallel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallel is the maximum number of deploy items which are processed in parallel. Further runnable deploy items are queued until running deploy items have finished. If not set, all runnable deploy items are started at once.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemTemplate", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutPolicy"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExecutionStatus contains the current status of a execution.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Execution. It corresponds to the Execution generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the actual condition of a execution",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"exportRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ExportReference references the object that contains the exported values. only used for operation purpose.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"metadata": {
+					"deployItemCache": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "DeployItemCache contains the currently existing deploy item belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemCache"),
 						},
 					},
-					"items": {
+					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites"),
-									},
-								},
-							},
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Condition holds the information about the state of a resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"jobIDFinished": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataType of the Shoot condition.",
-							Default:     "",
+							Description: "JobIDFinished is the ID of the finished working request.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
+							Description: "ExecutionPhase is the current phase of the execution.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"phaseTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
+							Description: "PhaseTransitionTime is the time when the phase last changed.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"lastUpdateTime": {
+					"transitionTimes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes"),
 						},
 					},
-					"reason": {
+					"rollout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Rollout describes the progress of the rollout of the deploy items. It is only maintained if the execution has a rollout policy.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutStatus"),
 						},
 					},
-					"message": {
+					"nextRetryTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NextRetryTime is the time of the next scheduled retry of a failed deploy item according to its retry policy.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"codes": {
+					"pendingDeletion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Well-defined error codes in case the condition reports a problem.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "PendingDeletion describes the deletion of orphaned deploy items, which waits for a confirmation because it exceeds the deletion safeguard.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.PendingDeletion"),
+						},
+					},
+					"pendingApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingApproval describes the change of the deploy items which waits for approval.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.PendingApproval"),
 						},
 					},
 				},
-				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemCache", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.PendingApproval", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.PendingDeletion", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutStatus", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapReference is reference to data in a configmap. The configmap can also be in a different namespace.",
+				Description: "ExportDefinition defines a exported value",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+						},
+					},
+					"targetType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetType defines the type of the imported target.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
+							Description: "Type specifies which kind of object is being exported. This field should be set and will likely be mandatory in future.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -7386,273 +11677,263 @@ func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.Referenc
 				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_FailedObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Context is a resource that contains shared information of installations. This includes information about the repository context like the context itself or secrets to access the oci artifacts. But it can also contain deployer specific config.",
+				Description: "FailedObject describes a failed object in the subtree of an installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"object": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Object is the reference to the failed object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TypedObjectReference"),
 						},
 					},
-					"apiVersion": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Reason is the reason of the last error of the object.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"repositoryContext": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
-						},
-					},
-					"ocmConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
-						},
-					},
-					"registryPullSecrets": {
+					"codes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Description: "Codes are the error codes of the last error of the object.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"configurations": {
+				},
+				Required: []string{"object"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TypedObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"numberOfReconciles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper limit exists.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"componentVersionOverwrites": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"verificationSignatures": {
+					"cronSpec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VerificationSignatures maps a signature name to the trusted verification information",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.VerificationSignature"),
-									},
-								},
-							},
+							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.VerificationSignature", "github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ContextConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "FieldValueDefinition defines a im- or exported field. Either schema or target type have to be defined",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
-						},
-					},
-					"ocmConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
-						},
-					},
-					"registryPullSecrets": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
-									},
-								},
-							},
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"configurations": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
 						},
 					},
-					"componentVersionOverwrites": {
+					"targetType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
-							Default:     "",
+							Description: "TargetType defines the type of the imported target.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"verificationSignatures": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VerificationSignatures maps a signature name to the trusted verification information",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.VerificationSignature"),
-									},
-								},
-							},
-						},
-					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.VerificationSignature", "github.com/openmcp-project/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContextList contains a list of Contexts",
+				Description: "ImportDefinition defines a imported value",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+						},
+					},
+					"targetType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetType defines the type of the imported target.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"required": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Default sets a default value for the current import that is used if the key is not set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Default"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Context"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ImportDefinition"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Context", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Default", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_IncompleteChild(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblem contains information about one critical problem.",
+				Description: "IncompleteChild describes a subinstallation or execution, which has not finished the current job.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"object": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName contains the name of the pod where the problem occurred",
+							Description: "Object is the reference to the subinstallation or execution.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TypedObjectReference"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the subinstallation or execution.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"creationTime": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreationTime contains the timestamp when the problem occurred",
+							Description: "StartTime is the time when the subinstallation or execution has started the current job.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"description": {
+				},
+				Required: []string{"object"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TypedObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InlineBlueprint defines a inline blueprint with component descriptor and filesystem.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"filesystem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains an error description",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 				},
+				Required: []string{"filesystem"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblems(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblems contains a list of critical landscaper problems.",
+				Description: "Installation contains the configuration of a component",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7677,16 +11958,16 @@ func schema_landscaper_apis_core_v1alpha1_CriticalProblems(ref common.ReferenceC
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
+							Description: "Spec contains the specification for a installation.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblemsSpec"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
+							Description: "Status contains the status of the installation.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblemsStatus"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationStatus"),
 						},
 					},
 				},
@@ -7694,225 +11975,340 @@ func schema_landscaper_apis_core_v1alpha1_CriticalProblems(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblemsSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblemsStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblemsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblemsList contains a list of critical landscaper problems objects",
+				Description: "InstallationExports defines exports of data objects and targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Data defines all data object exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataExport"),
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Targets defines all target exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetExport"),
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataExport", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetExport"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationImports defines import of data objects and targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Data defines all data object imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataImport"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Targets defines all target imports.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblems"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetImport"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblems", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataImport", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetImport"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblemsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblemsSpec contains the specification for a CriticalProblems object.",
+				Description: "InstallationList contains a list of Components",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"criticalProblem": {
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblem"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Installation"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.CriticalProblem"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblemsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblemsStatus contains the status of a CriticalProblems object.",
+				Description: "InstallationSet generates one root installation from a template for every element of a generator.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of the installation set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status of the installation set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSetElementOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataExport is a data object export.",
+				Description: "InstallationSetElementOverride replaces imports of the template for a particular element.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"element": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported/exported data.",
+							Description: "Element is the name of the element.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dataRef": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Imports replace the imports of the template with the same name or are added to them.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings replace the import data mappings of the template with the same name or are added to them.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "dataRef"},
+				Required: []string{"element"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSetGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataImport is a data object import.",
+				Description: "InstallationSetGenerator defines the elements of an InstallationSet. Exactly one of Targets, TargetMap and Parameters has to be specified.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported/exported data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Targets generates an element for every target in the namespace of the InstallationSet that matches a label selector. The element has the name of the target.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetTargetsGenerator"),
 						},
 					},
-					"dataRef": {
+					"targetMap": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object. The reference can also be a namespaces name. E.g. \"default/mydataref\"",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TargetMap generates an element for every entry of the map. The element has the name of the key, the value is the name of the target.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"version": {
+					"targetImport": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version specifies the imported data version. defaults to \"v1\"",
+							Description: "TargetImport is the name of the target import of the generated installations, which is set to the target of an element. Required for Targets and TargetMap.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef defines a data reference from a secret. This method is not allowed in installation templates.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"),
-						},
-					},
-					"configMapRef": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMapRef defines a data reference from a configmap. This method is not allowed in installation templates.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalConfigMapReference"),
+							Description: "Parameters generates an element for every parameter set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetParameterSet"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalConfigMapReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetParameterSet", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetTargetsGenerator"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSetInstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataObject are resources that can hold any kind json or yaml data.",
+				Description: "InstallationSetInstallationStatus contains the status of an installation generated by an InstallationSet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"element": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Element is the name of the element.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name is the name of the installation.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"data": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the data of the object as string.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Phase is the phase of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"data"},
+				Required: []string{"element", "name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataObjectList contains a list of DataObject",
+				Description: "InstallationSetList contains a list of InstallationSets",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7942,7 +12338,7 @@ func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataObject"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSet"),
 									},
 								},
 							},
@@ -7953,24 +12349,41 @@ func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Default(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSetParameterSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Default defines a default value (future idea: also reference?).",
+				Description: "InstallationSetParameterSet is an element of an InstallationSet defined by parameters.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Name is the name of the element.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings are added to the import data mappings of the generated installation.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"value"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
@@ -7978,97 +12391,179 @@ func schema_landscaper_apis_core_v1alpha1_Default(ref common.ReferenceCallback)
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "InstallationSetSpec contains the specification of an InstallationSet.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the dependent installation",
+							Description: "Template is the template of the generated installations.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetTemplate"),
+						},
+					},
+					"generator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generator defines the elements for which installations are generated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetGenerator"),
+						},
+					},
+					"nameTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NameTemplate is a go template which computes the name of the installation of an element. The template gets the values \"setName\", \"elementName\" and \"targetName\". Defaults to \"{{ .setName }}-{{ .elementName }}\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"overrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overrides replace imports of the template for particular elements.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetElementOverride"),
+									},
+								},
+							},
+						},
+					},
+					"maxProgressing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxProgressing is the maximal number of generated installations which are progressing at the same time. Installations of further elements are created or updated when progressing installations have finished. If not set, all installations are created or updated at once.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
+				Required: []string{"template", "generator"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetElementOverride", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetGenerator", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetTemplate"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItem defines a resource that should be processed by a external deployer",
+				Description: "InstallationSetStatus contains the status of an InstallationSet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"apiVersion": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastError describes the last error of the installation set.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error"),
 						},
 					},
-					"metadata": {
+					"installationCount": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "InstallationCount is the number of generated installations.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"spec": {
+					"progressing": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemSpec"),
+							Description: "Progressing is the number of generated installations which are currently progressing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"status": {
+					"pending": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemStatus"),
+							Description: "Pending is the number of elements whose installation waits to be created, updated or deleted, because MaxProgressing installations are already progressing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"installations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installations contains the status of the generated installations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetInstallationStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSetInstallationStatus"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSetTargetsGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemCache contains the existing deploy items",
+				Description: "InstallationSetTargetsGenerator selects the targets for which installations are generated.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"activeDIs": {
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Selector selects the targets by their labels.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"selector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationSetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationSetTemplate is the template of the installations of an InstallationSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are added to the generated installations.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DiNamePair"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"orphanedDIs": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Annotations are added to the generated installations.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -8079,142 +12574,182 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref common.ReferenceCa
 							},
 						},
 					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the generated installations.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSpec"),
+						},
+					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DiNamePair"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSpec"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemList contains a list of DeployItems",
+				Description: "InstallationSpec defines a component installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Context defines the current context of the installation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"verification": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Verification defines the necessary data to verify the signature of the refered component",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Verification"),
 						},
 					},
-					"metadata": {
+					"componentDescriptor": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
 						},
 					},
-					"items": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Blueprint is the resolved reference to the definition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItem"),
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItem", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemSpec contains the definition of a deploy item.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the deployer that should handle the item.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports"),
 						},
 					},
-					"target": {
+					"exportDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target specifies an optional target of the deploy item. In most cases it contains the secrets to access a evironment. It is also used by the deployers to determine the ownernship.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
-					"context": {
+					"automaticReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the deployitem.",
-							Type:        []string{"string"},
+							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AutomaticReconcile"),
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization"),
+						},
+					},
+					"reconcileOnTargetChange": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcileOnTargetChange specifies that all deploy items of the installation are reconciled again when the resolved content of their target changes, e.g. because the secret referenced by the target has been rotated.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"rollout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the deployer type specific configuration.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Rollout configures the progressive rollout of the deploy items and subinstallations of the installation which deploy to an element of a targetList or targetMap import. If not set, all of them are updated at once.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutPolicy"),
 						},
 					},
-					"timeout": {
+					"requireApproval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "RequireApproval specifies that changes of the deploy items of the installation have to be approved before they are applied. If not set, the setting of the context of the installation is used.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"updateOnChangeOnly": {
+					"suspend": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Description: "Suspend pauses the reconciliation of the installation and of its subtree, i.e. its subinstallations, its execution and its deploy items. When the installation is resumed, the reconciliation continues in the current phase.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"onDelete": {
+					"dependsOn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnDelete specifies particular setting when deleting a deploy item",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+							Description: "DependsOn lists the names of sibling installations which have to be finished successfully before this installation is reconciled, additionally to the dependencies defined by the imports. Root installations refer to the names of other root installations in the same namespace, subinstallations to the names of the installation templates in the blueprint of their parent.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy configures automatic retries of the installation when it has failed, depending on the error codes of the failure. It must not be combined with automaticReconcile.failedReconcile.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryPolicy"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the maximal duration of a reconciliation of the installation including its whole subtree, measured from the start of the job. When it is exceeded, the installation fails with error code ERR_TIMEOUT, and the running deploy items of the subtree are interrupted. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). There is no timeout if not specified.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.AutomaticReconcile", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryPolicy", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutPolicy", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Verification"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemStatus contains the status of a deploy item.",
+				Description: "InstallationStatus contains the current status of a Installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current phase of the DeployItem",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this DeployItem. It corresponds to the DeployItem generation, which is updated on mutation by the landscaper.",
+							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
@@ -8222,7 +12757,7 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceC
 					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a deploy item",
+							Description: "Conditions contains the actual condition of a installation",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -8240,81 +12775,138 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceC
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error"),
 						},
 					},
-					"lastErrors": {
+					"subInstCache": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ErrorHistory describes the last n errors that occurred since JobID was changed the last time.",
+							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubInstCache"),
+						},
+					},
+					"executionRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"testExecutionRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TestExecutionReference is the reference to the execution of the test deploy items of the blueprint.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationPhase is the current phase of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phaseTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PhaseTransitionTime is the time when the phase last changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"automaticReconcileStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus"),
+						},
+					},
+					"dependentsToTrigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependentsToTrigger lists dependent installations to be triggered",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DependentToTrigger"),
 									},
 								},
 							},
 						},
 					},
-					"firstError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FirstError describes the first error that occurred since JobID was changed the last time.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error"),
-						},
-					},
-					"lastReconcileTime": {
+					"transitionTimes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime indicates when the reconciliation of the last change to the deploy item has started",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes"),
 						},
 					},
-					"deployer": {
+					"rollout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deployer describes the deployer that has reconciled the deploy item.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployerInformation"),
+							Description: "Rollout describes the progress of the rollout of the subinstallations. It is only maintained if the installation has a rollout policy.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutStatus"),
 						},
 					},
-					"providerStatus": {
+					"retry": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderStatus contains the provider specific status",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Retry describes the retries of the failed installation according to its retry policy.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryStatus"),
 						},
 					},
-					"exportRef": {
+					"blockedBy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportReference is the reference to the object that contains the exported values.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "BlockedBy lists the objects for which the installation is waiting. It is only set while the installation is in phase Init or Progressing.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.BlockingObject"),
+									},
+								},
+							},
 						},
 					},
-					"jobID": {
+					"summary": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Summary aggregates the status of the subinstallations, executions and deploy items in the subtree of the installation. For a root installation, it covers the whole tree.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubtreeSummary"),
 						},
 					},
-					"jobIDFinished": {
+					"pendingDeletion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "PendingDeletion describes the deletion of orphaned subinstallations, which waits for a confirmation because it exceeds the deletion safeguard.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.PendingDeletion"),
 						},
 					},
-					"jobIDGenerationTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+					"deletionImpact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionImpact describes the objects which would be deleted together with the installation. It is computed on request by the operation annotation deletion-impact.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeletionImpact"),
 						},
 					},
-					"deployItemPhase": {
+					"timedOut": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TimedOut describes the exceeded timeout, if the current job of the installation has failed because of its timeout.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TimeoutDetails"),
 						},
 					},
-					"transitionTimes": {
+					"tests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes"),
+							Description: "Tests describes the latest run of the tests defined by the testExecutions of the blueprint.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TestStatus"),
 						},
 					},
 				},
@@ -8322,251 +12914,229 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployerInformation", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.BlockingObject", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeletionImpact", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.PendingDeletion", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryStatus", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutStatus", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubInstCache", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubtreeSummary", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TestStatus", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TimeoutDetails", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemTemplate defines a execution element that is translated into a deploy item.",
+				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the execution.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataType is the DeployItem type of the execution.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the step",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the object reference to the target that the deploy item should deploy to.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
 						},
 					},
-					"labels": {
+					"imports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is the map of labels to be added to the deploy item.",
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-					"config": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfiguration contains the type specific configuration for the execution.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports"),
 						},
 					},
-					"dependsOn": {
+					"exportDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependsOn lists deploy items that need to be executed before this one",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-					"timeout": {
+					"optimization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization"),
 						},
 					},
-					"updateOnChangeOnly": {
+					"rollout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Rollout configures the progressive rollout of the deploy items and subinstallations of the installation which deploy to an element of a targetList or targetMap import.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutPolicy"),
 						},
 					},
-					"onDelete": {
+					"dependsOn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnDelete specifies particular setting when deleting a deploy item",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+							Description: "DependsOn lists the names of sibling installation templates which have to be finished successfully before this subinstallation is reconciled, additionally to the dependencies defined by the imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "type", "config"},
+				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutPolicy"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
+				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"identity": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Identity describes the unique identity of the deployer.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the deployer.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"version": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the deployer.",
-							Default:     "",
+							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"identity", "name", "version"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_DiNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"specName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"objectName": {
+					"filesystem": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Duration is a wrapper for time.Duration that implements JSON marshalling and openapi scheme.",
-				Type:        v1alpha1.Duration{}.OpenAPISchemaType(),
-				Format:      v1alpha1.Duration{}.OpenAPISchemaFormat(),
+				Description: "JSONSchemaDefinition defines a jsonschema.",
+				Type:        v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaType(),
+				Format:      v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Error(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Error holds information about an error that occurred.",
+				Description: "LocalConfigMapReference is a reference to data in a configmap.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"operation": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Operation describes the operator where the error occurred.",
+							Description: "Name is the name of the configmap",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"reason": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "Key is the name of the key in the configmap that holds the data.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalSecretReference is a reference to data in a secret.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "Name is the name of the secret",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"codes": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Well-defined error codes in case the condition reports a problem.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Execution contains the configuration of a execution and deploy item",
+				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8589,33 +13159,42 @@ func schema_landscaper_apis_core_v1alpha1_Execution(ref common.ReferenceCallback
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"spec": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines a execution and its items",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExecutionSpec"),
+							Description: "Status defines the overall status of the landscaper and its standard deployers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the current status of the execution.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExecutionStatus"),
+							Description: "LastUpdateTime contains last time the check was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description contains description of the problem(s)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"status", "lastUpdateTime", "description"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExecutionSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ExecutionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionList contains a list of Executions‚",
+				Description: "LsHealthCheckList contains a list of LsHealthChecks",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8645,7 +13224,7 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCall
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Execution"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LsHealthCheck"),
 									},
 								},
 							},
@@ -8656,172 +13235,114 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Execution", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ManagedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionSpec defines a execution plan.",
+				Description: "ManagedResourceReference is the reference to a resource in a target cluster, which is managed by a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"context": {
+					"deployItem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the execution.",
+							Description: "DeployItem is the deploy item which manages the resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the group and version of the resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"deployItems": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItems defines all execution items that need to be scheduled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
-									},
-								},
-							},
+							Description: "Kind is the kind of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"deployItemsCompressed": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItemsCompressed as zipped byte array",
+							Description: "Name is the name of the resource.",
+							Default:     "",
 							Type:        []string{"string"},
-							Format:      "byte",
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the resource.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"deployItem", "apiVersion", "kind", "name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemTemplate"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionStatus contains the current status of a execution.",
+				Description: "NamedObjectReference is a named reference to a specific resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Execution. It corresponds to the Execution generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"conditions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a execution",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition"),
-									},
-								},
-							},
-						},
-					},
-					"lastError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error"),
-						},
-					},
-					"exportRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExportReference references the object that contains the exported values. only used for operation purpose.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
-						},
-					},
-					"deployItemCache": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeployItemCache contains the currently existing deploy item belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemCache"),
-						},
-					},
-					"jobID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"jobIDFinished": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"phase": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionPhase is the current phase of the execution.",
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phaseTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PhaseTransitionTime is the time when the phase last changed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"transitionTimes": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes"),
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
 				},
+				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.DeployItemCache", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExportDefinition defines a exported value",
+				Description: "ObjectReference is the reference to a kubernetes object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Description: "Name is the name of the kubernetes object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schema": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
-						},
-					},
-					"targetType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"type": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being exported. This field should be set and will likely be mandatory in future.",
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -8830,581 +13351,703 @@ func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceC
 				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations",
+				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"numberOfReconciles": {
+					"skipUninstallIfClusterRemoved": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper limit exists.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"interval": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Optimization contains settings to improve execution preformance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hasNoSiblingImports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"cronSpec": {
+					"hasNoSiblingExports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
-							Type:        []string{"string"},
+							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_PendingApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FieldValueDefinition defines a im- or exported field. Either schema or target type have to be defined",
+				Description: "PendingApproval describes a change of the deploy items of an execution which waits for approval.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Description: "Digest identifies the pending change. The change is approved by setting the approve annotation of the execution to this digest.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schema": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
-						},
-					},
-					"targetType": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DeployItems contains the names of the deploy items which are created, updated or deleted by the change.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"digest"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_PendingDeletion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImportDefinition defines a imported value",
+				Description: "PendingDeletion describes the deletion of orphaned objects, which exceeds the deletion safeguard and therefore waits for a confirmation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Description: "Digest identifies the pending deletion. The deletion is confirmed by the annotation landscaper.gardener.cloud/confirm-deletion with the digest as value.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schema": {
+					"objects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+							Description: "Objects lists the names of the orphaned objects which would be deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"targetType": {
+					"totalObjects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TotalObjects is the number of objects of the same kind, of which the orphaned objects would be deleted.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"type": {
+				},
+				Required: []string{"digest", "totalObjects"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
+							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"required": {
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"default": {
+					"operator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Default sets a default value for the current import that is used if the key is not set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Default"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"imports": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports.",
+							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ImportDefinition"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"key", "operator"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Default", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InlineBlueprint defines a inline blueprint with component descriptor and filesystem.",
+				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"filesystem": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Target contains the original target.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Target"),
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"filesystem"},
+				Required: []string{"target", "content"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Target"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Installation contains the configuration of a component",
+				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification for a installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status of the installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationStatus"),
-						},
-					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"componentName", "resourceName"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RetryBackoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationExports defines exports of data objects and targets.",
+				Description: "RetryBackoff configures an exponential backoff between retries.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"data": {
+					"initialInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object exports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataExport"),
-									},
-								},
-							},
+							Description: "InitialInterval is the waiting time before the first retry. Defaults to 30 seconds.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"targets": {
+					"maxInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target exports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetExport"),
-									},
-								},
-							},
+							Description: "MaxInterval is the upper limit of the waiting time before a retry. Defaults to 10 minutes.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"factor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Factor is the factor by which the waiting time grows with every retry. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"jitterPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JitterPercentage is the maximal percentage by which the waiting time is randomly extended, so that objects which failed at the same time are not retried at the same time. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataExport", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetExport"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RetryPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationImports defines import of data objects and targets.",
+				Description: "RetryPolicy configures automatic retries of a failed object depending on the error codes of its last error. The waiting time before a retry grows exponentially with the number of retries.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"data": {
+					"retryOn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object imports.",
+							Description: "RetryOn lists the error codes for which a failed object is retried. If empty, a failed object is retried for all error codes which are not listed in NoRetryOn.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataImport"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"targets": {
+					"noRetryOn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target imports.",
+							Description: "NoRetryOn lists the error codes for which a failed object is never retried. It takes precedence over RetryOn.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetImport"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"maxAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAttempts is the maximal number of retries. If not set, no upper limit exists.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff configures the waiting time before a retry.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryBackoff"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.DataImport", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetImport"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.RetryBackoff"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RetryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationList contains a list of Components",
+				Description: "RetryStatus describes the retries of a failed object according to its retry policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"generation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Generation is the generation of the object for which the retries are counted.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"apiVersion": {
+					"attempts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Attempts is the number of retries which have been started.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"metadata": {
+					"lastAttemptTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "LastAttemptTime is the time when the last retry was started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"nextRetryTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Installation"),
-									},
-								},
-							},
+							Description: "NextRetryTime is the time when the next retry is started, if a retry is scheduled.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"attempts"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RolloutPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationSpec defines a component installation.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"context": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the installation.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"verification": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Verification defines the necessary data to verify the signature of the refered component",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Verification"),
-						},
-					},
-					"componentDescriptor": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
-						},
-					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the definition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
-						},
-					},
-					"imports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports"),
-						},
-					},
-					"importDataMappings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"exports": {
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutPolicy configures the progressive rollout of the deploy items and subinstallations of an installation which deploy to an element of a targetList or targetMap import. The elements are released in waves. A wave is only released when the objects of the previous waves have finished.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"batchSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports"),
+							Description: "BatchSize is the number of elements which are released in one wave.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"exportDataMappings": {
+					"batchPercentage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
+							Description: "BatchPercentage is the percentage of all elements which are released in one wave. The resulting number of elements is rounded up. Only one of BatchSize and BatchPercentage may be set. If none of them is set, all elements are released in one wave.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"automaticReconcile": {
+					"pauseBetweenWaves": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AutomaticReconcile"),
+							Description: "PauseBetweenWaves is the time to wait after a wave has finished before the next wave is released.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"optimization": {
+					"stopOnFailure": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization"),
+							Description: "StopOnFailure specifies that no further waves are released when an object of a released wave has failed. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.AutomaticReconcile", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Verification"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationStatus contains the current status of a Installation.",
+				Description: "RolloutStatus describes the progress of a rollout.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
+							Description: "JobID is the ID of the job the rollout belongs to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentWave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentWave is the number of the latest released wave, starting with 1.",
 							Default:     0,
 							Type:        []string{"integer"},
-							Format:      "int64",
+							Format:      "int32",
 						},
 					},
-					"conditions": {
+					"waves": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a installation",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition"),
-									},
-								},
-							},
+							Description: "Waves is the total number of waves.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"lastError": {
+					"nextWaveTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error"),
+							Description: "NextWaveTime is the time when the next wave is released, if the rollout is paused between two waves.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"subInstCache": {
+				},
+				Required: []string{"currentWave", "waves"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubInstCache"),
+							Description: "Selector is a map of labels to select specific secrets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"executionRef": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "The key of the secret to select from.  Must be a valid secret key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"jobID": {
+				},
+				Required: []string{"selector", "key"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDFinished": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstallationPhase is the current phase of the installation.",
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phaseTransitionTime": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataSource defines a static data source",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PhaseTransitionTime is the time when the phase last changed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
-					"importsHash": {
+					"valueFrom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportsHash is the hash of the import data.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
 						},
 					},
-					"automaticReconcileStatus": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus"),
+							Description: "Selects a key of a secret in the installations's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"dependentsToTrigger": {
+					"secretLabelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependentsToTrigger lists dependent installations to be triggered",
-							Type:        []string{"array"},
+							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubInstCache contains the existing sub installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"activeSubs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.DependentToTrigger"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubNamePair"),
 									},
 								},
 							},
 						},
 					},
-					"transitionTimes": {
+					"orphanedSubs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Error", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubInstCache", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubNamePair"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"specName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"objectName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubinstallationTemplate defines a subinstallation template.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File references a subinstallation template stored in another file.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
@@ -9482,609 +14125,457 @@ func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.Refere
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization"),
 						},
 					},
-				},
-				Required: []string{"name", "blueprint"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"filesystem": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JSONSchemaDefinition defines a jsonschema.",
-				Type:        v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaType(),
-				Format:      v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LocalConfigMapReference is a reference to data in a configmap.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the configmap",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"key": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LocalSecretReference is a reference to data in a secret.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the secret",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"key": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the overall status of the landscaper and its standard deployers.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"lastUpdateTime": {
+					"rollout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the check was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Rollout configures the progressive rollout of the deploy items and subinstallations of the installation which deploy to an element of a targetList or targetMap import.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutPolicy"),
 						},
 					},
-					"description": {
+					"dependsOn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains description of the problem(s)",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DependsOn lists the names of sibling installation templates which have to be finished successfully before this subinstallation is reconciled, additionally to the dependencies defined by the imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"status", "lastUpdateTime", "description"},
+				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.RolloutPolicy"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SubtreeSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Description: "SubtreeSummary aggregates the status of the objects in the subtree of an installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"installations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Installations counts the subinstallations in the subtree by phase.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"executions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Executions counts the executions in the subtree by phase.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "DeployItems counts the deploy items in the subtree by phase.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"failedObjects": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "FailedObjects lists the failed objects in the subtree whose failure is not caused by failed children, i.e. the objects where the failures originate.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LsHealthCheck"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.FailedObject"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NamedObjectReference is a named reference to a specific resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"finishedObjects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FinishedObjects is the number of objects in the subtree which have finished the current job.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"ref": {
+					"totalObjects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "TotalObjects is the number of objects in the subtree.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress is the percentage of the objects in the subtree which have finished the current job.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
+				Required: []string{"finishedObjects", "totalObjects", "progress"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.FailedObject"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ObjectReference is the reference to a kubernetes object.",
+				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"namespace": {
+					"cronSpec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Description: "The SyncObject helps to sync access to deploy items.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"skipUninstallIfClusterRemoved": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Optimization contains settings to improve execution preformance",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"hasNoSiblingImports": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hasNoSiblingExports": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObjectSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObjectStatus"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObjectSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Description: "SyncObjectList contains a list of SyncObject objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resourceName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"resourceName"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"key": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"operator": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"values": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObject"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"key", "operator"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Description: "SyncObjectSpec contains the specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"target": {
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target contains the original target.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Target"),
+							Description: "PodName describes the name of the pod of the responsible deployer",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"content": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Description: "Kind describes the kind of object that is being locked by this SyncObject",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"target", "content"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Target"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"componentName": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
+							Description: "Name is the name of the object that is being locked by this SyncObject",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
+							Description: "LastUpdateTime contains last time the object was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the prefix of the name of the object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"componentName", "resourceName"},
+				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Description: "SyncObjectStatus contains the status.",
 				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"selector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a map of labels to select specific secrets.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"key": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The key of the secret to select from.  Must be a valid secret key.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"selector", "key"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataSource defines a static data source",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"value": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSpec"),
 						},
 					},
-					"valueFrom": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the observed state of the target.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetStatus"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Description: "TargetExport is a single target export.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Name the internal name of the exported target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secretLabelSelector": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef"),
+							Description: "Target is the name of the in-cluster target object.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubInstCache contains the existing sub installations",
+				Description: "TargetImport is either a single target or a target list import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"activeSubs": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Name the internal name of the imported target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubNamePair"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"orphanedSubs": {
+					"targetListRef": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetMap": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -10095,53 +14586,26 @@ func schema_landscaper_apis_core_v1alpha1_SubInstCache(ref common.ReferenceCallb
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SubNamePair"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"specName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"objectName": {
+					"targetMapRef": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Description: "TargetList contains a list of Targets",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"file": {
-						SchemaProps: spec.SchemaProps{
-							Description: "File references a subinstallation template stored in another file.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
@@ -10156,275 +14620,257 @@ func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.Ref
 							Format:      "",
 						},
 					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
-						},
-					},
-					"imports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports"),
-						},
-					},
-					"importDataMappings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"exports": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"exportDataMappings": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Target"),
 									},
 								},
 							},
 						},
 					},
-					"optimization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization"),
-						},
-					},
 				},
-				Required: []string{"name", "blueprint"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Optimization"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Description: "TargetSelector describes a selector that matches specific targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"interval": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
 						},
 					},
-					"cronSpec": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Annotations matches a target based on annotations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Requirement"),
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels matches a target based on its labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Requirement"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Requirement"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The SyncObject helps to sync access to deploy items.",
+				Description: "TargetSpec contains the definition of a target.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObjectSpec"),
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
-					"status": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObjectStatus"),
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObjectSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectList contains a list of SyncObject objects",
+				Description: "TargetStatus contains the observed state of a target. The status is only maintained for targets of type kubernetes-cluster which are periodically probed by the landscaper.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation of the target that has been probed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"apiVersion": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Conditions contains the last observed conditions of the target.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"serverVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerVersion is the version of the api server of the target cluster observed by the last successful probe.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"credentialsExpirationTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "CredentialsExpirationTime is the time when the client certificate or token of the target's kubeconfig expires. It is not set if the credentials do not expire or their expiration time cannot be determined.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"lastProbeTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObject"),
-									},
-								},
-							},
+							Description: "LastProbeTime is the time when the target was probed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectSpec contains the specification.",
+				Description: "The TargetSync is created targets from secrets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName describes the name of the pod of the responsible deployer",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind describes the kind of object that is being locked by this SyncObject",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the object that is being locked by this SyncObject",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"lastUpdateTime": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the object was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSpec"),
 						},
 					},
-					"prefix": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prefix is the prefix of the name of the object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncStatus"),
 						},
 					},
 				},
-				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncClusterAPISource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectStatus contains the status.",
+				Description: "TargetSyncClusterAPISource defines the sync of Cluster API clusters.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterNameExpression defines the names of the Cluster API clusters for which targets are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. If not set, targets are created for all clusters.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
+				Description: "TargetSyncList contains a list of TargetSync objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10444,78 +14890,79 @@ func schema_landscaper_apis_core_v1alpha1_Target(ref common.ReferenceCallback) c
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"spec": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSpec"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSync"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncSecretSelectorSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetExport is a single target export.",
+				Description: "TargetSyncSecretSelectorSource defines the sync of secrets selected by labels.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the exported target.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LabelSelector selects the secrets in the source namespace which are synced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"target": {
+					"kubeconfigKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object.",
+							Description: "KubeconfigKey is the key in the data of the selected secrets which contains the kubeconfig. Defaults to \"kubeconfig\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"labelSelector"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncSourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetImport is either a single target or a target list import.",
+				Description: "TargetSyncSourceStatus contains the result of the last sync of a source of a TargetSync.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported target.",
+							Description: "Type is the type of the source.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"targets": {
+					"createdTargets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "CreatedTargets contains the names of the targets which were created by the last sync.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -10528,18 +14975,26 @@ func schema_landscaper_apis_core_v1alpha1_TargetImport(ref common.ReferenceCallb
 							},
 						},
 					},
-					"targetListRef": {
+					"updatedTargets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "UpdatedTargets contains the names of the targets which were updated by the last sync.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"targetMap": {
+					"removedTargets": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "RemovedTargets contains the names of the targets which were removed by the last sync.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -10550,112 +15005,158 @@ func schema_landscaper_apis_core_v1alpha1_TargetImport(ref common.ReferenceCallb
 							},
 						},
 					},
-					"targetMapRef": {
+					"conflictingTargets": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ConflictingTargets contains the names of the targets which were not synced from this source, because another source of the TargetSync produces a target with the same name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"type"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetList contains a list of Targets",
+				Description: "TargetSyncSpec contains the specification for a TargetSync.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"sourceNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+					"createTargetToSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"targetToSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"secretNameExpression": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"shootNameExpression": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Target"),
-									},
-								},
-							},
+							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterAPI": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterAPI defines that targets are created for the Cluster API clusters in the source namespace. The kubeconfigs of the clusters are taken from the secrets \"<cluster name>-kubeconfig\" maintained by Cluster API. if not set no targets for Cluster API clusters are created",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncClusterAPISource"),
+						},
+					},
+					"secretSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretSelector defines that targets are created for the secrets in the source namespace which match a label selector. This allows to sync the kubeconfigs of cluster managers which publish them in their own secret formats. if not set no secrets are selected by labels",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSecretSelectorSource"),
+						},
+					},
+					"tokenRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TokenRotation"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"sourceNamespace", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncClusterAPISource", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSecretSelectorSource", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TokenRotation"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSelector describes a selector that matches specific targets.",
+				Description: "TargetSyncStatus contains the status of a TargetSync.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"targets": {
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
-									},
-								},
-							},
+							Description: "Last time the status was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"annotations": {
+					"lastErrors": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations matches a target based on annotations.",
+							Description: "LastErrors describe the last errors",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Requirement"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"labels": {
+					"lastTokenRotationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels matches a target based on its labels.",
+							Description: "Last time the token was rotated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"sources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sources contains the result of the last sync for every source of the TargetSync.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.Requirement"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSourceStatus"),
 									},
 								},
 							},
@@ -10665,15 +15166,15 @@ func schema_landscaper_apis_core_v1alpha1_TargetSelector(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.Requirement"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSpec contains the definition of a target.",
+				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
@@ -10696,6 +15197,38 @@ func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallbac
 							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
@@ -10705,11 +15238,11 @@ func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallbac
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetTypeDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The TargetSync is created targets from secrets.",
+				Description: "TargetTypeDefinition registers a target type in the landscaper cluster. It declares the schema of the configuration of all targets of the type.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10734,16 +15267,9 @@ func schema_landscaper_apis_core_v1alpha1_TargetSync(ref common.ReferenceCallbac
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
+							Description: "Spec contains the specification of the target type.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncStatus"),
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetTypeDefinitionSpec"),
 						},
 					},
 				},
@@ -10751,15 +15277,15 @@ func schema_landscaper_apis_core_v1alpha1_TargetSync(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncSpec", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetTypeDefinitionSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetTypeDefinitionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncList contains a list of TargetSync objects",
+				Description: "TargetTypeDefinitionList contains a list of TargetTypeDefinitions",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10789,7 +15315,7 @@ func schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSync"),
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetTypeDefinition"),
 									},
 								},
 							},
@@ -10800,237 +15326,211 @@ func schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TargetTypeDefinition", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetTypeDefinitionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Description: "TargetTypeDefinitionSpec contains the specification of a target type.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sourceNamespace": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
+							Description: "Type is the registered target type, e.g. \"landscaper.gardener.cloud/kubernetes-cluster\". A target type must not be registered by more than one TargetTypeDefinition.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"),
-						},
-					},
-					"createTargetToSource": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Schema is the json schema that the configuration of targets of this type has to satisfy. The schema is applied to the inline configuration of a target as well as to the content of the referenced secret.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
 						},
 					},
-					"targetToSourceName": {
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateExecutor describes a templating mechanism and configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
+							Description: "Name is the unique name of the template",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretNameExpression": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
+							Description: "Type describes the templating mechanism.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"shootNameExpression": {
+					"file": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
-							Default:     "",
+							Description: "File is the path to the template in the blueprint's content.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tokenRotation": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TokenRotation"),
+							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
 				},
-				Required: []string{"sourceNamespace", "secretRef"},
+				Required: []string{"name", "type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.TokenRotation"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TestResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncStatus contains the status of a TargetSync.",
+				Description: "TestResult describes the result of a test deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Name is the name of the test deploy item as defined by the testExecutions of the blueprint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
+					"deployItem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the status was updated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "DeployItem is the reference to the test deploy item.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"lastErrors": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastErrors describe the last errors",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Phase is the phase of the test deploy item.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastTokenRotationTime": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the token was rotated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Message describes the error of a failed test.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
+				Description: "TestStatus describes the latest run of the tests of an installation, i.e. of the deploy items which are defined by the testExecutions of its blueprint.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Description: "JobID is the ID of the job of the test execution in which the tests have run.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Phase is the phase of the test execution.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secretRef": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Description: "StartTime is the time when the tests have been started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"labels": {
+					"completionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "CompletionTime is the time when the tests have finished.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"annotations": {
+					"results": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Results lists the results of the single test deploy items.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.TestResult"),
 									},
 								},
-							},
-						},
-					},
-				},
-				Required: []string{"type"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.LocalSecretReference"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TemplateExecutor describes a templating mechanism and configuration.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the template",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type describes the templating mechanism.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							},
 						},
 					},
-					"file": {
+				},
+				Required: []string{"jobID"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.TestResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TimeoutDetails(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TimeoutDetails describes the exceeded timeout of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deadline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File is the path to the template in the blueprint's content.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Deadline is the time when the timeout was exceeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"template": {
+					"slowestChild": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
-							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "SlowestChild is the subinstallation or execution, which had been incomplete for the longest time when the timeout was exceeded.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.IncompleteChild"),
 						},
 					},
 				},
-				Required: []string{"name", "type"},
+				Required: []string{"deadline"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.AnyJSON"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.IncompleteChild", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -12072,6 +16572,13 @@ func schema_apis_deployer_container_v1alpha1_Controller(ref common.ReferenceCall
 							Ref:     ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
+					"maxConcurrentDeployItemsPerTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrentDeployItemsPerTarget is the maximum number of deploy items with the same target which are processed concurrently by the deployer. Further deploy items are queued. If not set, the number is not limited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"CommonControllerConfig"},
 			},
@@ -13265,6 +17772,13 @@ func schema_apis_deployer_helm_v1alpha1_Controller(ref common.ReferenceCallback)
 							Ref:     ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
+					"maxConcurrentDeployItemsPerTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrentDeployItemsPerTarget is the maximum number of deploy items with the same target which are processed concurrently by the deployer. Further deploy items are queued. If not set, the number is not limited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"CommonControllerConfig"},
 			},
@@ -13505,6 +18019,98 @@ func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.Re
 	}
 }
 
+func schema_apis_deployer_helm_v1alpha1_PatchTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PatchTarget selects the rendered manifests to which a post-render patch is applied. All fields that are set must match; empty fields match all manifests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is the API group of the selected manifests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the API version of the selected manifests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the selected manifests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the selected manifests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the selected manifests. Manifests without namespace are considered to be in the release namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector is a label selector, e.g. \"app=nginx,tier!=frontend\", that the labels of the selected manifests must match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_PostRenderPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostRenderPatch defines a patch that is applied to the rendered manifests of a chart.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the patch. Supported values are \"json6902\", \"strategicMerge\" and \"merge\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target selects the rendered manifests to which the patch is applied. The patch is applied to all rendered manifests if no target is given.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.PatchTarget"),
+						},
+					},
+					"patch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patch is the patch document. A json6902 patch is a list of operations, a strategic merge patch and a merge patch are (partial) objects.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+				},
+				Required: []string{"type", "patch"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.PatchTarget"},
+	}
+}
+
 func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13578,6 +18184,20 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Format:      "byte",
 						},
 					},
+					"valuesFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuesFrom references values in Secrets and ConfigMaps, e.g. values containing credentials which should not be part of the DeployItem. Each referenced key has to contain a yaml document with values. The values are merged in the given order, and the inline Values are merged on top of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.DataReference"),
+									},
+								},
+							},
+						},
+					},
 					"exportsFromManifests": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
@@ -13645,12 +18265,40 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							},
 						},
 					},
+					"takeoverPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TakeoverPolicy defines whether objects which are owned by another deploy item are taken over. Defaults to \"never\", i.e. an object owned by another deploy item is not updated and an ownership conflict is reported. Only relevant if HelmDeployment is false.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"postRenderPatches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostRenderPatches are patches that are applied to the rendered manifests of the chart, similar to a helm post-renderer. The patches are applied in the given order, both for a helm deployment and for a manifest-only deployment.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.PostRenderPatch"),
+									},
+								},
+							},
+						},
+					},
+					"runTests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunTests defines whether the test hooks of the chart are run after the resources are deployed and ready. The DeployItem fails if a test fails.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"chart", "name", "namespace", "createNamespace"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/openmcp-project/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.Export", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/openmcp-project/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1.PostRenderPatch", "github.com/openmcp-project/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.DataReference", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.Export", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/openmcp-project/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
@@ -13689,11 +18337,24 @@ func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallb
 							},
 						},
 					},
+					"installed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installed is set when the chart of a manifest-only deployment has been applied successfully for the first time. Afterwards, the upgrade hooks are run instead of the install hooks.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"deleteHooksReference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeleteHooksReference references the secret which contains the pre-delete and post-delete hooks of a manifest-only deployment. The secret is owned by the deploy item. The hooks are run from there when the deploy item is deleted, so that the chart need not be templated again.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
 	}
 }
 
@@ -14159,6 +18820,13 @@ func schema_apis_deployer_manifest_v1alpha1_Controller(ref common.ReferenceCallb
 							Ref:     ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
+					"maxConcurrentDeployItemsPerTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrentDeployItemsPerTarget is the maximum number of deploy items with the same target which are processed concurrently by the deployer. Further deploy items are queued. If not set, the number is not limited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"CommonControllerConfig"},
 			},
@@ -14424,6 +19092,13 @@ func schema_apis_deployer_manifest_v1alpha2_Controller(ref common.ReferenceCallb
 							Ref:     ref("github.com/openmcp-project/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
+					"maxConcurrentDeployItemsPerTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrentDeployItemsPerTarget is the maximum number of deploy items with the same target which are processed concurrently by the deployer. Further deploy items are queued. If not set, the number is not limited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"CommonControllerConfig"},
 			},
@@ -14473,6 +19148,47 @@ func schema_apis_deployer_manifest_v1alpha2_HPAConfiguration(ref common.Referenc
 	}
 }
 
+func schema_apis_deployer_manifest_v1alpha2_ManifestsFromSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManifestsFromSource references manifests in a Secret or ConfigMap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references a key of a Secret.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SecretReference"),
+						},
+					},
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef references a key of a ConfigMap.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ConfigMapReference"),
+						},
+					},
+					"fromTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromTarget defines whether the Secret or ConfigMap is read from the target cluster. By default, it is read from the landscaper cluster, where it has to be in the namespace of the deploy item. In the target cluster, the namespace of the Secret or ConfigMap is required.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines the manage policy of the referenced manifests. Defaults to \"manage\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ConfigMapReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SecretReference"},
+	}
+}
+
 func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14522,6 +19238,20 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 							},
 						},
 					},
+					"manifestsFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManifestsFrom references manifests in Secrets and ConfigMaps, e.g. Secrets which should not be part of the DeployItem. Each referenced key can contain several yaml documents. The manifests are applied together with the inline Manifests.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2.ManifestsFromSource"),
+									},
+								},
+							},
+						},
+					},
 					"exports": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
@@ -14562,11 +19292,18 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 							},
 						},
 					},
+					"takeoverPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TakeoverPolicy defines whether objects which are owned by another deploy item are taken over. Defaults to \"never\", i.e. an object owned by another deploy item is not updated and an ownership conflict is reported.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/openmcp-project/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/openmcp-project/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2.ManifestsFromSource", "github.com/openmcp-project/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/openmcp-project/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
@@ -14910,6 +19647,40 @@ func schema_apis_deployer_utils_managedresource_CustomResourceGroup(ref common.R
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_DataReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataReference references the data in a key of a Secret or a ConfigMap. It allows to keep data like credentials out of the configuration of a deploy item, as the data is only read by the deployer right before it is used. Exactly one of SecretRef and ConfigMapRef has to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references a key of a Secret.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.SecretReference"),
+						},
+					},
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef references a key of a ConfigMap.",
+							Ref:         ref("github.com/openmcp-project/landscaper/apis/core/v1alpha1.ConfigMapReference"),
+						},
+					},
+					"fromTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromTarget defines whether the Secret or ConfigMap is read from the target cluster. By default, it is read from the landscaper cluster, where it has to be in the namespace of the deploy item. In the target cluster, the namespace of the Secret or ConfigMap is required.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/openmcp-project/landscaper/apis/core/v1alpha1.ConfigMapReference", "github.com/openmcp-project/landscaper/apis/core/v1alpha1.SecretReference"},
+	}
+}
+
 func schema_apis_deployer_utils_managedresource_DeletionGroupDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -15094,12 +19865,39 @@ func schema_apis_deployer_utils_managedresource_ManagedResourceStatus(ref common
 							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
+					"applyState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApplyState is the result of the last attempt to apply the resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"readinessState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadinessState is the result of the readiness checks of the resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the apply state or the readiness state changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why the resource could not be applied or is not ready.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"resource"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
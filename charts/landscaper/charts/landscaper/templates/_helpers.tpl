@@ -170,6 +170,11 @@ deployItemTimeouts:
   {{- end }}
 {{- end }}
 
+{{- if .Values.landscaper.deletionSafeguard }}
+deletionSafeguard:
+{{ toYaml .Values.landscaper.deletionSafeguard | indent 2 }}
+{{- end }}
+
 lsDeployments:
   lsController: "{{- include "landscaper.fullname" . }}"
   lsMainController: "{{- include "landscaper.main.fullname" . }}"
@@ -32,6 +32,9 @@ import (
 	executionactrl "github.com/openmcp-project/landscaper/pkg/landscaper/controllers/execution"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/controllers/healthcheck"
 	installationsctrl "github.com/openmcp-project/landscaper/pkg/landscaper/controllers/installations"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/controllers/installationset"
+	targetctrl "github.com/openmcp-project/landscaper/pkg/landscaper/controllers/target"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/controllers/targetchange"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/controllers/targetsync"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/crdmanager"
 	"github.com/openmcp-project/landscaper/pkg/metrics"
@@ -166,6 +169,10 @@ func (o *Options) startMainController(ctx context.Context,
 		return fmt.Errorf("unable to setup execution controller: %w", err)
 	}
 
+	if err := installationset.AddControllerToManager(lsUncachedClient, lsCachedClient, ctrlLogger, lsMgr, o.Config.Controllers.Installations.CommonControllerConfig); err != nil {
+		return fmt.Errorf("unable to setup installation set controller: %w", err)
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	if os.Getenv("ENABLE_PROFILER") == "true" {
@@ -225,6 +232,14 @@ func (o *Options) startCentralLandscaper(ctx context.Context,
 		return fmt.Errorf("unable to register target sync controller: %w", err)
 	}
 
+	if err := targetctrl.AddControllerToManager(lsUncachedClient, lsCachedClient, ctrlLogger, lsMgr, o.Config.Controllers.Targets); err != nil {
+		return fmt.Errorf("unable to setup target controller: %w", err)
+	}
+
+	if err := targetchange.AddControllerToManager(lsUncachedClient, lsCachedClient, ctrlLogger, lsMgr, o.Config.Controllers.TargetChanges); err != nil {
+		return fmt.Errorf("unable to setup target change controller: %w", err)
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	if os.Getenv("ENABLE_PROFILER") == "true" {
@@ -25,6 +25,7 @@ import (
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
 	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
 	webhooklib "github.com/openmcp-project/landscaper/controller-utils/pkg/webhook"
+	webhook "github.com/openmcp-project/landscaper/pkg/utils/webhook"
 )
 
 func NewLandscaperWebhooksCommand(ctx context.Context) *cobra.Command {
@@ -78,6 +79,13 @@ func (o *options) run(ctx context.Context) error {
 		return fmt.Errorf("unable to get client: %w", err)
 	}
 
+	// targets and target type definitions are validated against the target types registered in the cluster
+	defaultWebhooks["targets"].Process = webhook.NewTargetWebhookLogic(kubeClient)
+	defaultWebhooks["targettypedefinitions"].Process = webhook.NewTargetTypeDefinitionWebhookLogic(kubeClient)
+	// targets in use by deploy items and imported data objects are protected against deletion
+	defaultWebhooks["targets-deletion-protection"].Process = webhook.NewTargetDeletionProtectionWebhookLogic(kubeClient)
+	defaultWebhooks["dataobjects-deletion-protection"].Process = webhook.NewDataObjectDeletionProtectionWebhookLogic(kubeClient)
+
 	if err := webhooklib.ApplyWebhooks(ctx, &webhooklib.ApplyWebhooksOptions{
 		NameValidating: &webhooklib.WebhookNaming{
 			Name:          "landscaper-validation-webhook",
@@ -39,6 +39,16 @@ var defaultWebhooks = webhooklib.NewWebhookRegistry().
 		LabelSelector: landscaperSkipValidationSelector,
 		Process:       webhook.InstallationWebhookLogic,
 	}).
+	Register(&webhooklib.Webhook{
+		Name:          "installationsets",
+		Type:          webhooklib.ValidatingWebhook,
+		APIGroup:      core.GroupName,
+		APIVersions:   []string{"v1alpha1"},
+		ResourceName:  "installationsets",
+		Operations:    webhooklib.Operations(webhooklib.CREATE, webhooklib.UPDATE),
+		LabelSelector: landscaperSkipValidationSelector,
+		Process:       webhook.InstallationSetWebhookLogic,
+	}).
 	Register(&webhooklib.Webhook{
 		Name:          "deployitems",
 		Type:          webhooklib.ValidatingWebhook,
@@ -68,6 +78,43 @@ var defaultWebhooks = webhooklib.NewWebhookRegistry().
 		Operations:    webhooklib.Operations(webhooklib.CREATE, webhooklib.UPDATE),
 		LabelSelector: landscaperSkipValidationSelector,
 		Process:       webhook.TargetWebhookLogic,
+	}).
+	Register(&webhooklib.Webhook{
+		Name:          "targettypedefinitions",
+		Type:          webhooklib.ValidatingWebhook,
+		APIGroup:      core.GroupName,
+		APIVersions:   []string{"v1alpha1"},
+		ResourceName:  "targettypedefinitions",
+		Operations:    webhooklib.Operations(webhooklib.CREATE, webhooklib.UPDATE),
+		LabelSelector: landscaperSkipValidationSelector,
+		Process:       webhook.TargetTypeDefinitionWebhookLogic,
+	}).
+	Register(&webhooklib.Webhook{
+		Name:         "installations-deletion-protection",
+		Type:         webhooklib.ValidatingWebhook,
+		APIGroup:     core.GroupName,
+		APIVersions:  []string{"v1alpha1"},
+		ResourceName: "installations",
+		Operations:   webhooklib.Operations(webhooklib.UPDATE, webhooklib.DELETE),
+		Process:      webhook.InstallationDeletionProtectionWebhookLogic,
+	}).
+	Register(&webhooklib.Webhook{
+		Name:         "targets-deletion-protection",
+		Type:         webhooklib.ValidatingWebhook,
+		APIGroup:     core.GroupName,
+		APIVersions:  []string{"v1alpha1"},
+		ResourceName: "targets",
+		Operations:   webhooklib.Operations(webhooklib.DELETE),
+		Process:      webhook.TargetDeletionProtectionWebhookLogic,
+	}).
+	Register(&webhooklib.Webhook{
+		Name:         "dataobjects-deletion-protection",
+		Type:         webhooklib.ValidatingWebhook,
+		APIGroup:     core.GroupName,
+		APIVersions:  []string{"v1alpha1"},
+		ResourceName: "dataobjects",
+		Operations:   webhooklib.Operations(webhooklib.DELETE),
+		Process:      webhook.DataObjectDeletionProtectionWebhookLogic,
 	})
 
 type options struct {
@@ -63,6 +63,8 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient
 			Deployer:        containerDeployer,
 			TargetSelectors: config.TargetSelector,
 			Options:         options,
+
+			MaxConcurrentDeployItemsPerTarget: config.Controller.MaxConcurrentDeployItemsPerTarget,
 		}, config.Controller.Workers, lockingEnabled, callerName, controllerName)
 	if err != nil {
 		return nil, err
@@ -62,5 +62,7 @@ func AddDeployerToManager(
 			Deployer:        d,
 			TargetSelectors: config.TargetSelector,
 			Options:         options,
+
+			MaxConcurrentDeployItemsPerTarget: config.Controller.MaxConcurrentDeployItemsPerTarget,
 		}, config.Controller.Workers, lockingEnabled, callerName, controllerName)
 }
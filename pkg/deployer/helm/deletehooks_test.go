@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	helmr "helm.sh/helm/v4/pkg/release/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/api"
+)
+
+var _ = Describe("Delete hooks", func() {
+
+	var (
+		ctx      context.Context
+		lsClient client.Client
+		h        *Helm
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+
+		item := &lsv1alpha1.DeployItem{}
+		item.Name = "myitem"
+		item.Namespace = "default"
+		item.UID = "myitem-uid"
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(item).Build()
+
+		h = &Helm{
+			lsUncachedClient: lsClient,
+			DeployItem:       item,
+			ProviderStatus:   &helmv1alpha1.ProviderStatus{},
+		}
+	})
+
+	newHooks := func(events ...string) Hooks {
+		objects := []*runtime.RawExtension{}
+		for _, event := range events {
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion("batch/v1")
+			obj.SetKind("Job")
+			obj.SetName(event)
+			obj.SetAnnotations(map[string]string{helmr.HookAnnotation: event})
+			raw, err := json.Marshal(obj.Object)
+			Expect(err).ToNot(HaveOccurred())
+			objects = append(objects, &runtime.RawExtension{Raw: raw})
+		}
+		_, hooks, err := SplitHooks(objects)
+		Expect(err).ToNot(HaveOccurred())
+		return hooks
+	}
+
+	It("should store the delete hooks in a secret owned by the deploy item", func() {
+		Expect(h.storeDeleteHooks(ctx, newHooks("pre-install", "pre-delete", "post-delete"))).To(Succeed())
+		Expect(h.ProviderStatus.DeleteHooksReference).ToNot(BeNil())
+
+		secret := &corev1.Secret{}
+		Expect(lsClient.Get(ctx, h.ProviderStatus.DeleteHooksReference.NamespacedName(), secret)).To(Succeed())
+		Expect(secret.Namespace).To(Equal(h.DeployItem.Namespace))
+		Expect(secret.OwnerReferences).To(HaveLen(1))
+		Expect(secret.OwnerReferences[0].UID).To(Equal(h.DeployItem.UID))
+
+		hooks, err := h.deleteHooks(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hooks).To(HaveLen(2))
+		Expect(hooks.ForEvent(helmr.HookPreDelete)).To(HaveLen(1))
+		Expect(hooks.ForEvent(helmr.HookPostDelete)).To(HaveLen(1))
+	})
+
+	It("should remove the secret if the chart has no delete hooks anymore", func() {
+		Expect(h.storeDeleteHooks(ctx, newHooks("pre-delete"))).To(Succeed())
+		ref := h.ProviderStatus.DeleteHooksReference
+		Expect(ref).ToNot(BeNil())
+
+		Expect(h.storeDeleteHooks(ctx, newHooks("pre-install"))).To(Succeed())
+		Expect(h.ProviderStatus.DeleteHooksReference).To(BeNil())
+
+		err := lsClient.Get(ctx, ref.NamespacedName(), &corev1.Secret{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		hooks, err := h.deleteHooks(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hooks).To(BeEmpty())
+	})
+
+	It("should not run delete hooks whose secret is gone", func() {
+		h.ProviderStatus.DeleteHooksReference = &lsv1alpha1.ObjectReference{Name: "missing", Namespace: "default"}
+
+		hooks, err := h.deleteHooks(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hooks).To(BeEmpty())
+	})
+})
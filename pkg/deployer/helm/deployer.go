@@ -14,8 +14,10 @@ import (
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1"
 	crval "github.com/openmcp-project/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
 	lserrors "github.com/openmcp-project/landscaper/apis/errors"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/api"
 	deployerlib "github.com/openmcp-project/landscaper/pkg/deployer/lib"
 	cr "github.com/openmcp-project/landscaper/pkg/deployer/lib/continuousreconcile"
 	"github.com/openmcp-project/landscaper/pkg/deployer/lib/extension"
@@ -34,6 +36,8 @@ const (
 	TimeoutCheckpointHelmStartCreateManifests      = "helm deployer: start create manifests"
 	TimeoutCheckpointHelmDefaultReadinessChecks    = "helm deployer: default readiness checks"
 	TimeoutCheckpointHelmCustomReadinessChecks     = "helm deployer: custom readiness checks"
+	TimeoutCheckpointHelmRunHooks                  = "helm deployer: run hooks"
+	TimeoutCheckpointHelmRunTests                  = "helm deployer: run tests"
 )
 
 // NewDeployer creates a new deployer that reconciles deploy items of type helm.
@@ -126,6 +130,32 @@ func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv
 	return nil
 }
 
+// ReferencedDataHash returns the hash of the values referenced by valuesFrom.
+func (d *deployer) ReferencedDataHash(ctx context.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) (string, error) {
+	helm, err := New(d.lsUncachedClient, d.lsCachedClient, d.hostUncachedClient, d.hostCachedClient, d.lsRestConfig, d.config, di, rt, nil)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := helm.dataReferenceResolver().Resolve(ctx, helm.ProviderConfiguration.ValuesFrom)
+	if err != nil {
+		return "", err
+	}
+	return deployerlib.DataHash(data), nil
+}
+
+// DataReferences returns the references of valuesFrom.
+func (d *deployer) DataReferences(di *lsv1alpha1.DeployItem) ([]managedresource.DataReference, error) {
+	if di.Spec.Configuration == nil {
+		return nil, nil
+	}
+	config := &helmv1alpha1.ProviderConfiguration{}
+	if _, _, err := api.NewDecoder(HelmScheme).Decode(di.Spec.Configuration.Raw, nil, config); err != nil {
+		return nil, err
+	}
+	return config.ValuesFrom, nil
+}
+
 func (d *deployer) ExtensionHooks() extension.ReconcileExtensionHooks {
 	return d.hooks
 }
@@ -6,11 +6,15 @@ package helm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	chart "helm.sh/helm/v4/pkg/chart/v2"
+	helmr "helm.sh/helm/v4/pkg/release/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
@@ -25,6 +29,8 @@ import (
 	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
 	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/openmcp-project/landscaper/pkg/api"
+	"github.com/openmcp-project/landscaper/pkg/deployer/helm/postrender"
 	"github.com/openmcp-project/landscaper/pkg/deployer/helm/realhelmdeployer"
 	deployerlib "github.com/openmcp-project/landscaper/pkg/deployer/lib"
 	"github.com/openmcp-project/landscaper/pkg/deployer/lib/interruption"
@@ -57,11 +63,20 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 		}
 	}
 
-	var deployErr error
+	var (
+		deployErr error
+		hooks     Hooks
+		postEvent helmr.HookEvent
+	)
 
 	shouldUseRealHelmDeployer := ptr.Deref[bool](h.ProviderConfiguration.HelmDeployment, true)
 
 	if shouldUseRealHelmDeployer {
+		// helm runs the delete hooks itself
+		if err := h.removeDeleteHooks(ctx); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "RemoveDeleteHooks", err.Error())
+		}
+
 		// Apply helm install/upgrade. Afterwards get the list of deployed resources by helm get release.
 		// The list is filtered, i.e. it contains only the resources that are needed for the default readiness check.
 		realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration, h.targetAccess, h.DeployItem)
@@ -71,16 +86,45 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 			if err != nil {
 				return err
 			}
+			resourcemanager.SetApplyStates(managedResourceStatusList, h.ProviderStatus.ManagedResources)
 			h.ProviderStatus.ManagedResources = managedResourceStatusList
+
+			// the hooks of the release are only needed to run the tests, as the other hooks are run by helm
+			if h.ProviderConfiguration.RunTests {
+				hooks, err = h.getReleaseHooks(ctx, realHelmDeployer)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
 	} else {
-		manifests, err := h.createManifests(ctx, currOp, filesForManifestDeployer, crdsForManifestDeployer)
+		var manifests []managedresource.Manifest
+		var err error
+		manifests, hooks, err = h.createManifests(ctx, currOp, filesForManifestDeployer, crdsForManifestDeployer)
 		if err != nil {
 			return err
 		}
 
+		// remember the delete hooks, as the chart is not templated again when the deploy item is deleted
+		if err := h.storeDeleteHooks(ctx, hooks); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "StoreDeleteHooks", err.Error())
+		}
+
+		// the release is installed if the chart has not been applied successfully so far, otherwise it is upgraded
+		preEvent := helmr.HookPreUpgrade
+		postEvent = helmr.HookPostUpgrade
+		if !h.ProviderStatus.Installed {
+			preEvent, postEvent = helmr.HookPreInstall, helmr.HookPostInstall
+		}
+		if err := h.runHooks(ctx, hooks, preEvent); err != nil {
+			return err
+		}
+
 		deployErr = h.applyManifests(ctx, manifests)
+		if deployErr == nil {
+			h.ProviderStatus.Installed = true
+		}
 	}
 
 	// common error handling for deploy errors (h.applyManifests / realHelmDeployer.Deploy)
@@ -107,7 +151,24 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 		return err
 	}
 
-	if err := h.checkResourcesReady(ctx, h.targetAccess.TargetClient(), !shouldUseRealHelmDeployer); err != nil {
+	readinessErr := h.checkResourcesReady(ctx, h.targetAccess.TargetClient(), !shouldUseRealHelmDeployer)
+	if readinessErr == nil {
+		health.SetManagedResourcesReady(h.ProviderStatus.ManagedResources)
+	}
+	// update the provider status, as it contains the readiness of the managed resources
+	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+	if readinessErr != nil {
+		return readinessErr
+	}
+
+	if err := h.runHooks(ctx, hooks, postEvent); err != nil {
+		return err
+	}
+
+	if err := h.runTests(ctx, hooks); err != nil {
 		return err
 	}
 
@@ -145,6 +206,7 @@ func (h *Helm) applyManifests(ctx context.Context, manifests []managedresource.M
 		},
 		DeletionGroupsDuringUpdate: h.ProviderConfiguration.DeletionGroupsDuringUpdate,
 		InterruptionChecker:        interruption.NewStandardInterruptionChecker(h.DeployItem, h.lsUncachedClient),
+		TakeoverPolicy:             h.ProviderConfiguration.TakeoverPolicy,
 		LsUncachedClient:           h.lsUncachedClient,
 		LsRestConfig:               h.lsRestConfig,
 	})
@@ -155,27 +217,23 @@ func (h *Helm) applyManifests(ctx context.Context, manifests []managedresource.M
 	return err
 }
 
-func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds map[string]string) ([]managedresource.Manifest, error) {
+// createManifests creates the manifests for the applier from the templated files of the chart.
+// The helm hooks of the chart are returned separately, as they are not managed by the applier.
+func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds map[string]string) ([]managedresource.Manifest, Hooks, error) {
 	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "createManifests"})
 
 	if _, err := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHelmStartCreateManifests); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	objects, err := kutil.ParseFilesToRawExtension(logger, files)
+	objects, hooks, err := h.decodeObjects(ctx, currOp, files)
 	if err != nil {
-		return nil, lserrors.NewWrappedError(err,
-			currOp, "DecodeHelmTemplatedObjects", err.Error())
-	}
-
-	objects, err = deployerlib.ExpandManifests(objects)
-	if err != nil {
-		return nil, lserrors.NewWrappedError(err, currOp, "ExpandManifests", err.Error())
+		return nil, nil, err
 	}
 
 	crdObjects, err := kutil.ParseFilesToRawExtension(logger, crds)
 	if err != nil {
-		return nil, lserrors.NewWrappedError(err,
+		return nil, nil, lserrors.NewWrappedError(err,
 			currOp, "DecodeHelmTemplatedObjects", err.Error())
 	}
 
@@ -201,7 +259,7 @@ func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds m
 		ns.Name = h.ProviderConfiguration.Namespace
 		rawNs, err := kutil.ConvertToRawExtension(ns, scheme.Scheme)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal release namespace: %w", err)
+			return nil, nil, fmt.Errorf("unable to marshal release namespace: %w", err)
 		}
 		nsManifest := managedresource.Manifest{
 			Policy:   managedresource.KeepPolicy,
@@ -211,7 +269,35 @@ func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds m
 		manifests = append(manifests, nsManifest)
 	}
 
-	return manifests, nil
+	return manifests, hooks, nil
+}
+
+// decodeObjects decodes the templated files of the chart, applies the post-render patches,
+// and separates the helm hooks from the other objects.
+func (h *Helm) decodeObjects(ctx context.Context, currOp string, files map[string]string) ([]*runtime.RawExtension, Hooks, error) {
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+
+	objects, err := kutil.ParseFilesToRawExtension(logger, files)
+	if err != nil {
+		return nil, nil, lserrors.NewWrappedError(err,
+			currOp, "DecodeHelmTemplatedObjects", err.Error())
+	}
+
+	objects, err = deployerlib.ExpandManifests(objects)
+	if err != nil {
+		return nil, nil, lserrors.NewWrappedError(err, currOp, "ExpandManifests", err.Error())
+	}
+
+	objects, err = postrender.New(h.ProviderConfiguration.PostRenderPatches, h.ProviderConfiguration.Namespace).PatchObjects(objects)
+	if err != nil {
+		return nil, nil, lserrors.NewWrappedError(err, currOp, "ApplyPostRenderPatches", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	objects, hooks, err := SplitHooks(objects)
+	if err != nil {
+		return nil, nil, lserrors.NewWrappedError(err, currOp, "SplitHooks", err.Error())
+	}
+	return objects, hooks, nil
 }
 
 // checkResourcesReady checks if the managed resources are Ready/Healthy.
@@ -231,6 +317,7 @@ func (h *Helm) checkResourcesReady(ctx context.Context, client client.Client, fa
 			ManagedResources:    h.ProviderStatus.ManagedResources.TypedObjectReferenceList(),
 			FailOnMissingObject: failOnMissingObject,
 			InterruptionChecker: interruption.NewStandardInterruptionChecker(h.DeployItem, h.lsUncachedClient),
+			ReportObjectStatus:  health.ReportToManagedResources(h.ProviderStatus.ManagedResources),
 		}
 		err := defaultReadinessCheck.CheckResourcesReady()
 		if err != nil {
@@ -254,6 +341,7 @@ func (h *Helm) checkResourcesReady(ctx context.Context, client client.Client, fa
 				LsClient:            h.lsUncachedClient,
 				DeployItem:          h.DeployItem,
 				LsRestConfig:        h.lsRestConfig,
+				ReportObjectStatus:  health.ReportToManagedResources(h.ProviderStatus.ManagedResources),
 			}
 			err := customReadinessCheck.CheckResourcesReady(ctx)
 			if err != nil {
@@ -312,7 +400,7 @@ func (h *Helm) deleteManifestsInGroups(ctx context.Context) error {
 
 	h.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Deleting
 
-	if h.ProviderStatus == nil || len(h.ProviderStatus.ManagedResources) == 0 {
+	if h.ProviderStatus == nil || (len(h.ProviderStatus.ManagedResources) == 0 && h.ProviderStatus.DeleteHooksReference == nil) {
 		controllerutil.RemoveFinalizer(h.DeployItem, lsv1alpha1.LandscaperFinalizer)
 		return h.Writer().UpdateDeployItem(ctx, read_write_layer.W000067, h.DeployItem)
 	}
@@ -321,12 +409,33 @@ func (h *Helm) deleteManifestsInGroups(ctx context.Context) error {
 		return err
 	}
 
+	// objects which have been taken over by another deploy item are not deleted
+	managedResources := []managedresource.ManagedResourceStatus{}
+	for i := range h.ProviderStatus.ManagedResources {
+		mr := &h.ProviderStatus.ManagedResources[i]
+		ok, err := resourcemanager.FilterByPolicy(ctx, mr, h.targetAccess.TargetClient(), h.DeployItem.Name, h.DeployItem)
+		if err != nil {
+			return err
+		}
+		if ok {
+			managedResources = append(managedResources, *mr)
+		}
+	}
+
+	hooks, err := h.deleteHooks(ctx)
+	if err != nil {
+		return err
+	}
+	if err := h.runHooks(ctx, hooks, helmr.HookPreDelete); err != nil {
+		return err
+	}
+
 	interruptionChecker := interruption.NewStandardInterruptionChecker(h.DeployItem, h.lsUncachedClient)
 
-	err := resourcemanager.DeleteManagedResources(
+	err = resourcemanager.DeleteManagedResources(
 		ctx,
 		h.lsUncachedClient,
-		h.ProviderStatus.ManagedResources,
+		managedResources,
 		h.ProviderConfiguration.DeletionGroups,
 		h.targetAccess.TargetClient(),
 		h.DeployItem,
@@ -336,11 +445,122 @@ func (h *Helm) deleteManifestsInGroups(ctx context.Context) error {
 		return fmt.Errorf("failed deleting managed resources: %w", err)
 	}
 
+	if err := h.runHooks(ctx, hooks, helmr.HookPostDelete); err != nil {
+		return err
+	}
+
 	// remove finalizer
 	controllerutil.RemoveFinalizer(h.DeployItem, lsv1alpha1.LandscaperFinalizer)
 	return h.Writer().UpdateDeployItem(ctx, read_write_layer.W000049, h.DeployItem)
 }
 
+// DeleteHookObjects returns the objects of the hooks which have to run during the deletion.
+func DeleteHookObjects(hooks Hooks) ([]*runtime.RawExtension, error) {
+	var objects []*runtime.RawExtension
+	for _, hook := range hooks {
+		if !hook.HasEvent(helmr.HookPreDelete) && !hook.HasEvent(helmr.HookPostDelete) {
+			continue
+		}
+
+		raw, err := json.Marshal(hook.Object.Object)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode hook %s: %w", hook.String(), err)
+		}
+		objects = append(objects, &runtime.RawExtension{Raw: raw})
+	}
+	return objects, nil
+}
+
+// storeDeleteHooks stores the hooks which have to run during the deletion in a secret owned by the deploy item.
+// They are not kept in the provider status, as they may contain sensitive data.
+func (h *Helm) storeDeleteHooks(ctx context.Context, hooks Hooks) error {
+	objects, err := DeleteHookObjects(hooks)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		return h.removeDeleteHooks(ctx)
+	}
+
+	data, err := json.Marshal(objects)
+	if err != nil {
+		return fmt.Errorf("unable to encode delete hooks: %w", err)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = fmt.Sprintf("%s-delete-hooks", h.DeployItem.Name)
+	secret.Namespace = h.DeployItem.Namespace
+	if ref := h.ProviderStatus.DeleteHooksReference; ref != nil {
+		secret.Name = ref.Name
+		secret.Namespace = ref.Namespace
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, h.lsUncachedClient, secret, func() error {
+		secret.Data = map[string][]byte{
+			DeleteHooksSecretDataKey: data,
+		}
+		return controllerutil.SetOwnerReference(h.DeployItem, secret, api.LandscaperScheme)
+	}); err != nil {
+		return fmt.Errorf("unable to store delete hooks in secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	h.ProviderStatus.DeleteHooksReference = &lsv1alpha1.ObjectReference{
+		Name:      secret.Name,
+		Namespace: secret.Namespace,
+	}
+	return nil
+}
+
+// removeDeleteHooks deletes the secret with the hooks which have to run during the deletion, if there is one.
+func (h *Helm) removeDeleteHooks(ctx context.Context) error {
+	ref := h.ProviderStatus.DeleteHooksReference
+	if ref == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = ref.Name
+	secret.Namespace = ref.Namespace
+	if err := h.lsUncachedClient.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete secret %s/%s with delete hooks: %w", ref.Namespace, ref.Name, err)
+	}
+
+	h.ProviderStatus.DeleteHooksReference = nil
+	return nil
+}
+
+// deleteHooks returns the hooks which have to run during the deletion. They have been stored in a secret
+// when the chart was applied.
+func (h *Helm) deleteHooks(ctx context.Context) (Hooks, error) {
+	currOp := "DeleteHooks"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	ref := h.ProviderStatus.DeleteHooksReference
+	if ref == nil {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := read_write_layer.GetSecret(ctx, h.lsUncachedClient, ref.NamespacedName(), secret, read_write_layer.R000144); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("Secret with delete hooks not found, no delete hooks are run", lc.KeyResource, ref.NamespacedName().String())
+			return nil, nil
+		}
+		return nil, lserrors.NewWrappedError(err, currOp, "GetSecret", err.Error())
+	}
+
+	objects := []*runtime.RawExtension{}
+	if err := json.Unmarshal(secret.Data[DeleteHooksSecretDataKey], &objects); err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "DecodeDeleteHooks", err.Error())
+	}
+
+	_, hooks, err := SplitHooks(objects)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "SplitHooks", err.Error())
+	}
+	return hooks, nil
+}
+
 func (h *Helm) deleteManifestsWithRealHelmDeployer(ctx context.Context, di *lsv1alpha1.DeployItem) error {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "lsHealthCheckController.check"})
 	logger.Info("Deleting files with real helm deployer")
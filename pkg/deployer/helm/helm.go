@@ -6,6 +6,8 @@ package helm
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"helm.sh/helm/v4/pkg/chart/common"
@@ -120,6 +122,62 @@ func (h *Helm) ensureTargetAccess(ctx context.Context) (err error) {
 	return err
 }
 
+// dataReferenceResolver returns a resolver for the data in Secrets and ConfigMaps referenced by the deploy item.
+func (h *Helm) dataReferenceResolver() *lib.DataReferenceResolver {
+	return &lib.DataReferenceResolver{
+		LsClient:   h.lsUncachedClient,
+		DeployItem: h.DeployItem,
+		GetTargetClient: func(ctx context.Context) (client.Client, error) {
+			if err := h.ensureTargetAccess(ctx); err != nil {
+				return nil, err
+			}
+			return h.targetAccess.TargetClient(), nil
+		},
+	}
+}
+
+// resolveValuesFrom merges the values referenced by valuesFrom into the values of the provider configuration, with
+// the inline values taking precedence. The hash of the referenced values is remembered in the status of the deploy
+// item, so that a change of the referenced values can be detected.
+func (h *Helm) resolveValuesFrom(ctx context.Context) lserrors.LsError {
+	currOp := "ResolveValuesFrom"
+
+	data, lsErr := h.dataReferenceResolver().Resolve(ctx, h.ProviderConfiguration.ValuesFrom)
+	if lsErr != nil {
+		return lsErr
+	}
+	h.DeployItem.Status.ReferencedDataHash = lib.DataHash(data)
+	if len(data) == 0 {
+		return nil
+	}
+
+	values := map[string]interface{}{}
+	for i, d := range data {
+		referencedValues := map[string]interface{}{}
+		if err := yaml.Unmarshal(d, &referencedValues); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ParseValuesFrom",
+				fmt.Sprintf("unable to parse the values of valuesFrom %d: %s", i, err.Error()), lsv1alpha1.ErrorConfigurationProblem)
+		}
+		values = utils.MergeMaps(values, referencedValues)
+	}
+
+	inlineValues := map[string]interface{}{}
+	if len(h.ProviderConfiguration.Values) != 0 {
+		if err := yaml.Unmarshal(h.ProviderConfiguration.Values, &inlineValues); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ParseHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	}
+	values = utils.MergeMaps(values, inlineValues)
+
+	// the merged values are only kept in memory and are never written to the deploy item
+	var err error
+	h.ProviderConfiguration.Values, err = json.Marshal(values)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "MarshalValues", err.Error())
+	}
+	return nil
+}
+
 // Template loads the specified helm chart
 // and templates it with the given values.
 func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]string, map[string]interface{}, *chart.Chart, lserrors.LsError) {
@@ -130,6 +188,10 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "ensureTargetAccess", err.Error())
 	}
 
+	if err := h.resolveValuesFrom(ctx); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	// download chart
 	// todo: do caching of charts
 
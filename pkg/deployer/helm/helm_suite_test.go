@@ -1070,6 +1070,97 @@ var _ = Describe("Template", func() {
 
 		})
 
+		// Tests concerning the helm tests of a chart. The chart contains a ConfigMap and a test Pod.
+		// As there is no kubelet in the test environment, the tests set the phase of the test Pod themselves.
+		Context("helm tests", func() {
+
+			createHelmDeployItem := func(helmDeployment, runTests bool) *lsv1alpha1.DeployItem {
+				Expect(utils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+				target, err := utils.CreateKubernetesTarget(state.Namespace, "my-target", testenv.Env.Config)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(state.Create(ctx, target)).To(Succeed())
+
+				// the service account of the test Pod is not created automatically in the test environment
+				serviceAccount := &corev1.ServiceAccount{}
+				serviceAccount.Name = "default"
+				serviceAccount.Namespace = state.Namespace
+				if err := state.Create(ctx, serviceAccount); err != nil && !apierrors.IsAlreadyExists(err) {
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				chartBytes, closer := utils.ReadChartFrom("./testdata/testchart12")
+				defer closer()
+
+				helmConfig := &helmv1alpha1.ProviderConfiguration{
+					Name:      "test",
+					Namespace: state.Namespace,
+					Chart: helmv1alpha1.Chart{
+						Archive: &helmv1alpha1.ArchiveAccess{
+							Raw: base64.StdEncoding.EncodeToString(chartBytes),
+						},
+					},
+					HelmDeployment: ptr.To(helmDeployment),
+					RunTests:       runTests,
+				}
+
+				item, err := helm.NewDeployItemBuilder().
+					Key(state.Namespace, "myitem").
+					ProviderConfig(helmConfig).
+					Target(target.Namespace, target.Name).
+					GenerateJobID().
+					Build()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(state.Create(ctx, item, envtest.UpdateStatus(true))).To(Succeed())
+				return item
+			}
+
+			testNotEnabled := func(helmDeployment bool) {
+				item := createHelmDeployItem(helmDeployment, false)
+
+				Eventually(isFinished, 30*time.Second, 1*time.Second).WithArguments(item).Should(BeTrue(), "deploy item should eventually have a final phase")
+				Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+
+				testPod := &corev1.Pod{}
+				err := testenv.Client.Get(ctx, kutil.ObjectKey("mychart-test", state.Namespace), testPod)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue(), "test pod should not be created")
+			}
+
+			testFailed := func(helmDeployment bool) {
+				item := createHelmDeployItem(helmDeployment, true)
+
+				testPod := &corev1.Pod{}
+				Eventually(func() error {
+					return testenv.Client.Get(ctx, kutil.ObjectKey("mychart-test", state.Namespace), testPod)
+				}, 30*time.Second, 1*time.Second).Should(Succeed(), "test pod should be created")
+				testPod.Status.Phase = corev1.PodFailed
+				Expect(testenv.Client.Status().Update(ctx, testPod)).To(Succeed())
+
+				Eventually(isFinished, 30*time.Second, 1*time.Second).WithArguments(item).Should(BeTrue(), "deploy item should eventually have a final phase")
+				Expect(item.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Failed))
+				Expect(item.Status.LastError).NotTo(BeNil())
+				Expect(item.Status.LastError.Reason).To(Equal(helm.HelmTestFailedReason))
+				Expect(item.Status.LastError.Codes).To(ContainElement(lsv1alpha1.ErrorHelmTestFailed))
+				Expect(item.Status.LastError.Message).To(ContainSubstring("mychart-test"))
+			}
+
+			It("should not run the tests if they are not enabled (real helm deployer)", func() {
+				testNotEnabled(true)
+			})
+
+			It("should not run the tests if they are not enabled (manifest helm deployer)", func() {
+				testNotEnabled(false)
+			})
+
+			It("should report a failed test (real helm deployer)", func() {
+				testFailed(true)
+			})
+
+			It("should report a failed test (manifest helm deployer)", func() {
+				testFailed(false)
+			})
+
+		})
+
 	})
 
 })
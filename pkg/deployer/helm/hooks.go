@@ -0,0 +1,366 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	helmr "helm.sh/helm/v4/pkg/release/v1"
+	releaseutil "helm.sh/helm/v4/pkg/release/v1/util"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/openmcp-project/landscaper/pkg/deployer/lib/interruption"
+	"github.com/openmcp-project/landscaper/pkg/deployer/lib/timeout"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+// HookFailedReason is the reason of the error which reports a failed helm hook.
+const HookFailedReason = "HookFailed"
+
+// DeleteHooksSecretDataKey is the key of the secret which contains the delete hooks of a manifest-only deployment.
+const DeleteHooksSecretDataKey = "hooks"
+
+var (
+	jobGroupKind = schema.GroupKind{Group: "batch", Kind: "Job"}
+	podGroupKind = schema.GroupKind{Kind: "Pod"}
+)
+
+// Hook is a resource of a chart which is annotated as helm hook.
+// In manifest-only deployments, hooks are not managed like the other resources of the chart.
+// They are created at the phases given by their events and deleted according to their delete policies.
+type Hook struct {
+	Object         *unstructured.Unstructured
+	Events         []helmr.HookEvent
+	Weight         int
+	DeletePolicies []helmr.HookDeletePolicy
+}
+
+// Hooks is a list of helm hooks.
+type Hooks []*Hook
+
+// HasEvent returns whether the hook runs at the given event.
+func (h *Hook) HasEvent(event helmr.HookEvent) bool {
+	return slices.Contains(h.Events, event)
+}
+
+// HasDeletePolicy returns whether the hook has the given delete policy.
+func (h *Hook) HasDeletePolicy(policy helmr.HookDeletePolicy) bool {
+	return slices.Contains(h.DeletePolicies, policy)
+}
+
+// String returns a short description of the hook for logs and error messages.
+func (h *Hook) String() string {
+	return fmt.Sprintf("%s %s", h.Object.GetKind(), kutil.ObjectKeyFromObject(h.Object).String())
+}
+
+// ForEvent returns the hooks of an event in the order in which helm runs them:
+// sorted by weight, then by the install order of their kinds, and then by name.
+func (hooks Hooks) ForEvent(event helmr.HookEvent) Hooks {
+	result := Hooks{}
+	for _, hook := range hooks {
+		if hook.HasEvent(event) {
+			result = append(result, hook)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Weight != b.Weight {
+			return a.Weight < b.Weight
+		}
+		if ka, kb := kindOrder(a.Object.GetKind()), kindOrder(b.Object.GetKind()); ka != kb {
+			return ka < kb
+		}
+		if a.Object.GetKind() != b.Object.GetKind() {
+			return a.Object.GetKind() < b.Object.GetKind()
+		}
+		return a.Object.GetName() < b.Object.GetName()
+	})
+	return result
+}
+
+// kindOrder returns the position of a kind in the helm install order.
+// Unknown kinds are ordered after all known kinds.
+func kindOrder(kind string) int {
+	if i := slices.Index(releaseutil.InstallOrder, kind); i >= 0 {
+		return i
+	}
+	return len(releaseutil.InstallOrder)
+}
+
+// SplitHooks separates the helm hooks from the other objects of a chart.
+// Hook events which are unknown to helm are ignored, so that a hook with only unknown events never runs.
+// Hooks without delete policy get the helm default policy "before-hook-creation".
+func SplitHooks(objects []*runtime.RawExtension) ([]*runtime.RawExtension, Hooks, error) {
+	resources := make([]*runtime.RawExtension, 0, len(objects))
+	hooks := Hooks{}
+	for _, obj := range objects {
+		u := &unstructured.Unstructured{}
+		if err := json.Unmarshal(obj.Raw, &u.Object); err != nil {
+			return nil, nil, fmt.Errorf("unable to decode object: %w", err)
+		}
+
+		annotations := u.GetAnnotations()
+		hookAnnotation, ok := annotations[helmr.HookAnnotation]
+		if !ok {
+			resources = append(resources, obj)
+			continue
+		}
+
+		hook := &Hook{
+			Object:         u,
+			Events:         []helmr.HookEvent{},
+			DeletePolicies: []helmr.HookDeletePolicy{},
+		}
+		for _, event := range splitAnnotation(hookAnnotation) {
+			if isKnownHookEvent(helmr.HookEvent(event)) {
+				hook.Events = append(hook.Events, helmr.HookEvent(event))
+			}
+		}
+		// helm ignores invalid weights
+		hook.Weight, _ = strconv.Atoi(strings.TrimSpace(annotations[helmr.HookWeightAnnotation]))
+		for _, policy := range splitAnnotation(annotations[helmr.HookDeleteAnnotation]) {
+			hook.DeletePolicies = append(hook.DeletePolicies, helmr.HookDeletePolicy(policy))
+		}
+		if len(hook.DeletePolicies) == 0 {
+			hook.DeletePolicies = append(hook.DeletePolicies, helmr.HookBeforeHookCreation)
+		}
+
+		hooks = append(hooks, hook)
+	}
+	return resources, hooks, nil
+}
+
+func splitAnnotation(value string) []string {
+	result := []string{}
+	for _, s := range strings.Split(value, ",") {
+		if s = strings.ToLower(strings.TrimSpace(s)); len(s) != 0 {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func isKnownHookEvent(event helmr.HookEvent) bool {
+	switch event {
+	case helmr.HookPreInstall, helmr.HookPostInstall, helmr.HookPreDelete, helmr.HookPostDelete,
+		helmr.HookPreUpgrade, helmr.HookPostUpgrade, helmr.HookPreRollback, helmr.HookPostRollback, helmr.HookTest:
+		return true
+	}
+	return false
+}
+
+// runHooks runs the hooks of an event one after another.
+// Each hook has to be completed before the next one is created. A Job is completed if it has succeeded, a Pod if it
+// has terminated successfully; all other kinds are completed as soon as they are created.
+func (h *Helm) runHooks(ctx context.Context, hooks Hooks, event helmr.HookEvent) error {
+	hooks = hooks.ForEvent(event)
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "runHooks", "event", string(event)})
+	logger.Info("Running helm hooks", "count", len(hooks))
+
+	if err := h.ensureReleaseNamespace(ctx); err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		if err := h.runHook(ctx, hook, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Helm) runHook(ctx context.Context, hook *Hook, event helmr.HookEvent) error {
+	currOp := "RunHook"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyResource, hook.String()})
+	targetClient := h.targetAccess.TargetClient()
+
+	obj := hook.Object.DeepCopy()
+	if len(obj.GetNamespace()) == 0 && len(h.ProviderConfiguration.Namespace) != 0 {
+		namespaced, err := targetClient.IsObjectNamespaced(obj)
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "IsObjectNamespaced", err.Error())
+		}
+		if namespaced {
+			obj.SetNamespace(h.ProviderConfiguration.Namespace)
+		}
+	}
+	kutil.SetMetaDataLabel(obj, helmv1alpha1.ManagedDeployItemLabel, h.DeployItem.Name)
+
+	t, lserr := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHelmRunHooks)
+	if lserr != nil {
+		return lserr
+	}
+
+	if hook.HasDeletePolicy(helmr.HookBeforeHookCreation) {
+		if err := deleteHookObject(ctx, targetClient, obj.DeepCopy(), t); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "DeleteHookBeforeCreation",
+				fmt.Sprintf("unable to delete %s hook %s: %s", event, hook, err.Error()))
+		}
+	}
+
+	logger.Info("Creating helm hook")
+	if err := targetClient.Create(ctx, obj); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "CreateHook",
+			fmt.Sprintf("unable to create %s hook %s: %s", event, hook, err.Error()))
+	}
+
+	failure, waitErr := h.waitForHookCompleted(ctx, obj, t)
+	failed := waitErr != nil || len(failure) != 0
+
+	// the logs of a test have to be read before the test is deleted
+	var testLogs string
+	if event == helmr.HookTest {
+		testLogs = h.getTestLogs(ctx, obj)
+	}
+
+	if (!failed && hook.HasDeletePolicy(helmr.HookSucceeded)) || (failed && hook.HasDeletePolicy(helmr.HookFailed)) {
+		logger.Info("Deleting helm hook")
+		if err := targetClient.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+			if failed {
+				logger.Error(err, "unable to delete failed helm hook")
+			} else {
+				return lserrors.NewWrappedError(err, currOp, "DeleteHook",
+					fmt.Sprintf("unable to delete %s hook %s: %s", event, hook, err.Error()))
+			}
+		}
+	}
+
+	if waitErr != nil {
+		if lsErr, ok := waitErr.(lserrors.LsError); ok {
+			return lsErr
+		}
+		return lserrors.NewWrappedError(waitErr, currOp, "WaitForHookCompleted",
+			fmt.Sprintf("unable to wait for %s hook %s: %s", event, hook, waitErr.Error()))
+	}
+	if len(failure) != 0 {
+		if event == helmr.HookTest {
+			return lserrors.NewError(currOp, HelmTestFailedReason,
+				fmt.Sprintf("helm test %s failed: %s%s", hook, failure, testLogs), lsv1alpha1.ErrorHelmTestFailed)
+		}
+		return lserrors.NewError(currOp, HookFailedReason, fmt.Sprintf("%s hook %s failed: %s", event, hook, failure))
+	}
+	return nil
+}
+
+// waitForHookCompleted waits until a Job has succeeded resp. a Pod has terminated successfully.
+// If the Job resp. Pod has failed, a message describing the failure is returned.
+func (h *Helm) waitForHookCompleted(ctx context.Context, obj *unstructured.Unstructured, t time.Duration) (string, error) {
+	gk := obj.GroupVersionKind().GroupKind()
+	if gk != jobGroupKind && gk != podGroupKind {
+		return "", nil
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	targetClient := h.targetAccess.TargetClient()
+	interruptionChecker := interruption.NewStandardInterruptionChecker(h.DeployItem, h.lsUncachedClient)
+
+	var failure string
+	err := wait.PollUntilContextTimeout(ctx, 5*time.Second, t, true, func(ctx context.Context) (bool, error) {
+		if err := interruptionChecker.Check(ctx); err != nil {
+			return false, err
+		}
+
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(obj.GroupVersionKind())
+		if err := read_write_layer.GetUnstructured(ctx, targetClient, kutil.ObjectKeyFromObject(obj), current, read_write_layer.R000136); err != nil {
+			logger.Info("unable to get helm hook", lc.KeyError, err.Error())
+			return false, nil
+		}
+
+		var completed bool
+		completed, failure = hookCompletionState(current)
+		return completed, nil
+	})
+
+	if wait.Interrupted(err) {
+		msg := fmt.Sprintf("timeout while waiting for hook %s %s to complete", obj.GetKind(), kutil.ObjectKeyFromObject(obj).String())
+		return "", lserrors.NewWrappedError(err, "WaitForHookCompleted", lsv1alpha1.ProgressingTimeoutReason, msg,
+			lsv1alpha1.ErrorTimeout)
+	}
+	return failure, err
+}
+
+// hookCompletionState returns whether a Job or Pod is completed, and a failure message if it has failed.
+func hookCompletionState(obj *unstructured.Unstructured) (bool, string) {
+	if obj.GroupVersionKind().GroupKind() == podGroupKind {
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		switch corev1.PodPhase(phase) {
+		case corev1.PodSucceeded:
+			return true, ""
+		case corev1.PodFailed:
+			return true, "pod failed"
+		}
+		return false, ""
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["status"] != string(corev1.ConditionTrue) {
+			continue
+		}
+		switch condition["type"] {
+		case "Complete":
+			return true, ""
+		case "Failed":
+			return true, fmt.Sprintf("job failed: %v", condition["message"])
+		}
+	}
+	return false, ""
+}
+
+// deleteHookObject deletes an existing hook object and waits until it is gone.
+func deleteHookObject(ctx context.Context, targetClient client.Client, obj *unstructured.Unstructured, t time.Duration) error {
+	if err := targetClient.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+	return wait.PollUntilContextCancel(pollCtx, 5*time.Second, true, kutil.GenerateDeleteObjectConditionFunc(ctx, targetClient, obj))
+}
+
+// ensureReleaseNamespace creates the release namespace if it should be created by the deployer, so that hooks can be
+// created before the other resources of the chart.
+func (h *Helm) ensureReleaseNamespace(ctx context.Context) error {
+	if !h.ProviderConfiguration.CreateNamespace || len(h.ProviderConfiguration.Namespace) == 0 {
+		return nil
+	}
+
+	ns := &corev1.Namespace{}
+	ns.Name = h.ProviderConfiguration.Namespace
+	if err := h.targetAccess.TargetClient().Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
+		return lserrors.NewWrappedError(err, "EnsureReleaseNamespace", "CreateNamespace", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	helmr "helm.sh/helm/v4/pkg/release/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openmcp-project/landscaper/pkg/deployer/helm"
+)
+
+var _ = Describe("Hooks", func() {
+
+	newObject := func(apiVersion, kind, name string, annotations map[string]string) *runtime.RawExtension {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetName(name)
+		obj.SetAnnotations(annotations)
+		raw, err := json.Marshal(obj.Object)
+		Expect(err).ToNot(HaveOccurred())
+		return &runtime.RawExtension{Raw: raw}
+	}
+
+	names := func(hooks helm.Hooks) []string {
+		result := []string{}
+		for _, hook := range hooks {
+			result = append(result, hook.Object.GetName())
+		}
+		return result
+	}
+
+	It("should separate the hooks from the other resources", func() {
+		objects := []*runtime.RawExtension{
+			newObject("v1", "ConfigMap", "config", nil),
+			newObject("batch/v1", "Job", "migrate", map[string]string{
+				helmr.HookAnnotation:       "pre-install, pre-upgrade",
+				helmr.HookWeightAnnotation: "-5",
+				helmr.HookDeleteAnnotation: "hook-succeeded,hook-failed",
+			}),
+			newObject("v1", "Pod", "unknown", map[string]string{
+				helmr.HookAnnotation: "post-something",
+			}),
+		}
+
+		resources, hooks, err := helm.SplitHooks(objects)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resources).To(ConsistOf(objects[0]))
+		Expect(hooks).To(HaveLen(2))
+
+		Expect(hooks[0].Object.GetName()).To(Equal("migrate"))
+		Expect(hooks[0].Events).To(ConsistOf(helmr.HookPreInstall, helmr.HookPreUpgrade))
+		Expect(hooks[0].Weight).To(Equal(-5))
+		Expect(hooks[0].DeletePolicies).To(ConsistOf(helmr.HookSucceeded, helmr.HookFailed))
+
+		// unknown events are ignored and the default delete policy is used
+		Expect(hooks[1].Events).To(BeEmpty())
+		Expect(hooks[1].Weight).To(Equal(0))
+		Expect(hooks[1].DeletePolicies).To(ConsistOf(helmr.HookBeforeHookCreation))
+	})
+
+	It("should order the hooks of an event by weight, kind and name", func() {
+		preInstall := map[string]string{helmr.HookAnnotation: "pre-install"}
+		weighted := map[string]string{helmr.HookAnnotation: "pre-install", helmr.HookWeightAnnotation: "1"}
+		objects := []*runtime.RawExtension{
+			newObject("batch/v1", "Job", "job-b", preInstall),
+			newObject("batch/v1", "Job", "job-weighted", weighted),
+			newObject("batch/v1", "Job", "job-a", preInstall),
+			newObject("v1", "ServiceAccount", "sa", preInstall),
+			newObject("v1", "Pod", "delete", map[string]string{helmr.HookAnnotation: "pre-delete"}),
+		}
+
+		_, hooks, err := helm.SplitHooks(objects)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names(hooks.ForEvent(helmr.HookPreInstall))).To(Equal([]string{"sa", "job-a", "job-b", "job-weighted"}))
+		Expect(names(hooks.ForEvent(helmr.HookPreDelete))).To(Equal([]string{"delete"}))
+		Expect(hooks.ForEvent(helmr.HookPostInstall)).To(BeEmpty())
+	})
+
+	It("should separate the test hooks from the other resources", func() {
+		objects := []*runtime.RawExtension{
+			newObject("v1", "Service", "nginx", nil),
+			newObject("v1", "Pod", "test-connection", map[string]string{helmr.HookAnnotation: "test"}),
+		}
+
+		resources, hooks, err := helm.SplitHooks(objects)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resources).To(ConsistOf(objects[0]))
+		Expect(names(hooks.ForEvent(helmr.HookTest))).To(Equal([]string{"test-connection"}))
+		Expect(hooks.ForEvent(helmr.HookPostInstall)).To(BeEmpty())
+	})
+
+	It("should keep the delete hooks for the deletion", func() {
+		objects := []*runtime.RawExtension{
+			newObject("batch/v1", "Job", "migrate", map[string]string{helmr.HookAnnotation: "pre-install"}),
+			newObject("batch/v1", "Job", "backup", map[string]string{
+				helmr.HookAnnotation:       "pre-delete",
+				helmr.HookDeleteAnnotation: "hook-succeeded",
+			}),
+			newObject("batch/v1", "Job", "cleanup", map[string]string{helmr.HookAnnotation: "post-upgrade,post-delete"}),
+		}
+
+		_, hooks, err := helm.SplitHooks(objects)
+		Expect(err).ToNot(HaveOccurred())
+
+		deleteHookObjects, err := helm.DeleteHookObjects(hooks)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleteHookObjects).To(HaveLen(2))
+
+		_, deleteHooks, err := helm.SplitHooks(deleteHookObjects)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names(deleteHooks.ForEvent(helmr.HookPreDelete))).To(Equal([]string{"backup"}))
+		Expect(deleteHooks.ForEvent(helmr.HookPreDelete)[0].DeletePolicies).To(ConsistOf(helmr.HookSucceeded))
+		Expect(names(deleteHooks.ForEvent(helmr.HookPostDelete))).To(Equal([]string{"cleanup"}))
+		Expect(deleteHooks.ForEvent(helmr.HookPreInstall)).To(BeEmpty())
+	})
+
+})
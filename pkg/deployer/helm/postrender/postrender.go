@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postrender
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1"
+)
+
+// PostRenderer applies the post-render patches of a helm provider configuration to the rendered manifests of a chart.
+// It implements the post-renderer interface of helm, so that the patches can be applied to a helm deployment
+// as well as to a manifest-only deployment.
+type PostRenderer struct {
+	patches          []helmv1alpha1.PostRenderPatch
+	defaultNamespace string
+}
+
+// New creates a new post-renderer for the given patches.
+// Manifests without namespace are matched against the default namespace, which is the release namespace.
+func New(patches []helmv1alpha1.PostRenderPatch, defaultNamespace string) *PostRenderer {
+	return &PostRenderer{
+		patches:          patches,
+		defaultNamespace: defaultNamespace,
+	}
+}
+
+// Run implements the post-renderer interface of helm.
+// It patches the manifests of a multi-document yaml stream and returns the patched manifests.
+func (p *PostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(renderedManifests, 1024)
+	result := &bytes.Buffer{}
+	for {
+		obj := map[string]interface{}{}
+		if err := decoder.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("unable to decode rendered manifests: %w", err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		data, err = p.Patch(data)
+		if err != nil {
+			return nil, err
+		}
+		data, err = yaml.JSONToYAML(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result.WriteString("---\n")
+		result.Write(data)
+	}
+	return result, nil
+}
+
+// PatchObjects applies the patches to a list of rendered manifests.
+func (p *PostRenderer) PatchObjects(objects []*runtime.RawExtension) ([]*runtime.RawExtension, error) {
+	if len(p.patches) == 0 {
+		return objects, nil
+	}
+
+	result := make([]*runtime.RawExtension, len(objects))
+	for i, obj := range objects {
+		data, err := p.Patch(obj.Raw)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = &runtime.RawExtension{Raw: data}
+	}
+	return result, nil
+}
+
+// Patch applies the patches to a single manifest in json format.
+// The error of a patch that cannot be applied contains the index of the patch.
+func (p *PostRenderer) Patch(data []byte) ([]byte, error) {
+	for i, patch := range p.patches {
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(data); err != nil {
+			return nil, fmt.Errorf("unable to decode manifest: %w", err)
+		}
+
+		matches, err := p.matches(patch.Target, obj)
+		if err != nil {
+			return nil, fmt.Errorf("post-render patch %d: %w", i, err)
+		}
+		if !matches {
+			continue
+		}
+
+		data, err = applyPatch(patch, obj, data)
+		if err != nil {
+			return nil, fmt.Errorf("post-render patch %d: unable to apply %s patch to %s %s: %w",
+				i, patch.Type, obj.GetKind(), objectName(obj), err)
+		}
+	}
+	return data, nil
+}
+
+// matches returns whether a manifest is selected by the target of a patch.
+func (p *PostRenderer) matches(target *helmv1alpha1.PatchTarget, obj *unstructured.Unstructured) (bool, error) {
+	if target == nil {
+		return true, nil
+	}
+
+	gvk := obj.GroupVersionKind()
+	namespace := obj.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = p.defaultNamespace
+	}
+
+	if (len(target.Group) != 0 && target.Group != gvk.Group) ||
+		(len(target.Version) != 0 && target.Version != gvk.Version) ||
+		(len(target.Kind) != 0 && target.Kind != gvk.Kind) ||
+		(len(target.Name) != 0 && target.Name != obj.GetName()) ||
+		(len(target.Namespace) != 0 && target.Namespace != namespace) {
+		return false, nil
+	}
+
+	if len(target.LabelSelector) != 0 {
+		selector, err := labels.Parse(target.LabelSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid label selector %q: %w", target.LabelSelector, err)
+		}
+		if !selector.Matches(labels.Set(obj.GetLabels())) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func applyPatch(patch helmv1alpha1.PostRenderPatch, obj *unstructured.Unstructured, data []byte) ([]byte, error) {
+	switch patch.Type {
+	case helmv1alpha1.PatchTypeJSON6902:
+		decoded, err := jsonpatch.DecodePatch(patch.Patch)
+		if err != nil {
+			return nil, err
+		}
+		return decoded.Apply(data)
+	case helmv1alpha1.PatchTypeStrategicMerge:
+		dataStruct, err := scheme.Scheme.New(obj.GroupVersionKind())
+		if runtime.IsNotRegisteredError(err) {
+			// types which are not known, e.g. custom resources, do not support strategic merge patches
+			return jsonpatch.MergePatch(data, patch.Patch)
+		} else if err != nil {
+			return nil, err
+		}
+		return strategicpatch.StrategicMergePatch(data, patch.Patch, dataStruct)
+	case helmv1alpha1.PatchTypeMerge:
+		return jsonpatch.MergePatch(data, patch.Patch)
+	default:
+		return nil, fmt.Errorf("unsupported patch type %q", patch.Type)
+	}
+}
+
+func objectName(obj *unstructured.Unstructured) string {
+	if len(obj.GetNamespace()) == 0 {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
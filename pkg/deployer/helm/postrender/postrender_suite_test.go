@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postrender_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPostRender(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PostRender Test Suite")
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postrender_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/openmcp-project/landscaper/pkg/deployer/helm/postrender"
+)
+
+const deployment = `{
+  "apiVersion": "apps/v1",
+  "kind": "Deployment",
+  "metadata": {"name": "nginx", "labels": {"app": "nginx"}},
+  "spec": {"template": {"spec": {"containers": [{"name": "nginx", "image": "nginx:1.0"}]}}}
+}`
+
+const configMap = `{
+  "apiVersion": "v1",
+  "kind": "ConfigMap",
+  "metadata": {"name": "config", "namespace": "other"},
+  "data": {"key": "val"}
+}`
+
+func decode(data []byte) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	ExpectWithOffset(1, obj.UnmarshalJSON(data)).To(Succeed())
+	return obj
+}
+
+var _ = Describe("PostRenderer", func() {
+
+	It("should apply a json6902 patch", func() {
+		renderer := postrender.New([]helmv1alpha1.PostRenderPatch{
+			{
+				Type:  helmv1alpha1.PatchTypeJSON6902,
+				Patch: json.RawMessage(`[{"op": "add", "path": "/metadata/annotations", "value": {"patched": "true"}}]`),
+			},
+		}, "default")
+
+		data, err := renderer.Patch([]byte(deployment))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decode(data).GetAnnotations()).To(HaveKeyWithValue("patched", "true"))
+	})
+
+	It("should apply a strategic merge patch", func() {
+		renderer := postrender.New([]helmv1alpha1.PostRenderPatch{
+			{
+				Type: helmv1alpha1.PatchTypeStrategicMerge,
+				Patch: json.RawMessage(`{"spec": {"template": {"spec": {"containers": [
+					{"name": "sidecar", "image": "sidecar:1.0"}
+				]}}}}`),
+			},
+		}, "default")
+
+		data, err := renderer.Patch([]byte(deployment))
+		Expect(err).ToNot(HaveOccurred())
+		containers, found, err := unstructured.NestedSlice(decode(data).Object, "spec", "template", "spec", "containers")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		// the containers are merged by their names
+		Expect(containers).To(HaveLen(2))
+	})
+
+	It("should apply a merge patch", func() {
+		renderer := postrender.New([]helmv1alpha1.PostRenderPatch{
+			{
+				Type:  helmv1alpha1.PatchTypeMerge,
+				Patch: json.RawMessage(`{"data": {"key": null, "other": "val"}}`),
+			},
+		}, "default")
+
+		data, err := renderer.Patch([]byte(configMap))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decode(data).Object["data"]).To(Equal(map[string]interface{}{"other": "val"}))
+	})
+
+	It("should only patch the selected manifests", func() {
+		renderer := postrender.New([]helmv1alpha1.PostRenderPatch{
+			{
+				Type: helmv1alpha1.PatchTypeMerge,
+				Target: &helmv1alpha1.PatchTarget{
+					Group:         "apps",
+					Kind:          "Deployment",
+					Namespace:     "default",
+					LabelSelector: "app=nginx",
+				},
+				Patch: json.RawMessage(`{"metadata": {"annotations": {"patched": "true"}}}`),
+			},
+		}, "default")
+
+		objects, err := renderer.PatchObjects([]*runtime.RawExtension{
+			{Raw: []byte(deployment)},
+			{Raw: []byte(configMap)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(HaveLen(2))
+		Expect(decode(objects[0].Raw).GetAnnotations()).To(HaveKeyWithValue("patched", "true"))
+		Expect(decode(objects[1].Raw).GetAnnotations()).To(BeEmpty())
+	})
+
+	It("should report the index of a patch that cannot be applied", func() {
+		renderer := postrender.New([]helmv1alpha1.PostRenderPatch{
+			{
+				Type:  helmv1alpha1.PatchTypeMerge,
+				Patch: json.RawMessage(`{"metadata": {"annotations": {"patched": "true"}}}`),
+			},
+			{
+				Type:  helmv1alpha1.PatchTypeJSON6902,
+				Patch: json.RawMessage(`[{"op": "replace", "path": "/spec/replicas", "value": 3}]`),
+			},
+		}, "default")
+
+		_, err := renderer.Patch([]byte(deployment))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("post-render patch 1"))
+		Expect(err.Error()).To(ContainSubstring("Deployment nginx"))
+	})
+
+	It("should patch a multi-document yaml stream", func() {
+		deploymentYaml, err := yaml.JSONToYAML([]byte(deployment))
+		Expect(err).ToNot(HaveOccurred())
+		configMapYaml, err := yaml.JSONToYAML([]byte(configMap))
+		Expect(err).ToNot(HaveOccurred())
+
+		manifests := bytes.NewBufferString("---\n# Source: chart/templates/deployment.yaml\n")
+		manifests.Write(deploymentYaml)
+		manifests.WriteString("---\n# Source: chart/templates/configmap.yaml\n")
+		manifests.Write(configMapYaml)
+
+		renderer := postrender.New([]helmv1alpha1.PostRenderPatch{
+			{
+				Type:   helmv1alpha1.PatchTypeMerge,
+				Target: &helmv1alpha1.PatchTarget{Kind: "ConfigMap"},
+				Patch:  json.RawMessage(`{"data": {"key": "patched"}}`),
+			},
+		}, "default")
+
+		result, err := renderer.Run(manifests)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.String()).To(ContainSubstring("key: patched"))
+		Expect(result.String()).To(ContainSubstring("image: nginx:1.0"))
+	})
+})
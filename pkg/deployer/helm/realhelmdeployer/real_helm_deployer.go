@@ -36,6 +36,7 @@ import (
 	lserrors "github.com/openmcp-project/landscaper/apis/errors"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
 	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/openmcp-project/landscaper/pkg/deployer/helm/postrender"
 	"github.com/openmcp-project/landscaper/pkg/deployer/lib"
 	"github.com/openmcp-project/landscaper/pkg/deployer/lib/readinesscheck"
 	"github.com/openmcp-project/landscaper/pkg/deployer/lib/resourcemanager"
@@ -56,6 +57,7 @@ type RealHelmDeployer struct {
 	rawValues          json.RawMessage
 	helmConfig         *helmv1alpha1.HelmDeploymentConfiguration
 	createNamespace    bool
+	postRenderPatches  []helmv1alpha1.PostRenderPatch
 	targetRestConfig   *rest.Config
 	apiResourceHandler *resourcemanager.ApiResourceHandler
 	helmSecretManager  *HelmSecretManager
@@ -74,6 +76,7 @@ func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderC
 		rawValues:          providerConfig.Values,
 		helmConfig:         providerConfig.HelmDeploymentConfig,
 		createNamespace:    providerConfig.CreateNamespace,
+		postRenderPatches:  providerConfig.PostRenderPatches,
 		targetRestConfig:   targetAccess.TargetRestConfig(),
 		apiResourceHandler: resourcemanager.CreateApiResourceHandler(targetAccess.TargetClientSet()),
 		helmSecretManager:  nil,
@@ -178,6 +181,9 @@ func (c *RealHelmDeployer) installRelease(ctx context.Context, values map[string
 	install.ForceReplace = installConfig.Force
 	install.SkipSchemaValidation = installConfig.SkipSchemaValidation
 	install.TakeOwnership = installConfig.TakeOwnership
+	if len(c.postRenderPatches) != 0 {
+		install.PostRenderer = postrender.New(c.postRenderPatches, c.defaultNamespace)
+	}
 
 	timeout, err := timeout.TimeoutExceeded(ctx, c.di, TimeoutCheckpointHelmBeforeInstallingRelease)
 	if err != nil {
@@ -242,6 +248,9 @@ func (c *RealHelmDeployer) upgradeRelease(ctx context.Context, values map[string
 	upgrade.ForceReplace = upgradeConfig.Force
 	upgrade.SkipSchemaValidation = upgradeConfig.SkipSchemaValidation
 	upgrade.TakeOwnership = upgradeConfig.TakeOwnership
+	if len(c.postRenderPatches) != 0 {
+		upgrade.PostRenderer = postrender.New(c.postRenderPatches, c.defaultNamespace)
+	}
 
 	timeout, err := timeout.TimeoutExceeded(ctx, c.di, TimeoutCheckpointHelmBeforeUpgradingRelease)
 	if err != nil {
@@ -456,6 +465,31 @@ func (c *RealHelmDeployer) GetManagedResourcesStatus(ctx context.Context) ([]man
 	return result, nil
 }
 
+// GetHooks returns the manifests of the hooks of the release.
+// The manifests are keyed by their index and the path of their template, as several hooks can have the same template.
+func (c *RealHelmDeployer) GetHooks(ctx context.Context) (map[string]string, error) {
+	rls, err := c.getRelease(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accessor, err := release.NewAccessor(rls)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get release accessor: %w", err)
+	}
+
+	result := make(map[string]string)
+	for i, hook := range accessor.Hooks() {
+		hookAccessor, err := release.NewHookAccessor(hook)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get hook accessor: %w", err)
+		}
+		result[fmt.Sprintf("%d-%s", i, hookAccessor.Path())] = hookAccessor.Manifest()
+	}
+
+	return result, nil
+}
+
 func (c *RealHelmDeployer) isReleaseNotFoundErr(err error) bool {
 	return strings.Contains(strings.ToLower(err.Error()), "release: not found")
 }
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	helmr "helm.sh/helm/v4/pkg/release/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/openmcp-project/landscaper/pkg/deployer/helm/realhelmdeployer"
+	"github.com/openmcp-project/landscaper/pkg/deployer/lib/timeout"
+)
+
+// HelmTestFailedReason is the reason of the error which reports a failed helm test.
+const HelmTestFailedReason = "HelmTestFailed"
+
+// testLogTailLines is the number of lines of the logs of a test container which are captured.
+const testLogTailLines = 20
+
+// maxTestLogLength is the maximal length of the logs of a test which are added to the error of a failed test.
+// Longer logs are truncated at the beginning, as the last lines are usually the most relevant ones.
+const maxTestLogLength = 2000
+
+// runTests runs the test hooks of the chart if this is enabled in the provider configuration.
+func (h *Helm) runTests(ctx context.Context, hooks Hooks) error {
+	if !h.ProviderConfiguration.RunTests {
+		return nil
+	}
+
+	if _, err := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHelmRunTests); err != nil {
+		return err
+	}
+
+	return h.runHooks(ctx, hooks, helmr.HookTest)
+}
+
+// getReleaseHooks returns the hooks of the release deployed by helm.
+func (h *Helm) getReleaseHooks(ctx context.Context, realHelmDeployer *realhelmdeployer.RealHelmDeployer) (Hooks, error) {
+	currOp := "GetReleaseHooks"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	files, err := realHelmDeployer.GetHooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := kutil.ParseFilesToRawExtension(logger, files)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "DecodeHooks", err.Error())
+	}
+
+	_, hooks, err := SplitHooks(objects)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "SplitHooks", err.Error())
+	}
+	return hooks, nil
+}
+
+// getTestLogs returns the last lines of the logs of the containers of a test Pod, resp. of the Pods of a test Job.
+// The logs are written to the debug log of the deployer, and truncated to maxTestLogLength characters in the result.
+// Errors are only logged, as the logs are not essential for the result of a test.
+func (h *Helm) getTestLogs(ctx context.Context, obj *unstructured.Unstructured) string {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyResource, kutil.ObjectKeyFromObject(obj).String()})
+	pods := h.targetAccess.TargetClientSet().CoreV1().Pods(obj.GetNamespace())
+
+	podList := []corev1.Pod{}
+	switch obj.GroupVersionKind().GroupKind() {
+	case podGroupKind:
+		pod, err := pods.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if err != nil {
+			logger.Error(err, "unable to get pod of helm test")
+			return ""
+		}
+		podList = append(podList, *pod)
+	case jobGroupKind:
+		list, err := pods.List(ctx, metav1.ListOptions{LabelSelector: "job-name=" + obj.GetName()})
+		if err != nil {
+			logger.Error(err, "unable to list pods of helm test")
+			return ""
+		}
+		podList = append(podList, list.Items...)
+	default:
+		return ""
+	}
+
+	logs := strings.Builder{}
+	for _, pod := range podList {
+		for _, container := range pod.Spec.Containers {
+			data, err := pods.GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container: container.Name,
+				TailLines: ptr.To[int64](testLogTailLines),
+			}).DoRaw(ctx)
+			if err != nil {
+				logger.Error(err, "unable to get logs of helm test", "pod", pod.Name, "container", container.Name)
+				continue
+			}
+			fmt.Fprintf(&logs, "\nlogs of container %s of pod %s:\n%s", container.Name, pod.Name, strings.TrimSpace(string(data)))
+		}
+	}
+
+	logger.Debug("Helm test completed", "logs", logs.String())
+	return truncateTestLogs(logs.String())
+}
+
+// truncateTestLogs shortens the logs of a test to their last maxTestLogLength characters.
+func truncateTestLogs(logs string) string {
+	if len(logs) <= maxTestLogLength {
+		return logs
+	}
+	return "\n... (truncated)" + strings.ToValidUTF8(logs[len(logs)-maxTestLogLength:], "")
+}
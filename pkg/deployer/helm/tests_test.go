@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	helmr "helm.sh/helm/v4/pkg/release/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/api"
+	"github.com/openmcp-project/landscaper/pkg/deployer/lib"
+	"github.com/openmcp-project/landscaper/pkg/deployer/lib/timeout"
+)
+
+var _ = Describe("Helm tests", func() {
+
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		timeout.ActivateIgnoreTimeoutChecker()
+	})
+
+	AfterEach(func() {
+		timeout.ActivateStandardTimeoutChecker()
+	})
+
+	newPod := func(name string, labels map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    labels,
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "test", Image: "busybox"}},
+			},
+		}
+	}
+
+	toUnstructured := func(obj runtime.Object, apiVersion, kind string) *unstructured.Unstructured {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		Expect(err).ToNot(HaveOccurred())
+		u := &unstructured.Unstructured{Object: content}
+		u.SetAPIVersion(apiVersion)
+		u.SetKind(kind)
+		return u
+	}
+
+	testHooks := func(pod *corev1.Pod) Hooks {
+		return Hooks{
+			{
+				Object:         toUnstructured(pod, "v1", "Pod"),
+				Events:         []helmr.HookEvent{helmr.HookTest},
+				DeletePolicies: []helmr.HookDeletePolicy{helmr.HookBeforeHookCreation},
+			},
+		}
+	}
+
+	// targetClientWithPodPhase returns a client for the target cluster which reports the given phase for all Pods.
+	targetClientWithPodPhase := func(phase corev1.PodPhase) client.Client {
+		return fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if err := c.Get(ctx, key, obj, opts...); err != nil {
+					return err
+				}
+				if u, ok := obj.(*unstructured.Unstructured); ok && u.GetKind() == "Pod" {
+					return unstructured.SetNestedField(u.Object, string(phase), "status", "phase")
+				}
+				return nil
+			},
+		}).Build()
+	}
+
+	newHelm := func(runTests bool, targetClient client.Client, targetClientSet kubernetes.Interface) *Helm {
+		item := &lsv1alpha1.DeployItem{}
+		item.Name = "myitem"
+		item.Namespace = "default"
+		lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(item).Build()
+
+		return &Helm{
+			lsUncachedClient: lsClient,
+			DeployItem:       item,
+			ProviderConfiguration: &helmv1alpha1.ProviderConfiguration{
+				Name:      "test",
+				Namespace: "default",
+				RunTests:  runTests,
+			},
+			targetAccess: lib.NewTargetAccessFromClients(targetClient, nil, targetClientSet),
+		}
+	}
+
+	It("should not run the tests if they are not enabled", func() {
+		targetClient := fake.NewClientBuilder().Build()
+		h := newHelm(false, targetClient, k8sfake.NewClientset())
+
+		Expect(h.runTests(ctx, testHooks(newPod("mytest", nil)))).To(Succeed())
+
+		pods := &corev1.PodList{}
+		Expect(targetClient.List(ctx, pods)).To(Succeed())
+		Expect(pods.Items).To(BeEmpty())
+	})
+
+	It("should run a successful test", func() {
+		pod := newPod("mytest", nil)
+		h := newHelm(true, targetClientWithPodPhase(corev1.PodSucceeded), k8sfake.NewClientset(pod))
+
+		Expect(h.runTests(ctx, testHooks(pod))).To(Succeed())
+	})
+
+	It("should report a failed test together with the logs of the test", func() {
+		pod := newPod("mytest", nil)
+		h := newHelm(true, targetClientWithPodPhase(corev1.PodFailed), k8sfake.NewClientset(pod))
+
+		err := h.runTests(ctx, testHooks(pod))
+		Expect(err).To(HaveOccurred())
+		lsErr, ok := err.(lserrors.LsError)
+		Expect(ok).To(BeTrue())
+		Expect(lsErr.LandscaperError().Reason).To(Equal(HelmTestFailedReason))
+		Expect(lsErr.LandscaperError().Codes).To(ContainElement(lsv1alpha1.ErrorHelmTestFailed))
+		Expect(lsErr.LandscaperError().Message).To(ContainSubstring("pod failed"))
+		// the fake clientset returns "fake logs" as logs of all containers
+		Expect(lsErr.LandscaperError().Message).To(ContainSubstring("logs of container test of pod mytest:\nfake logs"))
+	})
+
+	It("should get the logs of the pods of a test job", func() {
+		jobLabels := map[string]string{"job-name": "mytest"}
+		clientSet := k8sfake.NewClientset(newPod("mytest-a", jobLabels), newPod("mytest-b", jobLabels), newPod("other", nil))
+		h := newHelm(true, fake.NewClientBuilder().Build(), clientSet)
+
+		job := &unstructured.Unstructured{}
+		job.SetAPIVersion("batch/v1")
+		job.SetKind("Job")
+		job.SetName("mytest")
+		job.SetNamespace("default")
+
+		logs := h.getTestLogs(ctx, job)
+		Expect(logs).To(ContainSubstring("logs of container test of pod mytest-a"))
+		Expect(logs).To(ContainSubstring("logs of container test of pod mytest-b"))
+		Expect(logs).ToNot(ContainSubstring("pod other"))
+	})
+
+	It("should not get logs of a test which is neither a pod nor a job", func() {
+		h := newHelm(true, fake.NewClientBuilder().Build(), k8sfake.NewClientset(newPod("mytest", nil)))
+
+		cm := &unstructured.Unstructured{}
+		cm.SetAPIVersion("v1")
+		cm.SetKind("ConfigMap")
+		cm.SetName("mytest")
+		cm.SetNamespace("default")
+
+		Expect(h.getTestLogs(ctx, cm)).To(BeEmpty())
+	})
+
+	It("should truncate long test logs", func() {
+		short := strings.Repeat("a", maxTestLogLength)
+		Expect(truncateTestLogs(short)).To(Equal(short))
+
+		long := strings.Repeat("a", 10) + strings.Repeat("b", maxTestLogLength)
+		truncated := truncateTestLogs(long)
+		Expect(truncated).To(HavePrefix("\n... (truncated)"))
+		Expect(truncated).To(HaveSuffix(strings.Repeat("b", maxTestLogLength)))
+		Expect(truncated).To(HaveLen(len("\n... (truncated)") + maxTestLogLength))
+	})
+})
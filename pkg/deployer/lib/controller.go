@@ -8,6 +8,7 @@ import (
 	"context"
 	goerrors "errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -31,6 +32,7 @@ import (
 
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
 	lserrors "github.com/openmcp-project/landscaper/apis/errors"
 	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
@@ -54,6 +56,17 @@ type Deployer interface {
 	ExtensionHooks() extension.ReconcileExtensionHooks
 }
 
+// ReferencedDataHasher can be implemented by a Deployer whose provider configuration references data in Secrets or
+// ConfigMaps. A deploy item with UpdateOnChangeOnly is reconciled again if the hash of its referenced data differs
+// from the hash in its status. Changes of the referenced Secrets and ConfigMaps in the landscaper cluster start
+// a new job of the deploy item, see referencedDataController.
+type ReferencedDataHasher interface {
+	// ReferencedDataHash returns the hash of the data referenced by the deploy item, see DataHash.
+	ReferencedDataHash(ctx context.Context, di *lsv1alpha1.DeployItem, target *lsv1alpha1.ResolvedTarget) (string, error)
+	// DataReferences returns the references of the deploy item to data in Secrets and ConfigMaps.
+	DataReferences(di *lsv1alpha1.DeployItem) ([]managedresource.DataReference, error)
+}
+
 // DeployerArgs defines the deployer arguments for the initializing a generic deployer controller.
 type DeployerArgs struct {
 	Name            string
@@ -63,6 +76,9 @@ type DeployerArgs struct {
 	Deployer        Deployer
 	TargetSelectors []lsv1alpha1.TargetSelector
 	Options         ctrl.Options
+	// MaxConcurrentDeployItemsPerTarget limits the number of deploy items with the same target,
+	// which are processed concurrently. It is not limited if the value is not positive.
+	MaxConcurrentDeployItemsPerTarget int
 }
 
 // Default defaults deployer arguments
@@ -113,12 +129,19 @@ func Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient
 
 	log = log.Reconciles("", "DeployItem").WithValues(lc.KeyDeployItemType, string(args.Type))
 
-	return builder.ControllerManagedBy(lsMgr).
+	if err := builder.ControllerManagedBy(lsMgr).
 		Named(controllerName).
 		For(&lsv1alpha1.DeployItem{}, builder.WithPredicates(NewTypePredicate(args.Type)), builder.OnlyMetadata).
 		WithOptions(args.Options).
 		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
-		Complete(con)
+		Complete(con); err != nil {
+		return err
+	}
+
+	if _, ok := args.Deployer.(ReferencedDataHasher); ok {
+		return addReferencedDataController(lsUncachedClient, lsCachedClient, log, lsMgr, args, controllerName)
+	}
+	return nil
 }
 
 // controller reconciles deployitems and delegates the business logic to the configured Deployer.
@@ -145,6 +168,7 @@ type controller struct {
 	lockingEnabled bool
 	callerName     string
 	locker         lock.Locker
+	targetLimiter  *targetLimiter
 }
 
 // NewController creates a new generic deployitem controller.
@@ -183,6 +207,7 @@ func NewController(lsRestConfig *rest.Config,
 		lockingEnabled:  lockingEnabled,
 		callerName:      callerName,
 		locker:          *lock.NewLocker(lsUncachedClient, hostUncachedClient, callerName),
+		targetLimiter:   newTargetLimiter(args.MaxConcurrentDeployItemsPerTarget),
 	}
 }
 
@@ -228,6 +253,7 @@ func (c *controller) innerReconcile(ctx context.Context, req reconcile.Request)
 	if err := read_write_layer.GetMetaData(ctx, c.lsUncachedClient, req.NamespacedName, metadata, read_write_layer.R000042); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Debug(err.Error())
+			c.targetLimiter.release(req.String())
 			return reconcile.Result{}, nil
 		}
 		return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
@@ -243,6 +269,11 @@ func (c *controller) innerReconcile(ctx context.Context, req reconcile.Request)
 		return reconcile.Result{}, nil
 	}
 
+	if lsv1alpha1helper.HasSuspendedAnnotation(metadata.ObjectMeta) {
+		logger.Info("deploy item is suspended")
+		return reconcile.Result{}, nil
+	}
+
 	if c.lockingEnabled {
 		syncObject, err := c.locker.LockDI(ctx, metadata)
 		if err != nil {
@@ -271,6 +302,7 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 	if err := read_write_layer.GetDeployItem(ctx, c.lsUncachedClient, client.ObjectKeyFromObject(metadata), di, read_write_layer.R000035); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Debug(err.Error())
+			c.targetLimiter.release(client.ObjectKeyFromObject(metadata).String())
 			return reconcile.Result{}, nil
 		}
 		return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
@@ -288,8 +320,12 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 
 	hasTestReconcileAnnotation := lsv1alpha1helper.HasOperation(di.ObjectMeta, lsv1alpha1.TestReconcileOperation)
 
+	diKey := client.ObjectKeyFromObject(di).String()
+	targetKey := targetKeyOfResolvedTarget(rt)
+
 	if IsDeployItemFinished(di) {
 		logger.Debug("deploy item not reconciled because no new job ID or test reconcile annotation")
+		c.targetLimiter.release(diKey)
 		return reconcile.Result{}, nil
 	}
 
@@ -308,6 +344,7 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 		logger.Info(lsError.Error())
 		lsv1alpha1helper.SetDeployItemToFailed(di)
 		_ = c.handleReconcileResult(ctx, lsError, old, di)
+		c.targetLimiter.release(diKey)
 		return c.buildResult(ctx, di.Status.Phase, nil)
 	}
 
@@ -317,14 +354,23 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 			if di.Spec.UpdateOnChangeOnly &&
 				di.GetGeneration() == di.Status.ObservedGeneration &&
 				di.Status.Phase == lsv1alpha1.DeployItemPhases.Succeeded &&
-				!hasTestReconcileAnnotation {
+				!hasTestReconcileAnnotation &&
+				!lsv1alpha1helper.IsTargetContentChanged(di, rt) &&
+				!isReferencedDataChanged(ctx, c.deployer, di, rt) {
 
 				// deployitem is unchanged and succeeded, and no reconcile desired in this case
 				c.initStatus(ctx, di)
 				err := c.handleReconcileResult(ctx, nil, old, di)
 				return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 			}
+		}
 
+		// the new job is only started if the target of the deploy item has a free slot
+		if !c.targetLimiter.acquire(targetKey, diKey, false) {
+			return c.handleQueued(ctx, di, old)
+		}
+
+		if di.DeletionTimestamp.IsZero() {
 			// initialize deployitem for reconcile
 			logger.Debug("Setting deployitem to phase 'Init'", "updateOnChangeOnly", di.Spec.UpdateOnChangeOnly, lc.KeyGeneration, di.GetGeneration(), lc.KeyObservedGeneration, di.Status.ObservedGeneration, lc.KeyDeployItemPhase, di.Status.Phase)
 			di.Status.Phase = lsv1alpha1.DeployItemPhases.Init
@@ -340,6 +386,9 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 		}
 	}
 
+	// the deploy item is in progress and occupies a slot of its target, e.g. after a restart of the deployer
+	c.targetLimiter.acquire(targetKey, diKey, true)
+
 	// Create OCM context
 	octx := ocm.New(datacontext.MODE_EXTENDED)
 	defer func() {
@@ -349,16 +398,71 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 
 	// Deployitem has been initialized, proceed with reconcile/delete
 
+	var lsError lserrors.LsError
 	if di.DeletionTimestamp.IsZero() {
-		lsError := c.reconcile(ctx, di, rt)
-		_ = c.handleReconcileResult(ctx, lsError, old, di)
-		return c.buildResult(ctx, di.Status.Phase, lsError)
-
+		lsError = c.reconcile(ctx, di, rt)
 	} else {
-		lsError := c.delete(ctx, di, rt)
-		_ = c.handleReconcileResult(ctx, lsError, old, di)
-		return c.buildResult(ctx, di.Status.Phase, lsError)
+		lsError = c.delete(ctx, di, rt)
+	}
+	_ = c.handleReconcileResult(ctx, lsError, old, di)
+	if di.Status.Phase.IsFinal() {
+		c.targetLimiter.release(diKey)
 	}
+	return c.buildResult(ctx, di.Status.Phase, lsError)
+}
+
+// isReferencedDataChanged returns whether the data referenced by the configuration of the deploy item has changed
+// since its last reconciliation. If the data cannot be read, it is considered as changed, so that the reconciliation
+// reports the problem.
+func isReferencedDataChanged(ctx context.Context, deployer Deployer, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) bool {
+	hasher, ok := deployer.(ReferencedDataHasher)
+	if !ok {
+		return false
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	hash, err := hasher.ReferencedDataHash(ctx, di, rt)
+	if err != nil {
+		logger.Info("unable to compute the hash of the referenced data", lc.KeyError, err.Error())
+		return true
+	}
+	return hash != di.Status.ReferencedDataHash
+}
+
+// handleQueued reports that the deploy item waits until its target has a free slot, and requeues the deploy item.
+// The deploy item keeps its phase, so that the timeout of the new job only starts when the job is started.
+func (c *controller) handleQueued(ctx context.Context, di, old *lsv1alpha1.DeployItem) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	logger.Info("deploy item is queued, because the maximum number of concurrent deploy items of its target is reached")
+
+	// mark the deploy item as picked up, so that no pickup timeout occurs
+	if di.Status.LastReconcileTime == nil || di.Status.LastReconcileTime.Before(di.Status.JobIDGenerationTime) {
+		now := metav1.Now()
+		di.Status.LastReconcileTime = &now
+		di.Status.Deployer = c.info
+	}
+
+	msg := fmt.Sprintf("deploy item is queued, because the maximum number of %d concurrent deploy items of its target is reached",
+		c.targetLimiter.maxPerTarget)
+	lsError := lserrors.NewError("handleQueued", lsv1alpha1.TargetConcurrencyLimitReachedReason, msg,
+		lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly)
+	di.Status.LastError = lserrors.TryUpdateLsError(di.Status.LastError, lsError)
+
+	if !reflect.DeepEqual(&old.Status, &di.Status) {
+		if err := c.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000168, di); err != nil {
+			return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
+// targetKeyOfResolvedTarget returns the key of the target of a deploy item, or an empty string if it has no target.
+func targetKeyOfResolvedTarget(rt *lsv1alpha1.ResolvedTarget) string {
+	if rt == nil || rt.Target == nil {
+		return ""
+	}
+	return client.ObjectKeyFromObject(rt.Target).String()
 }
 
 func (c *controller) handleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployItem, deployItem *lsv1alpha1.DeployItem) error {
@@ -419,6 +523,13 @@ func (c *controller) reconcile(ctx context.Context, deployItem *lsv1alpha1.Deplo
 		return lserrors.NewWrappedError(err, operation, "ValidateTarget", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
 
+	// remember the target content with which the deploy item is reconciled, so that a change of the content can be detected
+	if deployItem.Spec.ReconcileOnTargetChange {
+		deployItem.Status.TargetContentHash = lsv1alpha1helper.ResolvedTargetContentHash(rt)
+	} else {
+		deployItem.Status.TargetContentHash = ""
+	}
+
 	lsCtx, lsErr := c.getContext(ctx, deployItem, operation)
 	if lsErr != nil {
 		return lsErr
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+// DataReferenceResolver reads the data in Secrets and ConfigMaps which is referenced by the configuration of a deploy item.
+type DataReferenceResolver struct {
+	LsClient   client.Client
+	DeployItem *lsv1alpha1.DeployItem
+	// GetTargetClient returns the client for the target cluster.
+	// It is only called if one of the references reads from the target cluster.
+	GetTargetClient func(ctx context.Context) (client.Client, error)
+}
+
+// Resolve returns the referenced data in the order of the references.
+// Secrets and ConfigMaps in the landscaper cluster are only read from the namespace of the deploy item,
+// so that a deploy item cannot access data in other namespaces.
+func (r *DataReferenceResolver) Resolve(ctx context.Context, refs []managedresource.DataReference) ([][]byte, lserrors.LsError) {
+	op := "ResolveDataReferences"
+
+	var targetClient client.Client
+	if managedresource.HasTargetReference(refs) {
+		var err error
+		targetClient, err = r.GetTargetClient(ctx)
+		if err != nil {
+			return nil, lserrors.NewWrappedError(err, op, "GetTargetClient", err.Error())
+		}
+	}
+
+	result := make([][]byte, 0, len(refs))
+	for i, ref := range refs {
+		c := r.LsClient
+		if ref.FromTarget {
+			c = targetClient
+		}
+
+		data, err := r.resolve(ctx, c, ref)
+		if err != nil {
+			return nil, lserrors.NewWrappedError(err, op, "ResolveDataReference",
+				fmt.Sprintf("unable to resolve data reference %d: %s", i, err.Error()))
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+func (r *DataReferenceResolver) resolve(ctx context.Context, c client.Client, ref managedresource.DataReference) ([]byte, error) {
+	var objRef lsv1alpha1.ObjectReference
+	var key string
+	if ref.SecretRef != nil {
+		objRef, key = ref.SecretRef.ObjectReference, ref.SecretRef.Key
+	} else if ref.ConfigMapRef != nil {
+		objRef, key = ref.ConfigMapRef.ObjectReference, ref.ConfigMapRef.Key
+	} else {
+		return nil, fmt.Errorf("neither a secret nor a configmap is referenced")
+	}
+
+	if !ref.FromTarget {
+		if len(objRef.Namespace) == 0 {
+			objRef.Namespace = r.DeployItem.Namespace
+		} else if objRef.Namespace != r.DeployItem.Namespace {
+			return nil, fmt.Errorf("%s must be in the namespace %s of the deploy item", objRef.NamespacedName().String(), r.DeployItem.Namespace)
+		}
+	}
+
+	if ref.SecretRef != nil {
+		secret := &corev1.Secret{}
+		if err := read_write_layer.GetSecret(ctx, c, objRef.NamespacedName(), secret, read_write_layer.R000137); err != nil {
+			return nil, fmt.Errorf("unable to get secret %s: %w", objRef.NamespacedName().String(), err)
+		}
+		data, ok := secret.Data[key]
+		if !ok {
+			return nil, fmt.Errorf("secret %s has no key %q", objRef.NamespacedName().String(), key)
+		}
+		return data, nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := read_write_layer.GetObject(ctx, c, objRef.NamespacedName(), cm, read_write_layer.R000138); err != nil {
+		return nil, fmt.Errorf("unable to get configmap %s: %w", objRef.NamespacedName().String(), err)
+	}
+	if data, ok := cm.Data[key]; ok {
+		return []byte(data), nil
+	}
+	if data, ok := cm.BinaryData[key]; ok {
+		return data, nil
+	}
+	return nil, fmt.Errorf("configmap %s has no key %q", objRef.NamespacedName().String(), key)
+}
+
+// DataHash returns a hash of resolved data references, or an empty string if there is no data.
+func DataHash(data [][]byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	for _, d := range data {
+		// the length prefix separates the data of the references
+		_, _ = fmt.Fprintf(h, "%d:", len(d))
+		_, _ = h.Write(d)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
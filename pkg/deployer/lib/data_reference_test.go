@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
+	"github.com/openmcp-project/landscaper/pkg/api"
+)
+
+var _ = Describe("Data references", func() {
+
+	var (
+		ctx          context.Context
+		lsClient     client.Client
+		targetClient client.Client
+		resolver     *DataReferenceResolver
+	)
+
+	secretRef := func(namespace, name, key string) *lsv1alpha1.SecretReference {
+		return &lsv1alpha1.SecretReference{ObjectReference: lsv1alpha1.ObjectReference{Name: name, Namespace: namespace}, Key: key}
+	}
+
+	configMapRef := func(namespace, name, key string) *lsv1alpha1.ConfigMapReference {
+		return &lsv1alpha1.ConfigMapReference{ObjectReference: lsv1alpha1.ObjectReference{Name: name, Namespace: namespace}, Key: key}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		lsClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithObjects(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "default"},
+					Data:       map[string][]byte{"values.yaml": []byte("password: secret")},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "other"},
+					Data:       map[string][]byte{"values.yaml": []byte("password: other")},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "default"},
+					Data:       map[string]string{"values.yaml": "replicas: 2"},
+				},
+			).
+			Build()
+		targetClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "other"},
+				Data:       map[string]string{"values.yaml": "replicas: 3"},
+			}).
+			Build()
+		resolver = &DataReferenceResolver{
+			LsClient: lsClient,
+			DeployItem: &lsv1alpha1.DeployItem{
+				ObjectMeta: metav1.ObjectMeta{Name: "di", Namespace: "default"},
+			},
+			GetTargetClient: func(_ context.Context) (client.Client, error) {
+				return targetClient, nil
+			},
+		}
+	})
+
+	It("should resolve secrets and configmaps in the order of the references", func() {
+		data, err := resolver.Resolve(ctx, []managedresource.DataReference{
+			{SecretRef: secretRef("", "values", "values.yaml")},
+			{ConfigMapRef: configMapRef("default", "values", "values.yaml")},
+			{ConfigMapRef: configMapRef("other", "values", "values.yaml"), FromTarget: true},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal([][]byte{[]byte("password: secret"), []byte("replicas: 2"), []byte("replicas: 3")}))
+	})
+
+	It("should not read secrets from other namespaces of the landscaper cluster", func() {
+		_, err := resolver.Resolve(ctx, []managedresource.DataReference{
+			{SecretRef: secretRef("other", "values", "values.yaml")},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("must be in the namespace default of the deploy item"))
+	})
+
+	It("should fail if a referenced key does not exist", func() {
+		_, err := resolver.Resolve(ctx, []managedresource.DataReference{
+			{ConfigMapRef: configMapRef("", "values", "missing")},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`has no key "missing"`))
+	})
+
+	It("should compute a hash which changes with the referenced data", func() {
+		Expect(DataHash(nil)).To(BeEmpty())
+
+		hash := DataHash([][]byte{[]byte("a"), []byte("bc")})
+		Expect(hash).ToNot(BeEmpty())
+		Expect(DataHash([][]byte{[]byte("a"), []byte("bc")})).To(Equal(hash))
+		Expect(DataHash([][]byte{[]byte("ab"), []byte("c")})).ToNot(Equal(hash))
+	})
+
+})
@@ -42,6 +42,8 @@ type CustomReadinessCheck struct {
 	LsClient            client.Client
 	DeployItem          *lsv1alpha1.DeployItem
 	LsRestConfig        *rest.Config
+	// ReportObjectStatus is an optional function which is called with the result of each check of an object.
+	ReportObjectStatus ReportObjectStatusFunc
 }
 
 // CheckResourcesReady starts a custom readiness check by checking the readiness of the submitted resources
@@ -78,7 +80,7 @@ func (c *CustomReadinessCheck) CheckResourcesReady(ctx context.Context) error {
 	}
 
 	timeout := c.Timeout.Duration
-	if err := WaitForObjectsReady(ctx, timeout, targetClient, getObjectsFunc, c.CheckObject, c.InterruptionChecker, c.CurrentOp); err != nil {
+	if err := WaitForObjectsReady(ctx, timeout, targetClient, getObjectsFunc, c.CheckObject, c.InterruptionChecker, c.CurrentOp, c.ReportObjectStatus); err != nil {
 		return err
 	}
 
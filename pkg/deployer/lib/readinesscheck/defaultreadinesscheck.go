@@ -30,6 +30,8 @@ type DefaultReadinessCheck struct {
 	ManagedResources    []lsv1alpha1.TypedObjectReference
 	FailOnMissingObject bool
 	InterruptionChecker interruption.InterruptionChecker
+	// ReportObjectStatus is an optional function which is called with the result of each check of an object.
+	ReportObjectStatus ReportObjectStatusFunc
 }
 
 // CheckResourcesReady implements the default readiness check for Kubernetes manifests
@@ -57,7 +59,7 @@ func (d *DefaultReadinessCheck) CheckResourcesReady() error {
 	}
 
 	timeout := d.Timeout.Duration
-	if err := WaitForObjectsReady(d.Context, timeout, d.Client, getObjectsFunc, d.CheckObject, d.InterruptionChecker, d.CurrentOp); err != nil {
+	if err := WaitForObjectsReady(d.Context, timeout, d.Client, getObjectsFunc, d.CheckObject, d.InterruptionChecker, d.CurrentOp, d.ReportObjectStatus); err != nil {
 		return err
 	}
 
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package readinesscheck
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
+)
+
+// ReportToManagedResources returns a function which records the results of the readiness checks in the
+// corresponding entries of the given managed resources. Objects which are not contained in the list are ignored.
+func ReportToManagedResources(managedResources managedresource.ManagedResourceStatusList) ReportObjectStatusFunc {
+	return func(obj *unstructured.Unstructured, status StatusType, err error) {
+		mr := managedResources.Find(corev1.ObjectReference{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		})
+		if mr == nil {
+			return
+		}
+
+		if status == StatusReady {
+			mr.SetState(mr.ApplyState, managedresource.ReadinessStateReady, "", metav1.Now())
+			return
+		}
+
+		message := ""
+		if err != nil {
+			message = err.Error()
+		}
+		mr.SetState(mr.ApplyState, managedresource.ReadinessStateNotReady, message, metav1.Now())
+	}
+}
+
+// SetManagedResourcesReady marks all applied managed resources as ready.
+// It is called when all readiness checks succeeded, so that also the resources that are not relevant for the
+// readiness checks are marked as ready.
+func SetManagedResourcesReady(managedResources managedresource.ManagedResourceStatusList) {
+	now := metav1.Now()
+	for i := range managedResources {
+		mr := &managedResources[i]
+		if mr.ApplyState == managedresource.ApplyStateApplied {
+			mr.SetState(mr.ApplyState, managedresource.ReadinessStateReady, "", now)
+		}
+	}
+}
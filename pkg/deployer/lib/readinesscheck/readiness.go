@@ -36,10 +36,16 @@ type checkObjectFunc func(*unstructured.Unstructured) error
 
 type ObjectsToWatchFunc func() ([]*unstructured.Unstructured, error)
 
+// ReportObjectStatusFunc is called with the result of each readiness check of an object.
+// The error describes why the object is not ready; it is nil if the object is ready.
+type ReportObjectStatusFunc func(obj *unstructured.Unstructured, status StatusType, err error)
+
 // WaitForObjectsReady waits for objects to be heatlhy and
 // returns an error if all the objects are not ready after the timeout.
+// All objects are checked in each try, and the result of each check is reported to the optional reportStatus function.
 func WaitForObjectsReady(ctx context.Context, timeout time.Duration, kubeClient client.Client,
-	getObjects ObjectsToWatchFunc, fn checkObjectFunc, interruptionChecker interruption.InterruptionChecker, operation string) error {
+	getObjects ObjectsToWatchFunc, fn checkObjectFunc, interruptionChecker interruption.InterruptionChecker, operation string,
+	reportStatus ReportObjectStatusFunc) error {
 	var (
 		try     int32 = 1
 		err     error
@@ -68,24 +74,35 @@ func WaitForObjectsReady(ctx context.Context, timeout time.Duration, kubeClient
 			}
 		}
 
+		allReady := true
 		for _, obj := range objects {
-			checkpoint = fmt.Sprintf("deployer: during readiness check - resource %s/%s of type %s",
-				obj.GetNamespace(), obj.GetName(), obj.GetKind())
-
 			if err = IsObjectReady(ctx, kubeClient, obj, fn); err != nil {
+				if allReady {
+					// the checkpoint refers to the first object that is not ready
+					checkpoint = fmt.Sprintf("deployer: during readiness check - resource %s/%s of type %s",
+						obj.GetNamespace(), obj.GetName(), obj.GetKind())
+				}
+				allReady = false
+
 				if IsRecoverableError(err) {
 					log.Info(fmt.Sprintf("WaitForObjectsReady: resource %s/%s of type %s is not ready",
 						obj.GetNamespace(), obj.GetName(), obj.GetKind()))
-					return false, nil
 				} else {
 					log.Error(err, fmt.Sprintf("WaitForObjectsReady: resource %s/%s of type %s is not ready",
 						obj.GetNamespace(), obj.GetName(), obj.GetKind()))
-					return false, nil
 				}
+				if reportStatus != nil {
+					reportStatus(obj, StatusNotReady, err)
+				}
+				continue
+			}
+
+			if reportStatus != nil {
+				reportStatus(obj, StatusReady, nil)
 			}
 		}
 
-		return true, nil
+		return allReady, nil
 	})
 
 	if wait.Interrupted(err) {
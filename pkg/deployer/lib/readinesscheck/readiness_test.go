@@ -13,9 +13,11 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
 	mock_client "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes/mock"
 	"github.com/openmcp-project/landscaper/pkg/deployer/lib/interruption"
 )
@@ -125,9 +127,73 @@ var _ = Describe("WaitForObjectsReady", func() {
 			checkObjectsFunc,
 			interruption.NewIgnoreInterruptionChecker(),
 			"test",
+			nil,
 		)
 
 		Expect(err).ToNot(HaveOccurred())
 	})
 
 })
+
+var _ = Describe("ReportToManagedResources", func() {
+
+	var managedResources managedresource.ManagedResourceStatusList
+
+	BeforeEach(func() {
+		pod := createUnstructuredPod()
+		managedResources = managedresource.ManagedResourceStatusList{
+			{
+				Resource: corev1.ObjectReference{
+					APIVersion: pod.GetAPIVersion(),
+					Kind:       pod.GetKind(),
+					Name:       pod.GetName(),
+					Namespace:  pod.GetNamespace(),
+				},
+				ApplyState:     managedresource.ApplyStateApplied,
+				ReadinessState: managedresource.ReadinessStatePending,
+			},
+			{
+				Resource: corev1.ObjectReference{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Name:       "Foo",
+					Namespace:  "default",
+				},
+				ApplyState:     managedresource.ApplyStateApplied,
+				ReadinessState: managedresource.ReadinessStatePending,
+			},
+		}
+	})
+
+	It("should record the readiness of the checked objects", func() {
+		report := ReportToManagedResources(managedResources)
+
+		report(createUnstructuredPod(), StatusNotReady, fmt.Errorf("it is not ready"))
+		Expect(managedResources[0].ReadinessState).To(Equal(managedresource.ReadinessStateNotReady))
+		Expect(managedResources[0].Message).To(Equal("it is not ready"))
+		Expect(managedResources[0].LastTransitionTime).ToNot(BeNil())
+		Expect(managedResources[1].ReadinessState).To(Equal(managedresource.ReadinessStatePending))
+
+		report(createUnstructuredPod(), StatusReady, nil)
+		Expect(managedResources[0].ReadinessState).To(Equal(managedresource.ReadinessStateReady))
+		Expect(managedResources[0].Message).To(BeEmpty())
+	})
+
+	It("should ignore objects which are not managed", func() {
+		obj := createUnstructuredPod()
+		obj.SetName("Bar")
+
+		ReportToManagedResources(managedResources)(obj, StatusNotReady, fmt.Errorf("it is not ready"))
+		Expect(managedResources[0].ReadinessState).To(Equal(managedresource.ReadinessStatePending))
+		Expect(managedResources[1].ReadinessState).To(Equal(managedresource.ReadinessStatePending))
+	})
+
+	It("should mark all applied resources as ready", func() {
+		managedResources[1].ApplyState = managedresource.ApplyStateFailed
+		managedResources[1].ReadinessState = ""
+
+		SetManagedResourcesReady(managedResources)
+		Expect(managedResources[0].ReadinessState).To(Equal(managedresource.ReadinessStateReady))
+		Expect(managedResources[1].ReadinessState).To(BeEmpty())
+	})
+})
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/openmcp-project/landscaper/pkg/utils/jobs"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	kindSecret    = "Secret"
+	kindConfigMap = "ConfigMap"
+
+	// reconcileReasonReferencedDataChange is the value of the reconcile reason annotation of installations
+	// which are reconciled because data referenced by one of their deploy items has changed.
+	reconcileReasonReferencedDataChange = "referenced-data-change"
+
+	// referencedDataRequeueInterval is the interval after which a deploy item is checked again, if no new job
+	// could be started because a job of its installation or execution is still running.
+	referencedDataRequeueInterval = 30 * time.Second
+)
+
+// addReferencedDataController adds a controller which watches the Secrets and ConfigMaps in the landscaper cluster,
+// and starts a new job of the deploy items with UpdateOnChangeOnly whose referenced data has changed.
+// Without it, such a change would only be noticed when the next job of the deploy item is started.
+// Secrets and ConfigMaps in target clusters are not watched.
+func addReferencedDataController(lsUncachedClient, lsCachedClient client.Client, log logging.Logger,
+	lsMgr manager.Manager, args DeployerArgs, controllerName string) error {
+
+	c := newReferencedDataController(lsUncachedClient, lsCachedClient, log, args)
+
+	if err := lsMgr.GetFieldIndexer().IndexField(context.Background(), &lsv1alpha1.DeployItem{},
+		c.indexName(), c.indexDeployItemByReferencedData); err != nil {
+		return err
+	}
+
+	return builder.ControllerManagedBy(lsMgr).
+		Named(controllerName+"-referenced-data").
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(c.deployItemsForObject(kindSecret)), builder.OnlyMetadata).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(c.deployItemsForObject(kindConfigMap)), builder.OnlyMetadata).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(c)
+}
+
+func newReferencedDataController(lsUncachedClient, lsCachedClient client.Client, log logging.Logger,
+	args DeployerArgs) *referencedDataController {
+	return &referencedDataController{
+		lsUncachedClient: lsUncachedClient,
+		lsCachedClient:   lsCachedClient,
+		log:              log,
+		deployer:         args.Deployer,
+		deployerType:     args.Type,
+		targetSelectors:  args.TargetSelectors,
+	}
+}
+
+// referencedDataController reconciles the deploy items which reference a changed Secret or ConfigMap.
+// The deploy items are processed by a separate controller, as the deploy item controller ignores deploy items
+// whose job is finished.
+type referencedDataController struct {
+	lsUncachedClient client.Client
+	lsCachedClient   client.Client
+	log              logging.Logger
+
+	deployer        Deployer
+	deployerType    lsv1alpha1.DeployItemType
+	targetSelectors []lsv1alpha1.TargetSelector
+}
+
+func (c *referencedDataController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := c.log.StartReconcile(req)
+	ctx = logging.NewContext(ctx, logger)
+
+	di := &lsv1alpha1.DeployItem{}
+	if err := read_write_layer.GetDeployItem(ctx, c.lsUncachedClient, req.NamespacedName, di, read_write_layer.R000143); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !isWaitingForReferencedDataChange(di) {
+		return reconcile.Result{}, nil
+	}
+
+	metadata := &metav1.PartialObjectMetadata{ObjectMeta: di.ObjectMeta}
+	rt, responsible, targetNotFound, lsErr := CheckResponsibility(ctx, c.lsUncachedClient, metadata, c.deployerType, c.targetSelectors)
+	if lsErr != nil {
+		return reconcile.Result{}, lsErr
+	}
+	if !responsible || targetNotFound {
+		return reconcile.Result{}, nil
+	}
+
+	if !isReferencedDataChanged(ctx, c.deployer, di, rt) {
+		return reconcile.Result{}, nil
+	}
+
+	// the job is started like the jobs of the deploy item usually are, so that its result reaches its execution
+	// and installations
+	logger.Info("starting a new job of the deploy item, because its referenced data has changed")
+	started, err := jobs.NewStarter(c.lsUncachedClient, reconcileReasonReferencedDataChange).StartJob(ctx, di)
+	if err != nil {
+		if apierrors.IsConflict(err) {
+			return reconcile.Result{RequeueAfter: referencedDataRequeueInterval}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if !started {
+		// a job of the owning installation or execution is still running, so check again when it is finished
+		return reconcile.Result{RequeueAfter: referencedDataRequeueInterval}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// isWaitingForReferencedDataChange returns whether a new job of a deploy item should be started if its referenced
+// data changes. This holds for deploy items with UpdateOnChangeOnly whose last job is finished, as they would
+// otherwise not be reconciled again before the next job of their execution.
+func isWaitingForReferencedDataChange(di *lsv1alpha1.DeployItem) bool {
+	return di.Spec.UpdateOnChangeOnly &&
+		di.DeletionTimestamp.IsZero() &&
+		!lsv1alpha1helper.HasSuspendedAnnotation(di.ObjectMeta) &&
+		di.Status.GetJobID() == di.Status.JobIDFinished &&
+		(di.Status.Phase == lsv1alpha1.DeployItemPhases.Succeeded || di.Status.Phase == lsv1alpha1.DeployItemPhases.Failed)
+}
+
+// deployItemsForObject returns a function which maps a Secret resp. ConfigMap to reconcile requests for the deploy
+// items which reference it.
+func (c *referencedDataController) deployItemsForObject(kind string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+		deployItems := &lsv1alpha1.DeployItemList{}
+		if err := read_write_layer.ListDeployItems(ctx, c.lsCachedClient, deployItems, read_write_layer.R000142,
+			client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{c.indexName(): referencedDataIndexValue(kind, obj.GetName())}); err != nil {
+			logger.Error(err, "unable to list deploy items", lc.KeyResource, client.ObjectKeyFromObject(obj).String())
+			return nil
+		}
+
+		requests := []reconcile.Request{}
+		for _, di := range deployItems.Items {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&di)})
+		}
+		return requests
+	}
+}
+
+// indexName returns the name of the index of deploy items by the Secrets and ConfigMaps they reference.
+// The name contains the deploy item type, as several deployers can share a manager.
+func (c *referencedDataController) indexName() string {
+	return "referencedData." + string(c.deployerType)
+}
+
+// indexDeployItemByReferencedData returns the Secrets and ConfigMaps in the landscaper cluster which are referenced
+// by a deploy item. References to other namespaces are omitted, as they are rejected by the DataReferenceResolver.
+func (c *referencedDataController) indexDeployItemByReferencedData(obj client.Object) []string {
+	di, ok := obj.(*lsv1alpha1.DeployItem)
+	if !ok || di.Spec.Type != c.deployerType {
+		return nil
+	}
+	hasher, ok := c.deployer.(ReferencedDataHasher)
+	if !ok {
+		return nil
+	}
+	refs, err := hasher.DataReferences(di)
+	if err != nil {
+		return nil
+	}
+
+	values := []string{}
+	for _, ref := range refs {
+		if ref.FromTarget {
+			continue
+		}
+		if ref.SecretRef != nil && isInNamespace(ref.SecretRef.ObjectReference, di.Namespace) {
+			values = append(values, referencedDataIndexValue(kindSecret, ref.SecretRef.Name))
+		} else if ref.ConfigMapRef != nil && isInNamespace(ref.ConfigMapRef.ObjectReference, di.Namespace) {
+			values = append(values, referencedDataIndexValue(kindConfigMap, ref.ConfigMapRef.Name))
+		}
+	}
+	return values
+}
+
+func referencedDataIndexValue(kind, name string) string {
+	return kind + "/" + name
+}
+
+func isInNamespace(ref lsv1alpha1.ObjectReference, namespace string) bool {
+	return len(ref.Namespace) == 0 || ref.Namespace == namespace
+}
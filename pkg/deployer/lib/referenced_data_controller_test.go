@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/api"
+)
+
+// referencingDeployer is a deployer whose provider configuration is a list of data references.
+type referencingDeployer struct {
+	Deployer
+	lsClient client.Client
+}
+
+func (d *referencingDeployer) DataReferences(di *lsv1alpha1.DeployItem) ([]managedresource.DataReference, error) {
+	refs := []managedresource.DataReference{}
+	if err := json.Unmarshal(di.Spec.Configuration.Raw, &refs); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+func (d *referencingDeployer) ReferencedDataHash(ctx context.Context, di *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) (string, error) {
+	refs, err := d.DataReferences(di)
+	if err != nil {
+		return "", err
+	}
+	resolver := &DataReferenceResolver{LsClient: d.lsClient, DeployItem: di}
+	data, lsErr := resolver.Resolve(ctx, refs)
+	if lsErr != nil {
+		return "", lsErr
+	}
+	return DataHash(data), nil
+}
+
+var _ = Describe("Referenced data controller", func() {
+
+	const deployerType lsv1alpha1.DeployItemType = "landscaper.gardener.cloud/referencing"
+
+	var (
+		ctx      context.Context
+		lsClient client.Client
+		c        *referencedDataController
+	)
+
+	newDeployItem := func(name string, refs ...managedresource.DataReference) *lsv1alpha1.DeployItem {
+		raw, err := json.Marshal(refs)
+		Expect(err).ToNot(HaveOccurred())
+		di := &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: lsv1alpha1.DeployItemSpec{
+				Type:               deployerType,
+				Configuration:      &runtime.RawExtension{Raw: raw},
+				UpdateOnChangeOnly: true,
+			},
+		}
+		di.Status.SetJobID("job-1")
+		di.Status.JobIDFinished = "job-1"
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		return di
+	}
+
+	secretRef := func(namespace, name string) managedresource.DataReference {
+		return managedresource.DataReference{
+			SecretRef: &lsv1alpha1.SecretReference{ObjectReference: lsv1alpha1.ObjectReference{Name: name, Namespace: namespace}, Key: "values.yaml"},
+		}
+	}
+
+	configMapRef := func(name string) managedresource.DataReference {
+		return managedresource.DataReference{
+			ConfigMapRef: &lsv1alpha1.ConfigMapReference{ObjectReference: lsv1alpha1.ObjectReference{Name: name}, Key: "values.yaml"},
+		}
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "default"},
+		Data:       map[string][]byte{"values.yaml": []byte("password: secret")},
+	}
+	secretHash := DataHash([][]byte{[]byte("password: secret")})
+
+	setup := func(objects ...client.Object) {
+		ctx = context.Background()
+		deployer := &referencingDeployer{}
+		c = newReferencedDataController(nil, nil, logging.Discard(), DeployerArgs{Type: deployerType, Deployer: deployer})
+		lsClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithIndex(&lsv1alpha1.DeployItem{}, c.indexName(), c.indexDeployItemByReferencedData).
+			WithStatusSubresource(&lsv1alpha1.DeployItem{}, &lsv1alpha1.Execution{}, &lsv1alpha1.Installation{}).
+			WithObjects(append(objects, secret.DeepCopy())...).
+			Build()
+		deployer.lsClient = lsClient
+		c.lsUncachedClient = lsClient
+		c.lsCachedClient = lsClient
+	}
+
+	reconcileDeployItem := func(di *lsv1alpha1.DeployItem) *lsv1alpha1.DeployItem {
+		_, err := c.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(di)})
+		Expect(err).ToNot(HaveOccurred())
+		result := &lsv1alpha1.DeployItem{}
+		Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(di), result)).To(Succeed())
+		return result
+	}
+
+	It("should map a secret or configmap to the deploy items which reference it", func() {
+		fromTarget := secretRef("default", "values")
+		fromTarget.FromTarget = true
+		otherType := newDeployItem("other-type", secretRef("", "values"))
+		otherType.Spec.Type = "landscaper.gardener.cloud/other"
+
+		setup(
+			newDeployItem("secret", secretRef("", "values")),
+			newDeployItem("secret-with-namespace", configMapRef("config"), secretRef("default", "values")),
+			newDeployItem("configmap", configMapRef("values")),
+			newDeployItem("from-target", fromTarget),
+			newDeployItem("other-namespace", secretRef("other", "values")),
+			otherType,
+		)
+
+		request := func(name string) reconcile.Request {
+			return reconcile.Request{NamespacedName: client.ObjectKey{Name: name, Namespace: "default"}}
+		}
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "default"}}
+		otherSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "other"}}
+
+		Expect(c.deployItemsForObject(kindSecret)(ctx, secret)).To(ConsistOf(request("secret"), request("secret-with-namespace")))
+		Expect(c.deployItemsForObject(kindConfigMap)(ctx, configMap)).To(ConsistOf(request("configmap")))
+		Expect(c.deployItemsForObject(kindSecret)(ctx, otherSecret)).To(BeEmpty())
+	})
+
+	It("should start a new job of a deploy item whose referenced data has changed", func() {
+		di := newDeployItem("item", secretRef("", "values"))
+		di.Status.ReferencedDataHash = "outdated"
+		setup(di)
+
+		result := reconcileDeployItem(di)
+		Expect(result.Status.GetJobID()).ToNot(Equal("job-1"))
+		Expect(result.Status.JobIDFinished).To(Equal("job-1"))
+		Expect(result.Status.JobIDGenerationTime).ToNot(BeNil())
+	})
+
+	It("should start a new job of a deploy item whose referenced data cannot be read", func() {
+		di := newDeployItem("item", secretRef("", "missing"))
+		di.Status.ReferencedDataHash = secretHash
+		setup(di)
+
+		result := reconcileDeployItem(di)
+		Expect(result.Status.GetJobID()).ToNot(Equal("job-1"))
+	})
+
+	It("should not start a new job of a deploy item whose referenced data is unchanged", func() {
+		di := newDeployItem("item", secretRef("", "values"))
+		di.Status.ReferencedDataHash = secretHash
+		setup(di)
+
+		result := reconcileDeployItem(di)
+		Expect(result.Status.GetJobID()).To(Equal("job-1"))
+	})
+
+	It("should not start a new job of a deploy item without updateOnChangeOnly", func() {
+		di := newDeployItem("item", secretRef("", "values"))
+		di.Spec.UpdateOnChangeOnly = false
+		di.Status.ReferencedDataHash = "outdated"
+		setup(di)
+
+		result := reconcileDeployItem(di)
+		Expect(result.Status.GetJobID()).To(Equal("job-1"))
+	})
+
+	It("should not start a new job of a deploy item whose job is running", func() {
+		di := newDeployItem("item", secretRef("", "values"))
+		di.Status.SetJobID("job-2")
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+		di.Status.ReferencedDataHash = "outdated"
+		setup(di)
+
+		result := reconcileDeployItem(di)
+		Expect(result.Status.GetJobID()).To(Equal("job-2"))
+		Expect(result.Status.JobIDFinished).To(Equal("job-1"))
+	})
+	Context("deploy items managed by an execution", func() {
+
+		var (
+			inst *lsv1alpha1.Installation
+			exec *lsv1alpha1.Execution
+		)
+
+		newManagedDeployItem := func() *lsv1alpha1.DeployItem {
+			di := newDeployItem("item", secretRef("", "values"))
+			di.Labels = map[string]string{lsv1alpha1.ExecutionManagedByLabel: exec.Name}
+			di.Status.ReferencedDataHash = "outdated"
+			return di
+		}
+
+		BeforeEach(func() {
+			inst = &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "default"}}
+			inst.Status.JobID = "job-1"
+			inst.Status.JobIDFinished = "job-1"
+			exec = &lsv1alpha1.Execution{ObjectMeta: metav1.ObjectMeta{
+				Name:      "root",
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "Installation",
+					Name:       inst.Name,
+					UID:        types.UID("uid-" + inst.Name),
+				}},
+			}}
+			exec.Status.JobID = "job-1"
+			exec.Status.JobIDFinished = "job-1"
+		})
+
+		It("should start a new job for the root installation instead of the deploy item", func() {
+			di := newManagedDeployItem()
+			setup(inst, exec, di)
+
+			result := reconcileDeployItem(di)
+			Expect(result.Status.GetJobID()).To(Equal("job-1"))
+
+			updated := &lsv1alpha1.Installation{}
+			Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(inst), updated)).To(Succeed())
+			Expect(lsv1alpha1helper.HasOperation(updated.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeTrue())
+			Expect(updated.Annotations).To(HaveKeyWithValue(lsv1alpha1.ReconcileReasonAnnotation, reconcileReasonReferencedDataChange))
+		})
+
+		It("should start a new job for an execution without installation", func() {
+			exec.OwnerReferences = nil
+			di := newManagedDeployItem()
+			setup(exec, di)
+
+			result := reconcileDeployItem(di)
+			Expect(result.Status.GetJobID()).To(Equal("job-1"))
+
+			updated := &lsv1alpha1.Execution{}
+			Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(exec), updated)).To(Succeed())
+			Expect(updated.Status.JobID).ToNot(Equal("job-1"))
+			Expect(updated.Status.JobIDFinished).To(Equal("job-1"))
+		})
+
+		It("should check the deploy item again if a job of its root installation is running", func() {
+			inst.Status.JobID = "job-2"
+			di := newManagedDeployItem()
+			setup(inst, exec, di)
+
+			res, err := c.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(di)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.RequeueAfter).ToNot(BeZero())
+
+			updated := &lsv1alpha1.Installation{}
+			Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(inst), updated)).To(Succeed())
+			Expect(lsv1alpha1helper.HasOperation(updated.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeFalse())
+		})
+	})
+})
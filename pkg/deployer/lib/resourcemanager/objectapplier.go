@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -71,6 +72,8 @@ type ManifestApplierOptions struct {
 	Labels                     map[string]string
 	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition
 	InterruptionChecker        interruption.InterruptionChecker
+	// TakeoverPolicy defines whether objects which are owned by another deploy item are taken over.
+	TakeoverPolicy managedresource.TakeoverPolicy
 
 	LsUncachedClient client.Client
 	LsRestConfig     *rest.Config
@@ -90,6 +93,7 @@ type ManifestApplier struct {
 	labels                     map[string]string
 	deletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition
 	interruptionChecker        interruption.InterruptionChecker
+	takeoverPolicy             managedresource.TakeoverPolicy
 	lsUncachedClient           client.Client
 	lsRestConfig               *rest.Config
 
@@ -141,6 +145,7 @@ func NewManifestApplier(opts ManifestApplierOptions) *ManifestApplier {
 		labels:                     opts.Labels,
 		deletionGroupsDuringUpdate: opts.DeletionGroupsDuringUpdate,
 		interruptionChecker:        opts.InterruptionChecker,
+		takeoverPolicy:             opts.TakeoverPolicy,
 		apiResourceHandler:         CreateApiResourceHandler(opts.Clientset),
 		lsUncachedClient:           opts.LsUncachedClient,
 		lsRestConfig:               opts.LsRestConfig,
@@ -190,6 +195,14 @@ func (a *ManifestApplier) Apply(ctx context.Context) ([]*PatchInfo, error) {
 					errMux.Lock()
 					defer errMux.Unlock()
 					allErrs = append(allErrs, err)
+
+					// keep track of the failed resource, so that it can be identified in the status
+					if mr == nil {
+						mr = a.failedManagedResource(ctx, m, err)
+					} else {
+						mr.ApplyState = managedresource.ApplyStateFailed
+						mr.Message = err.Error()
+					}
 				}
 				if mr != nil {
 					mux.Lock()
@@ -211,9 +224,15 @@ func (a *ManifestApplier) Apply(ctx context.Context) ([]*PatchInfo, error) {
 		a.managedResources = append(a.managedResources, managedResources...)
 	}
 
+	SetApplyStates(a.managedResources, oldManagedResources)
+
 	if len(allErrs) != 0 {
 		aggErr := apimacherrors.NewAggregate(allErrs)
-		return nil, lserrors.NewWrappedError(apimacherrors.NewAggregate(allErrs), "ApplyObjects", "ApplyNewObject", aggErr.Error())
+		codes := []lsv1alpha1.ErrorCode{}
+		for _, err := range allErrs {
+			codes = append(codes, lserrors.CollectErrorCodes(err)...)
+		}
+		return nil, lserrors.NewWrappedError(apimacherrors.NewAggregate(allErrs), "ApplyObjects", "ApplyNewObject", aggErr.Error(), codes...)
 	}
 
 	// remove old objects
@@ -259,6 +278,7 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 
 			obj.SetAnnotations(objAnnotations)
 		}
+		a.setOwner(obj)
 
 		if err := a.kubeClient.Create(ctx, obj); err != nil {
 			return nil, nil, fmt.Errorf("unable to create resource %s: %w", key.String(), err)
@@ -299,6 +319,10 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		return mr, nil, nil
 	}
 
+	if err := a.checkOwnership(ctx, &currObj); err != nil {
+		return nil, nil, err
+	}
+
 	switch a.updateStrategy {
 	case manifestv1alpha2.UpdateStrategyUpdate:
 		fallthrough
@@ -306,6 +330,7 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		// inject manifest specific labels
 		a.injectLabels(obj)
 		kutil.SetMetaDataLabel(obj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
+		a.setOwner(obj)
 
 		// Set the required and immutable fields from the current object.
 		// Update fails if these fields are missing
@@ -341,6 +366,7 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		// inject manifest specific labels
 		a.injectLabels(&currObj)
 		kutil.SetMetaDataLabel(&currObj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
+		a.setOwner(&currObj)
 
 		if err := a.kubeClient.Update(ctx, &currObj); err != nil {
 			return mr, nil, fmt.Errorf("unable to update resource %s: %w", key.String(), err)
@@ -420,7 +446,7 @@ func (a *ManifestApplier) cleanupOrphanedResourcesInGroups(ctx context.Context,
 			lc.KeyResourceKind, mr.Resource.Kind)
 		mrLogger.Debug("Checking resource")
 
-		ok, err := FilterByPolicy(mrCtx, mr, a.kubeClient, a.deployItemName)
+		ok, err := FilterByPolicy(mrCtx, mr, a.kubeClient, a.deployItemName, a.deployItem)
 		if err != nil {
 			return err
 		}
@@ -459,7 +485,13 @@ func (a *ManifestApplier) cleanupOrphanedResourcesInGroups(ctx context.Context,
 
 // FilterByPolicy is used during the deletion of manifest deployitems and manifest-only helm deployitems.
 // It returns true if the deployitem can be deleted according to its policy, and false if it must not be deleted.
-func FilterByPolicy(ctx context.Context, mr *managedresource.ManagedResourceStatus, targetClient client.Client, deployItemName string) (bool, error) {
+// Objects which have been taken over by another deploy item are not deleted either.
+// The object is only read from the target cluster if its managed-by label or its owner annotation must be checked.
+// Objects which do not exist anymore, or whose kind is not known anymore because its CRD has been deleted,
+// are not deleted.
+func FilterByPolicy(ctx context.Context, mr *managedresource.ManagedResourceStatus, targetClient client.Client,
+	deployItemName string, deployItem *lsv1alpha1.DeployItem) (bool, error) {
+
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
 	if mr.Policy == managedresource.IgnorePolicy || mr.Policy == managedresource.KeepPolicy {
@@ -467,24 +499,37 @@ func FilterByPolicy(ctx context.Context, mr *managedresource.ManagedResourceStat
 		return false, nil
 	}
 
-	if mr.Policy == managedresource.FallbackPolicy {
-		// if fallback policy is set and the resource is already managed by another deployer
-		// we are not allowed to manage that resource
-		ref := mr.Resource
-		obj := kutil.ObjectFromCoreObjectReference(&ref)
-		key := kutil.ObjectKey(ref.Name, ref.Namespace)
+	checkManagedByLabel := mr.Policy == managedresource.FallbackPolicy
+	checkOwner := deployItem != nil
+	if !checkManagedByLabel && !checkOwner {
+		return true, nil
+	}
 
-		if err := read_write_layer.GetUnstructured(ctx, targetClient, key, obj, read_write_layer.R000049); err != nil {
-			if apierrors.IsNotFound(err) {
-				logger.Debug("Object not found")
-				return false, nil
-			}
-			return false, fmt.Errorf("unable to get object %s %s: %w", obj.GroupVersionKind().String(), obj.GetName(), err)
-		}
-		if !kutil.HasLabelWithValue(obj, manifestv1alpha2.ManagedDeployItemLabel, deployItemName) {
-			logger.Info("Resource is already managed, skip cleanup")
+	ref := mr.Resource
+	obj := kutil.ObjectFromCoreObjectReference(&ref)
+	key := kutil.ObjectKey(ref.Name, ref.Namespace)
+
+	if err := read_write_layer.GetUnstructured(ctx, targetClient, key, obj, read_write_layer.R000049); err != nil {
+		if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+			// This handles two cases:
+			// 1. the resource is already deleted
+			// 2. the resource is a custom resource and its CRD is already deleted (and the resource itself thus too)
+			logger.Debug("Object not found")
 			return false, nil
 		}
+		return false, fmt.Errorf("unable to get object %s %s: %w", obj.GroupVersionKind().String(), obj.GetName(), err)
+	}
+
+	// if fallback policy is set and the resource is already managed by another deployer
+	// we are not allowed to manage that resource
+	if checkManagedByLabel && !kutil.HasLabelWithValue(obj, manifestv1alpha2.ManagedDeployItemLabel, deployItemName) {
+		logger.Info("Resource is already managed, skip cleanup")
+		return false, nil
+	}
+
+	if checkOwner && IsOwnedByOtherDeployItem(obj, deployItem) {
+		logger.Info("Resource is owned by another deploy item, skip cleanup", "owner", GetOwner(obj))
+		return false, nil
 	}
 
 	return true, nil
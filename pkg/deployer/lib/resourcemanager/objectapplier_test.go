@@ -11,14 +11,21 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 	manifestv1alpha2 "github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2"
 	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
 	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
 	"github.com/openmcp-project/landscaper/pkg/api"
+	"github.com/openmcp-project/landscaper/pkg/deployer/lib/interruption"
 	"github.com/openmcp-project/landscaper/pkg/deployer/lib/resourcemanager"
 	"github.com/openmcp-project/landscaper/pkg/deployer/lib/timeout"
 	"github.com/openmcp-project/landscaper/test/utils/envtest"
@@ -82,6 +89,9 @@ var _ = Describe("ObjectApplier", func() {
 			Namespace:  state.Namespace,
 			UID:        res.UID,
 		}))
+		Expect(managedResources[0].ApplyState).To(Equal(managedresource.ApplyStateApplied))
+		Expect(managedResources[0].ReadinessState).To(Equal(managedresource.ReadinessStatePending))
+		Expect(managedResources[0].LastTransitionTime).ToNot(BeNil())
 
 		cm.Data["key"] = "modified"
 		cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
@@ -697,4 +707,150 @@ var _ = Describe("ObjectApplier", func() {
 		Expect(cmRead.Data).To(HaveKeyWithValue("addedKey", "val1"))
 		Expect(cmRead.Annotations).To(HaveKeyWithValue("modified", "True"))
 	})
+
+	It("should not take over a resource owned by another deploy item", func() {
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		owner := &lsv1alpha1.DeployItem{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: state.Namespace}}
+		other := &lsv1alpha1.DeployItem{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: state.Namespace}}
+
+		opts := resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			DeployItemName:   owner.Name,
+			DeployItem:       owner,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+
+		cmRead := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).To(Succeed())
+		Expect(cmRead.Annotations).To(HaveKeyWithValue(managedresource.OwnerDeployItemAnnotation, resourcemanager.OwnerID(owner)))
+
+		cm.Data["key"] = "valOther"
+		cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		opts.DeployItemName = other.Name
+		opts.DeployItem = other
+		opts.Manifests = []managedresource.Manifest{
+			{
+				Manifest: cmRaw,
+			},
+		}
+
+		opts.InterruptionChecker = interruption.NewIgnoreInterruptionChecker()
+		applier := resourcemanager.NewManifestApplier(opts)
+		_, err = applier.Apply(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(resourcemanager.OwnerID(owner)))
+		Expect(lserrors.CollectErrorCodes(err)).To(ContainElement(lsv1alpha1.ErrorOwnershipConflict))
+
+		// the failed resource is reported in the status
+		managedResources = applier.GetManagedResourcesStatus()
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Resource.Name).To(Equal("my-cm"))
+		Expect(managedResources[0].ApplyState).To(Equal(managedresource.ApplyStateFailed))
+		Expect(managedResources[0].Message).To(ContainSubstring(resourcemanager.OwnerID(owner)))
+		Expect(managedResources[0].LastTransitionTime).ToNot(BeNil())
+
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).To(Succeed())
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "val"))
+	})
+
+	It("should take over a resource owned by another deploy item if the takeover policy allows it", func() {
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		owner := &lsv1alpha1.DeployItem{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: state.Namespace}}
+		other := &lsv1alpha1.DeployItem{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: state.Namespace}}
+
+		opts := resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			DeployItemName:   owner.Name,
+			DeployItem:       owner,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		cm.Data["key"] = "valOther"
+		cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		opts.DeployItemName = other.Name
+		opts.DeployItem = other
+		opts.TakeoverPolicy = managedresource.TakeoverPolicyAlways
+		opts.Manifests = []managedresource.Manifest{
+			{
+				Manifest: cmRaw,
+			},
+		}
+
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+
+		cmRead := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).To(Succeed())
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "valOther"))
+		Expect(cmRead.Annotations).To(HaveKeyWithValue(managedresource.OwnerDeployItemAnnotation, resourcemanager.OwnerID(other)))
+
+		// the previous owner must not delete the resource it no longer owns
+		ok, err := resourcemanager.FilterByPolicy(ctx, &managedResources[0], testenv.Client, owner.Name, owner)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+	It("should not delete a resource whose kind is not known anymore", func() {
+		targetClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				return &apimeta.NoKindMatchError{GroupKind: obj.GetObjectKind().GroupVersionKind().GroupKind()}
+			},
+		}).Build()
+
+		mr := &managedresource.ManagedResourceStatus{
+			Policy: managedresource.ManagePolicy,
+			Resource: corev1.ObjectReference{
+				APIVersion: "example.com/v1",
+				Kind:       "Example",
+				Name:       "example",
+				Namespace:  state.Namespace,
+			},
+		}
+		owner := &lsv1alpha1.DeployItem{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: state.Namespace}}
+
+		ok, err := resourcemanager.FilterByPolicy(ctx, mr, targetClient, owner.Name, owner)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
 })
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// OwnershipConflictReason is the reason of the error which reports an object owned by another deploy item.
+const OwnershipConflictReason = "OwnershipConflict"
+
+// OwnerID returns the value of the owner annotation of the objects which are owned by a deploy item.
+// It returns an empty string if no deploy item is given.
+func OwnerID(deployItem *lsv1alpha1.DeployItem) string {
+	if deployItem == nil {
+		return ""
+	}
+	return deployItem.Namespace + "/" + deployItem.Name
+}
+
+// GetOwner returns the deploy item which owns an object, in the form "<namespace>/<name>".
+// It returns an empty string if the object has no owner annotation.
+func GetOwner(obj metav1.Object) string {
+	return obj.GetAnnotations()[managedresource.OwnerDeployItemAnnotation]
+}
+
+// IsOwnedByOtherDeployItem returns whether an object is owned by another deploy item than the given one.
+// Objects without owner annotation, e.g. objects created by older versions of the deployers, have no owner.
+func IsOwnedByOtherDeployItem(obj metav1.Object, deployItem *lsv1alpha1.DeployItem) bool {
+	owner := GetOwner(obj)
+	return len(owner) != 0 && owner != OwnerID(deployItem)
+}
+
+// setOwner marks an object as owned by the deploy item of the applier.
+func (a *ManifestApplier) setOwner(obj metav1.Object) {
+	ownerID := OwnerID(a.deployItem)
+	if len(ownerID) == 0 {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[managedresource.OwnerDeployItemAnnotation] = ownerID
+	obj.SetAnnotations(annotations)
+}
+
+// checkOwnership returns an error with code ErrorOwnershipConflict if an existing object is owned by another deploy
+// item and the takeover policy does not allow to take it over.
+func (a *ManifestApplier) checkOwnership(ctx context.Context, obj metav1.Object) error {
+	if a.deployItem == nil || !IsOwnedByOtherDeployItem(obj, a.deployItem) {
+		return nil
+	}
+
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+	key := kutil.ObjectKeyFromObject(obj).String()
+	owner := GetOwner(obj)
+
+	if a.takeoverPolicy == managedresource.TakeoverPolicyAlways {
+		logger.Info("Taking over resource owned by another deploy item", lc.KeyResource, key, "owner", owner)
+		return nil
+	}
+
+	msg := fmt.Sprintf("resource %s is owned by deploy item %s; set the takeoverPolicy to %q to take it over",
+		key, owner, managedresource.TakeoverPolicyAlways)
+	return lserrors.NewError("CheckOwnership", OwnershipConflictReason, msg, lsv1alpha1.ErrorOwnershipConflict)
+}
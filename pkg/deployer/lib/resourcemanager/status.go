@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
+	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
+)
+
+// SetApplyStates sets the apply state of the managed resources after they have been applied.
+// Resources whose apply state is already set to failed keep the state and the error message; all other resources
+// are marked as applied and their readiness is pending until the readiness checks have been performed.
+// The last transition times are taken over from the managed resources of the previous reconciliation.
+func SetApplyStates(managedResources, oldManagedResources managedresource.ManagedResourceStatusList) {
+	now := metav1.Now()
+	for i := range managedResources {
+		mr := &managedResources[i]
+
+		applyState := managedresource.ApplyStateApplied
+		readinessState := managedresource.ReadinessStatePending
+		message := ""
+		if mr.ApplyState == managedresource.ApplyStateFailed {
+			applyState = managedresource.ApplyStateFailed
+			readinessState = ""
+			message = mr.Message
+		}
+
+		mr.ApplyState, mr.ReadinessState, mr.LastTransitionTime = "", "", nil
+		if old := oldManagedResources.Find(mr.Resource); old != nil {
+			mr.ApplyState, mr.ReadinessState, mr.LastTransitionTime = old.ApplyState, old.ReadinessState, old.LastTransitionTime
+		}
+		mr.SetState(applyState, readinessState, message, now)
+	}
+}
+
+// failedManagedResource returns the managed resource status of a manifest which could not be applied.
+// It returns nil if the object of the manifest cannot be determined.
+func (a *ManifestApplier) failedManagedResource(ctx context.Context, manifest *Manifest, err error) *managedresource.ManagedResourceStatus {
+	obj, decodeErr := a.getUnstructuredManifestObject(ctx, manifest)
+	if decodeErr != nil {
+		return nil
+	}
+	return &managedresource.ManagedResourceStatus{
+		AnnotateBeforeDelete: manifest.AnnotateBeforeDelete,
+		PatchBeforeDelete:    manifest.PatchBeforeDelete,
+		Policy:               manifest.Policy,
+		Resource:             *kutil.CoreObjectReferenceFromUnstructuredObject(obj),
+		ApplyState:           managedresource.ApplyStateFailed,
+		Message:              err.Error(),
+	}
+}
@@ -41,6 +41,15 @@ func (ta *TargetAccess) TargetClientSet() kubernetes.Interface {
 	return ta.targetClientSet
 }
 
+// NewTargetAccessFromClients constructs a TargetAccess from existing clients for a target cluster.
+func NewTargetAccessFromClients(targetClient client.Client, targetRestConfig *rest.Config, targetClientSet kubernetes.Interface) *TargetAccess {
+	return &TargetAccess{
+		targetClient:     targetClient,
+		targetRestConfig: targetRestConfig,
+		targetClientSet:  targetClientSet,
+	}
+}
+
 // NewTargetAccess constructs a TargetAccess, handling the different subtypes of kubernetes-cluster Targets, namely:
 // - Targets with a kubeconfig,
 // - OIDC Targets, and
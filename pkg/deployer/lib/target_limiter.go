@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// targetLimiter limits the number of deploy items with the same target, which are concurrently processed by a deployer.
+// A deploy item occupies a slot of its target from the start of a job until the job has finished.
+type targetLimiter struct {
+	maxPerTarget int
+
+	lock sync.Mutex
+	// items maps the targets to the deploy items occupying a slot of the target
+	items map[string]sets.Set[string]
+	// targets maps the deploy items to the target whose slot they occupy
+	targets map[string]string
+}
+
+func newTargetLimiter(maxPerTarget int) *targetLimiter {
+	return &targetLimiter{
+		maxPerTarget: maxPerTarget,
+		items:        map[string]sets.Set[string]{},
+		targets:      map[string]string{},
+	}
+}
+
+// acquire occupies a slot of the target for the deploy item. It returns false if all slots of the target are occupied
+// by other deploy items. If force is set, the slot is occupied regardless of the limit. This is used for deploy items
+// which are already in progress.
+// The number of deploy items is not limited if no maximum is configured, or if the deploy item has no target.
+func (l *targetLimiter) acquire(target, item string, force bool) bool {
+	if l.maxPerTarget <= 0 || len(target) == 0 {
+		return true
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if oldTarget, ok := l.targets[item]; ok {
+		if oldTarget == target {
+			return true
+		}
+		l.releaseLocked(item)
+	}
+
+	items, ok := l.items[target]
+	if !ok {
+		items = sets.New[string]()
+		l.items[target] = items
+	}
+
+	if !force && items.Len() >= l.maxPerTarget {
+		return false
+	}
+
+	items.Insert(item)
+	l.targets[item] = target
+	return true
+}
+
+// release frees the slot occupied by the deploy item.
+func (l *targetLimiter) release(item string) {
+	if l.maxPerTarget <= 0 {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.releaseLocked(item)
+}
+
+func (l *targetLimiter) releaseLocked(item string) {
+	target, ok := l.targets[item]
+	if !ok {
+		return
+	}
+
+	delete(l.targets, item)
+	if items, ok := l.items[target]; ok {
+		items.Delete(item)
+		if items.Len() == 0 {
+			delete(l.items, target)
+		}
+	}
+}
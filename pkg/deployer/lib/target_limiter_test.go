@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Target limiter", func() {
+
+	It("should limit the number of deploy items per target", func() {
+		l := newTargetLimiter(2)
+
+		Expect(l.acquire("ns/t1", "ns/a", false)).To(BeTrue())
+		Expect(l.acquire("ns/t1", "ns/b", false)).To(BeTrue())
+		Expect(l.acquire("ns/t1", "ns/c", false)).To(BeFalse())
+		Expect(l.acquire("ns/t2", "ns/d", false)).To(BeTrue())
+
+		// a deploy item which already occupies a slot keeps it
+		Expect(l.acquire("ns/t1", "ns/a", false)).To(BeTrue())
+
+		l.release("ns/a")
+		Expect(l.acquire("ns/t1", "ns/c", false)).To(BeTrue())
+		Expect(l.acquire("ns/t1", "ns/a", false)).To(BeFalse())
+	})
+
+	It("should occupy a slot of a deploy item in progress regardless of the limit", func() {
+		l := newTargetLimiter(1)
+
+		Expect(l.acquire("ns/t1", "ns/a", false)).To(BeTrue())
+		Expect(l.acquire("ns/t1", "ns/b", true)).To(BeTrue())
+
+		l.release("ns/a")
+		Expect(l.acquire("ns/t1", "ns/c", false)).To(BeFalse())
+
+		l.release("ns/b")
+		Expect(l.acquire("ns/t1", "ns/c", false)).To(BeTrue())
+	})
+
+	It("should free the slot of the old target if the target of a deploy item changes", func() {
+		l := newTargetLimiter(1)
+
+		Expect(l.acquire("ns/t1", "ns/a", false)).To(BeTrue())
+		Expect(l.acquire("ns/t2", "ns/a", false)).To(BeTrue())
+		Expect(l.acquire("ns/t1", "ns/b", false)).To(BeTrue())
+	})
+
+	It("should not limit the deploy items if no maximum is configured or if they have no target", func() {
+		l := newTargetLimiter(0)
+		Expect(l.acquire("ns/t1", "ns/a", false)).To(BeTrue())
+		Expect(l.acquire("ns/t1", "ns/b", false)).To(BeTrue())
+
+		l = newTargetLimiter(1)
+		Expect(l.acquire("", "ns/a", false)).To(BeTrue())
+		Expect(l.acquire("", "ns/b", false)).To(BeTrue())
+	})
+})
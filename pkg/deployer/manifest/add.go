@@ -64,5 +64,7 @@ func AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient,
 			Deployer:        d,
 			TargetSelectors: config.TargetSelector,
 			Options:         options,
+
+			MaxConcurrentDeployItemsPerTarget: config.Controller.MaxConcurrentDeployItemsPerTarget,
 		}, config.Controller.Workers, lockingEnabled, callerName, controllerName)
 }
@@ -14,7 +14,9 @@ import (
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 	manifestv1alpha2 "github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2"
 	crval "github.com/openmcp-project/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/api"
 	deployerlib "github.com/openmcp-project/landscaper/pkg/deployer/lib"
 	cr "github.com/openmcp-project/landscaper/pkg/deployer/lib/continuousreconcile"
 	"github.com/openmcp-project/landscaper/pkg/deployer/lib/extension"
@@ -83,6 +85,33 @@ func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv
 	return nil
 }
 
+// ReferencedDataHash returns the hash of the manifests referenced by manifestsFrom.
+func (d *deployer) ReferencedDataHash(ctx context.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) (string, error) {
+	manifest, err := New(d.lsUncachedClient, d.hostUncachedClient, &d.config, di, rt)
+	if err != nil {
+		return "", err
+	}
+	manifest.SetLsRestConfig(d.lsRestConfig)
+
+	data, err := manifest.dataReferenceResolver().Resolve(ctx, manifest.manifestsFromReferences())
+	if err != nil {
+		return "", err
+	}
+	return deployerlib.DataHash(data), nil
+}
+
+// DataReferences returns the references of manifestsFrom.
+func (d *deployer) DataReferences(di *lsv1alpha1.DeployItem) ([]managedresource.DataReference, error) {
+	if di.Spec.Configuration == nil {
+		return nil, nil
+	}
+	config := &manifestv1alpha2.ProviderConfiguration{}
+	if _, _, err := api.NewDecoder(Scheme).Decode(di.Spec.Configuration.Raw, nil, config); err != nil {
+		return nil, err
+	}
+	return dataReferencesOfSources(config.ManifestsFrom), nil
+}
+
 func (d *deployer) ExtensionHooks() extension.ReconcileExtensionHooks {
 	return d.hooks
 }
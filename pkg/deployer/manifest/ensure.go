@@ -53,6 +53,11 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		}
 	}
 
+	manifests, lsErr := m.resolveManifests(ctx)
+	if lsErr != nil {
+		return lsErr
+	}
+
 	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
 		Decoder:          serializer.NewCodecFactory(Scheme).UniversalDecoder(),
 		KubeClient:       m.targetAccess.TargetClient(),
@@ -60,13 +65,14 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		DeployItemName:   m.DeployItem.Name,
 		DeployItem:       m.DeployItem,
 		UpdateStrategy:   m.ProviderConfiguration.UpdateStrategy,
-		Manifests:        m.ProviderConfiguration.Manifests,
+		Manifests:        manifests,
 		ManagedResources: m.ProviderStatus.ManagedResources,
 		Labels: map[string]string{
 			manifestv1alpha2.ManagedDeployItemLabel: m.DeployItem.Name,
 		},
 		DeletionGroupsDuringUpdate: m.ProviderConfiguration.DeletionGroupsDuringUpdate,
 		InterruptionChecker:        interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient),
+		TakeoverPolicy:             m.ProviderConfiguration.TakeoverPolicy,
 		LsUncachedClient:           m.lsUncachedClient,
 		LsRestConfig:               m.lsRestConfig,
 	})
@@ -96,8 +102,17 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		return err
 	}
 
-	if err := m.CheckResourcesReady(ctx, m.targetAccess.TargetClient()); err != nil {
-		return err
+	readinessErr := m.CheckResourcesReady(ctx, m.targetAccess.TargetClient())
+	if readinessErr == nil {
+		health.SetManagedResourcesReady(m.ProviderStatus.ManagedResources)
+	}
+	// update the provider status, as it contains the readiness of the managed resources
+	m.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(m.ProviderStatus, Scheme)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+	if readinessErr != nil {
+		return readinessErr
 	}
 
 	if m.ProviderConfiguration.Exports != nil {
@@ -152,6 +167,7 @@ func (m *Manifest) CheckResourcesReady(ctx context.Context, client client.Client
 			ManagedResources:    managedresources,
 			FailOnMissingObject: true,
 			InterruptionChecker: interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient),
+			ReportObjectStatus:  health.ReportToManagedResources(m.ProviderStatus.ManagedResources),
 		}
 		err := defaultReadinessCheck.CheckResourcesReady()
 		if err != nil {
@@ -176,6 +192,7 @@ func (m *Manifest) CheckResourcesReady(ctx context.Context, client client.Client
 				LsClient:            m.lsUncachedClient,
 				DeployItem:          m.DeployItem,
 				LsRestConfig:        m.lsRestConfig,
+				ReportObjectStatus:  health.ReportToManagedResources(m.ProviderStatus.ManagedResources),
 			}
 			err := customReadinessCheck.CheckResourcesReady(ctx)
 			if err != nil {
@@ -219,7 +236,7 @@ func (m *Manifest) deleteManifestsInGroups(ctx context.Context) error {
 			lc.KeyResourceKind, mr.Resource.Kind)
 		mrLogger.Debug("Checking resource")
 
-		ok, err := resourcemanager.FilterByPolicy(mrCtx, mr, m.targetAccess.TargetClient(), m.DeployItem.Name)
+		ok, err := resourcemanager.FilterByPolicy(mrCtx, mr, m.targetAccess.TargetClient(), m.DeployItem.Name, m.DeployItem)
 		if err != nil {
 			return err
 		}
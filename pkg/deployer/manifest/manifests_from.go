@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	manifestv1alpha2 "github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/deployer/lib"
+)
+
+// dataReferenceResolver returns a resolver for the data in Secrets and ConfigMaps referenced by the deploy item.
+func (m *Manifest) dataReferenceResolver() *lib.DataReferenceResolver {
+	return &lib.DataReferenceResolver{
+		LsClient:   m.lsUncachedClient,
+		DeployItem: m.DeployItem,
+		GetTargetClient: func(ctx context.Context) (client.Client, error) {
+			if err := m.ensureTargetAccess(ctx); err != nil {
+				return nil, err
+			}
+			return m.targetAccess.TargetClient(), nil
+		},
+	}
+}
+
+// manifestsFromReferences returns the manifests referenced by manifestsFrom.
+func (m *Manifest) manifestsFromReferences() []managedresource.DataReference {
+	return dataReferencesOfSources(m.ProviderConfiguration.ManifestsFrom)
+}
+
+// dataReferencesOfSources returns the data references of manifestsFrom sources.
+func dataReferencesOfSources(sources []manifestv1alpha2.ManifestsFromSource) []managedresource.DataReference {
+	refs := make([]managedresource.DataReference, 0, len(sources))
+	for _, source := range sources {
+		refs = append(refs, source.DataReference)
+	}
+	return refs
+}
+
+// resolveManifests returns the inline manifests of the provider configuration together with the manifests referenced
+// by manifestsFrom. The hash of the referenced manifests is remembered in the status of the deploy item,
+// so that a change of the referenced manifests can be detected.
+func (m *Manifest) resolveManifests(ctx context.Context) ([]managedresource.Manifest, lserrors.LsError) {
+	currOp := "ResolveManifestsFrom"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	data, lsErr := m.dataReferenceResolver().Resolve(ctx, m.manifestsFromReferences())
+	if lsErr != nil {
+		return nil, lsErr
+	}
+	m.DeployItem.Status.ReferencedDataHash = lib.DataHash(data)
+	if len(data) == 0 {
+		return m.ProviderConfiguration.Manifests, nil
+	}
+
+	manifests := make([]managedresource.Manifest, 0, len(m.ProviderConfiguration.Manifests))
+	manifests = append(manifests, m.ProviderConfiguration.Manifests...)
+	for i, d := range data {
+		name := fmt.Sprintf("manifestsFrom %d", i)
+		objects, err := kutil.DecodeObjectsToRawExtension(logger, name, d)
+		if err != nil {
+			return nil, lserrors.NewWrappedError(err, currOp, "DecodeManifestsFrom",
+				fmt.Sprintf("unable to decode the manifests of %s: %s", name, err.Error()), lsv1alpha1.ErrorConfigurationProblem)
+		}
+
+		policy := m.ProviderConfiguration.ManifestsFrom[i].Policy
+		if len(policy) == 0 {
+			policy = managedresource.ManagePolicy
+		}
+		for _, obj := range objects {
+			manifests = append(manifests, managedresource.Manifest{
+				Policy:   policy,
+				Manifest: obj,
+			})
+		}
+	}
+	return manifests, nil
+}
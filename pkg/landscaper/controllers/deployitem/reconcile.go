@@ -54,6 +54,11 @@ func (con *controller) reconcile(ctx context.Context, req reconcile.Request) (re
 		return reconcile.Result{}, nil
 	}
 
+	if lsv1alpha1helper.HasSuspendedAnnotation(di.ObjectMeta) {
+		logger.Debug("deploy item is suspended, no pickup check")
+		return reconcile.Result{}, nil
+	}
+
 	if HasBeenPickedUp(di) || con.pickupTimeout == 0 {
 		// deploy item has been picked up, or the pickup check is deactivated
 		return reconcile.Result{}, nil
@@ -48,6 +48,7 @@ func AddControllerToManager(ctx context.Context, lsUncachedClient, lsCachedClien
 		lsMgr.GetEventRecorder("Landscaper"),
 		config.Controllers.Executions.Workers,
 		lockingEnabled,
+		config.DeletionSafeguard,
 		"executions",
 	)
 	if err != nil {
@@ -9,7 +9,8 @@ import (
 func isExecFinished(exec *lsv1alpha1.Execution) bool {
 	if needsFinalizer(exec) ||
 		hasInterruptOperation(exec) ||
-		isDifferentJobIDs(exec) {
+		isDifferentJobIDs(exec) ||
+		isResumed(exec) {
 		return false
 	}
 
@@ -27,3 +28,15 @@ func hasInterruptOperation(exec *lsv1alpha1.Execution) bool {
 func isDifferentJobIDs(exec *lsv1alpha1.Execution) bool {
 	return exec.Status.JobID != exec.Status.JobIDFinished
 }
+
+// isSuspended returns whether the execution is suspended by its installation.
+// An execution which is being deleted is not suspended, so that a suspension cannot block the deletion.
+func isSuspended(exec *lsv1alpha1.Execution) bool {
+	return exec.DeletionTimestamp.IsZero() && lsv1alpha1helper.HasSuspendedAnnotation(exec.ObjectMeta)
+}
+
+// isResumed returns whether the execution has been suspended and is not suspended anymore.
+func isResumed(exec *lsv1alpha1.Execution) bool {
+	cond := lsv1alpha1helper.GetCondition(exec.Status.Conditions, lsv1alpha1.SuspendedCondition)
+	return !isSuspended(exec) && cond != nil && cond.Status == lsv1alpha1.ConditionTrue
+}
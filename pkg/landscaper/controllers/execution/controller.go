@@ -7,6 +7,7 @@ package execution
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -17,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/openmcp-project/landscaper/apis/config"
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
 	lserrors "github.com/openmcp-project/landscaper/apis/errors"
@@ -27,12 +29,13 @@ import (
 	lsutil "github.com/openmcp-project/landscaper/pkg/utils"
 	"github.com/openmcp-project/landscaper/pkg/utils/lock"
 	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+	"github.com/openmcp-project/landscaper/pkg/utils/rollout"
 )
 
 // NewController creates a new execution controller that reconcile Execution resources.
 func NewController(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
 	logger logging.Logger, scheme *runtime.Scheme, eventRecorder events.EventRecorder, maxNumberOfWorker int,
-	lockingEnabled bool, callerName string) (reconcile.Reconciler, error) {
+	lockingEnabled bool, deletionSafeguard *config.DeletionSafeguard, callerName string) (reconcile.Reconciler, error) {
 
 	ctx := logging.NewContext(context.Background(), logger)
 
@@ -54,6 +57,7 @@ func NewController(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCac
 		eventRecorder:       eventRecorder,
 		workerCounter:       wc,
 		lockingEnabled:      lockingEnabled,
+		deletionSafeguard:   deletionSafeguard,
 		callerName:          callerName,
 		locker:              *lock.NewLocker(lsUncachedClient, hostUncachedClient, callerName),
 	}, nil
@@ -73,6 +77,9 @@ type controller struct {
 	lockingEnabled bool
 	callerName     string
 	locker         lock.Locker
+
+	// deletionSafeguard limits the number of orphaned deploy items which are deleted without confirmation
+	deletionSafeguard *config.DeletionSafeguard
 }
 
 func prepareFinishedObjectCache(ctx context.Context, lsUncachedClient client.Client) (*lsutil.FinishedObjectCache, error) {
@@ -192,6 +199,19 @@ func (c *controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		}
 	}
 
+	if isSuspended(exec) {
+		if err := c.handleSuspend(ctx, exec); err != nil {
+			return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if isResumed(exec) {
+		if err := c.handleResume(ctx, exec); err != nil {
+			return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+	}
+
 	if hasInterruptOperation(exec) {
 		if err := c.handleInterruptOperation(ctx, exec); err != nil {
 			return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
@@ -203,7 +223,16 @@ func (c *controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		// Execution is unfinished
 
 		err := c.handleReconcilePhase(ctx, exec)
-		return lsutil.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
+		result, resultErr := lsutil.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
+		if waitTime := rollout.TimeUntilNextWave(exec.Status.Rollout, time.Now()); waitTime > 0 && result.RequeueAfter == 0 {
+			// the rollout is paused between two waves
+			result.RequeueAfter = waitTime
+		}
+		if exec.Status.NextRetryTime != nil && (result.RequeueAfter == 0 || time.Until(exec.Status.NextRetryTime.Time) < result.RequeueAfter) {
+			// failed deploy items wait for their next retry
+			result.RequeueAfter = max(time.Until(exec.Status.NextRetryTime.Time), time.Second)
+		}
+		return result, resultErr
 	} else {
 		// Execution is finished; nothing to do
 		return reconcile.Result{}, nil
@@ -227,7 +256,11 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 
 		exec.Status.DeployItemCache = nil
 
-		if exec.DeletionTimestamp.IsZero() {
+		if exec.DeletionTimestamp.IsZero() && exec.Spec.RequireApproval {
+			// keep the cache for the comparison of the deploy items in phase WaitingForApproval
+			exec.Status.DeployItemCache = deployItemCache
+			exec.Status.ExecutionPhase = lsv1alpha1.ExecutionPhases.WaitingForApproval
+		} else if exec.DeletionTimestamp.IsZero() {
 			exec.Status.ExecutionPhase = lsv1alpha1.ExecutionPhases.Init
 		} else {
 			exec.Status.ExecutionPhase = lsv1alpha1.ExecutionPhases.InitDelete
@@ -244,8 +277,35 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		}
 	}
 
+	if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.WaitingForApproval {
+		approved, err := c.handlePhaseWaitingForApproval(ctx, exec)
+		if err != nil {
+			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000155)
+		}
+
+		if !approved {
+			msg := fmt.Sprintf("waiting for approval of change %s of deploy items %v", exec.Status.PendingApproval.Digest,
+				exec.Status.PendingApproval.DeployItems)
+			err = lserrors.NewError(op, "handlePhaseWaitingForApproval", msg, lsv1alpha1.ErrorUnfinished,
+				lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000156)
+		}
+
+		deployItemCache = exec.Status.DeployItemCache
+		exec.Status.DeployItemCache = nil
+		exec.Status.PendingApproval = nil
+
+		if err := c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Init, nil, read_write_layer.W000157); err != nil {
+			return err
+		}
+	}
+
 	if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.Init {
 		if err := c.handlePhaseInit(ctx, exec, deployItemCache); err != nil {
+			if lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorUnfinished) {
+				// the deletion of orphaned deploy items waits for a confirmation
+				return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000174)
+			}
 			if lsutil.IsRecoverableError(err) {
 				return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000007)
 			}
@@ -265,12 +325,33 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000133)
 		}
 
-		if !deployItemClassification.HasRunningItems() && deployItemClassification.HasFailedItems() {
-			err = lserrors.NewError(op, "handlePhaseProgressing", "has failed or missing deploy items", lsv1alpha1.ErrorForInfoOnly)
+		continueOnFailure := !rollout.StopOnFailure(exec.Spec.Rollout) &&
+			(deployItemClassification.HasRunnableItems() || deployItemClassification.HasWaitingItems() ||
+				deployItemClassification.HasQueuedItems())
+
+		if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRetryingItems() &&
+			deployItemClassification.HasFailedItems() && !continueOnFailure {
+			// the error has the error codes of the failed deploy items, so that they can be matched by a retry policy
+			codes := append(deployItemClassification.GetFailureCodes(), lsv1alpha1.ErrorForInfoOnly)
+			err = lserrors.NewError(op, "handlePhaseProgressing", "has failed or missing deploy items", codes...)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000134)
-		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() && deployItemClassification.HasPendingItems() {
+		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() &&
+			!deployItemClassification.HasWaitingItems() && !deployItemClassification.HasQueuedItems() &&
+			!deployItemClassification.HasRetryingItems() && deployItemClassification.HasPendingItems() {
 			err = lserrors.NewError(op, "handlePhaseProgressing", "items could not be started", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000135)
+		} else if deployItemClassification.HasQueuedItems() {
+			// remain in progressing until the queued items can be started
+			msg := fmt.Sprintf("deploy items are queued, because the maximum number of %d parallel deploy items is reached", *exec.Spec.MaxParallel)
+			err = lserrors.NewError(op, lsv1alpha1.MaxParallelReachedReason, msg, lsv1alpha1.ErrorUnfinished,
+				lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000195)
+		} else if !deployItemClassification.HasRunningItems() && deployItemClassification.HasRetryingItems() {
+			// remain in progressing until the failed items are retried
+			msg := fmt.Sprintf("failed deploy items are retried at %s", exec.Status.NextRetryTime.Format(time.RFC3339))
+			err = lserrors.NewError(op, "handlePhaseProgressing", msg, lsv1alpha1.ErrorUnfinished,
+				lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000196)
 		} else if !deployItemClassification.AllSucceeded() {
 			// remain in progressing in all other cases
 			err = lserrors.NewError(op, "handlePhaseProgressing", "some running items", lsv1alpha1.ErrorUnfinished,
@@ -336,9 +417,45 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 	return nil
 }
 
+// handlePhaseWaitingForApproval returns whether the change of the deploy items in the current job may be applied.
+// This is the case if the deploy items are up to date, or if the change has been approved with the approve annotation.
+// An approve annotation which has been used is removed.
+func (c *controller) handlePhaseWaitingForApproval(ctx context.Context, exec *lsv1alpha1.Execution) (bool, lserrors.LsError) {
+	op := "handlePhaseWaitingForApproval"
+
+	forceReconcile := false
+	o := execution.NewOperation(operation.NewOperation(c.scheme, c.eventRecorder, c.lsUncachedClient), exec, forceReconcile)
+
+	pendingApproval, err := o.GetPendingApproval(ctx, exec.Status.DeployItemCache)
+	if err != nil {
+		return false, err
+	}
+
+	if !execution.IsApproved(exec, pendingApproval) {
+		exec.Status.PendingApproval = pendingApproval
+		return false, nil
+	}
+
+	if pendingApproval != nil {
+		logger, ctx := logging.FromContextOrNew(ctx, nil)
+		logger.Info("change of deploy items approved", "digest", pendingApproval.Digest)
+
+		// the update of the execution overwrites the status with the stored one; it is restored afterwards
+		status := exec.Status.DeepCopy()
+		delete(exec.Annotations, lsv1alpha1.ApproveAnnotation)
+		if err := c.Writer().UpdateExecution(ctx, read_write_layer.W000158, exec); err != nil {
+			return false, lserrors.NewWrappedError(err, op, "RemoveApproveAnnotation", err.Error())
+		}
+		exec.Status = *status
+	}
+
+	return true, nil
+}
+
 func (c *controller) handlePhaseInit(ctx context.Context, exec *lsv1alpha1.Execution, deployItemCache *lsv1alpha1.DeployItemCache) lserrors.LsError {
 	forceReconcile := false
 	o := execution.NewOperation(operation.NewOperation(c.scheme, c.eventRecorder, c.lsUncachedClient), exec, forceReconcile)
+	o.DeletionSafeguard = c.deletionSafeguard
 
 	return o.UpdateDeployItems(ctx, deployItemCache)
 }
@@ -445,6 +562,13 @@ func (c *controller) handleInterruptOperation(ctx context.Context, exec *lsv1alp
 		}
 	}
 
+	if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.WaitingForApproval {
+		exec.Status.PendingApproval = nil
+		lsErr := lserrors.NewError(op, "InterruptOperation", "operation was interrupted while waiting for approval")
+		// the returned error is the interrupt error; a failed status update has already been logged
+		_ = c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, lsErr, read_write_layer.W000159)
+	}
+
 	return nil
 }
 
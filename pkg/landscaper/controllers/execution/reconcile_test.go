@@ -17,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
 	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
 	"github.com/openmcp-project/landscaper/pkg/api"
@@ -35,7 +36,7 @@ var _ = Describe("Reconcile", func() {
 	BeforeEach(func() {
 		var err error
 		ctrl, err = execution.NewController(testenv.Client, testenv.Client, testenv.Client, testenv.Client, logging.Discard(), api.Scheme,
-			events.NewFakeRecorder(1024), 1000, false, "exec-test-"+testutils.GetNextCounter())
+			events.NewFakeRecorder(1024), 1000, false, nil, "exec-test-"+testutils.GetNextCounter())
 		Expect(err).ToNot(HaveOccurred())
 		state, err = testenv.InitState(context.TODO())
 		Expect(err).ToNot(HaveOccurred())
@@ -504,4 +505,64 @@ var _ = Describe("Reconcile", func() {
 			Expect(di3.Status.JobIDFinished).To(Equal(currentJobID))
 		})
 	})
+
+	It("should suspend and resume the deploy items of a suspended execution", func() {
+		ctx := context.Background()
+		exec := &lsv1alpha1.Execution{}
+		exec.GenerateName = "test-"
+		exec.Namespace = state.Namespace
+		exec.Spec.DeployItems = []lsv1alpha1.DeployItemTemplate{
+			{
+				Name: "def",
+				Type: "test-type",
+				Configuration: &runtime.RawExtension{
+					Raw: []byte(`
+{
+  "apiVersion": "sometest",
+  "kind": "somekind"
+}
+`),
+				},
+			},
+		}
+		Expect(state.Create(ctx, exec)).To(Succeed())
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(testutils.UpdateJobIdForExecution(ctx, testenv, exec)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+
+		items := &lsv1alpha1.DeployItemList{}
+		testutils.ExpectNoError(testenv.Client.List(ctx, items, client.InNamespace(state.Namespace)))
+		Expect(items.Items).To(HaveLen(1))
+		di := &items.Items[0]
+
+		// the installation suspends the execution
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		lsv1alpha1helper.SetSuspendedAnnotation(&exec.ObjectMeta, true)
+		Expect(state.Client.Update(ctx, exec)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		cond := lsv1alpha1helper.GetCondition(exec.Status.Conditions, lsv1alpha1.SuspendedCondition)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(lsv1alpha1.SuspendedByParentReason))
+		Expect(exec.Status.ExecutionPhase).To(Equal(lsv1alpha1.ExecutionPhases.Progressing))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(di), di)).To(Succeed())
+		Expect(di.Annotations).To(HaveKeyWithValue(lsv1alpha1.SuspendedAnnotation, "true"))
+
+		// the installation resumes the execution
+		lsv1alpha1helper.SetSuspendedAnnotation(&exec.ObjectMeta, false)
+		Expect(state.Client.Update(ctx, exec)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		cond = lsv1alpha1helper.GetCondition(exec.Status.Conditions, lsv1alpha1.SuspendedCondition)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(lsv1alpha1.ResumedReason))
+		Expect(exec.Status.ExecutionPhase).To(Equal(lsv1alpha1.ExecutionPhases.Progressing))
+		Expect(exec.Status.JobIDFinished).NotTo(Equal(exec.Status.JobID))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(di), di)).To(Succeed())
+		Expect(di.Annotations).ToNot(HaveKey(lsv1alpha1.SuspendedAnnotation))
+	})
 })
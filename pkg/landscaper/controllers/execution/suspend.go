@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/controllers/deployitem"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/execution"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/operation"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+// handleSuspend forwards the suspended annotation of the execution to its deploy items.
+// The phase and the job of the execution remain unchanged, so that the reconciliation can continue after a resume.
+func (c *controller) handleSuspend(ctx context.Context, exec *lsv1alpha1.Execution) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	if err := c.setSuspendedAnnotationOfDeployItems(ctx, exec, true); err != nil {
+		return err
+	}
+
+	cond := lsv1alpha1helper.GetOrInitCondition(exec.Status.Conditions, lsv1alpha1.SuspendedCondition)
+	if cond.Status == lsv1alpha1.ConditionTrue {
+		return nil
+	}
+
+	logger.Info("suspending execution")
+	exec.Status.Conditions = lsv1alpha1helper.MergeConditions(exec.Status.Conditions,
+		lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue, lsv1alpha1.SuspendedByParentReason,
+			"execution is suspended by its installation"))
+	return c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000164, exec)
+}
+
+// handleResume removes the suspended annotation from the deploy items of a resumed execution.
+func (c *controller) handleResume(ctx context.Context, exec *lsv1alpha1.Execution) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	logger.Info("resuming execution")
+
+	if err := c.setSuspendedAnnotationOfDeployItems(ctx, exec, false); err != nil {
+		return err
+	}
+
+	cond := lsv1alpha1helper.GetOrInitCondition(exec.Status.Conditions, lsv1alpha1.SuspendedCondition)
+	exec.Status.Conditions = lsv1alpha1helper.MergeConditions(exec.Status.Conditions,
+		lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse, lsv1alpha1.ResumedReason, "execution has been resumed"))
+	return c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000165, exec)
+}
+
+func (c *controller) setSuspendedAnnotationOfDeployItems(ctx context.Context, exec *lsv1alpha1.Execution, suspended bool) error {
+	forceReconcile := false
+	o := execution.NewOperation(operation.NewOperation(c.scheme, c.eventRecorder, c.lsUncachedClient), exec, forceReconcile)
+
+	managedItems, err := o.ListManagedDeployItems(ctx, read_write_layer.R000126, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range managedItems {
+		if !lsv1alpha1helper.SetSuspendedAnnotation(&item.ObjectMeta, suspended) {
+			continue
+		}
+
+		if err := c.Writer().UpdateDeployItem(ctx, read_write_layer.W000166, item); err != nil {
+			return err
+		}
+
+		if !suspended && item.Status.GetJobID() != item.Status.JobIDFinished && !deployitem.HasBeenPickedUp(item) {
+			// restart the pickup timeout, which was not checked while the deploy item was suspended
+			now := metav1.Now()
+			item.Status.JobIDGenerationTime = &now
+			if err := c.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000167, item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/controllers/deployitem"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+)
+
+// blockingSubinstallation returns the description of a subinstallation for which the installation is waiting,
+// or nil if the subinstallation does not block the installation.
+func blockingSubinstallation(inst, subInst *lsv1alpha1.Installation) *lsv1alpha1.BlockingObject {
+	if subInst.Status.JobID != inst.Status.JobID {
+		// the subinstallation is waiting for a later wave of the rollout
+		return nil
+	}
+
+	if subInst.Status.JobIDFinished != subInst.Status.JobID && subInst.Status.PendingDeletion != nil {
+		blockingObject := lsv1alpha1helper.NewBlockingObject(utils.InstallationKind, subInst,
+			lsv1alpha1.DeletionNotConfirmedReason,
+			fmt.Sprintf("installation is waiting for the confirmation of deletion %s", subInst.Status.PendingDeletion.Digest))
+		return &blockingObject
+	}
+
+	if subInst.Status.JobIDFinished != subInst.Status.JobID {
+		message := fmt.Sprintf("installation is in phase %s", subInst.Status.InstallationPhase)
+		blockingObject := lsv1alpha1helper.NewBlockingObject(utils.InstallationKind, subInst,
+			lsv1alpha1.SubinstallationNotFinishedReason, message)
+		return &blockingObject
+	}
+
+	if subInst.Status.InstallationPhase.IsFailed() {
+		message := fmt.Sprintf("installation is in phase %s", subInst.Status.InstallationPhase)
+		if subInst.Status.LastError != nil {
+			message = fmt.Sprintf("%s: %s", message, subInst.Status.LastError.Message)
+		}
+		blockingObject := lsv1alpha1helper.NewBlockingObject(utils.InstallationKind, subInst,
+			lsv1alpha1.SubinstallationFailedReason, message)
+		return &blockingObject
+	}
+
+	return nil
+}
+
+// blockingExecution returns the descriptions of the execution and its deploy items, for which the installation is
+// waiting. It returns nil if there is no execution, or if the execution has succeeded.
+func blockingExecution(exec *lsv1alpha1.Execution, deployItems []*lsv1alpha1.DeployItem) []lsv1alpha1.BlockingObject {
+	if exec == nil {
+		return nil
+	}
+
+	finished := exec.Status.JobIDFinished == exec.Status.JobID
+	if finished && !exec.Status.ExecutionPhase.IsFailed() {
+		return nil
+	}
+
+	blockedBy := []lsv1alpha1.BlockingObject{}
+	if !finished && exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.WaitingForApproval && exec.Status.PendingApproval != nil {
+		blockedBy = append(blockedBy, lsv1alpha1helper.NewBlockingObject(utils.ExecutionKind, exec,
+			lsv1alpha1.ExecutionWaitingForApprovalReason,
+			fmt.Sprintf("execution is waiting for approval of change %s", exec.Status.PendingApproval.Digest)))
+	}
+	if !finished && exec.Status.PendingDeletion != nil {
+		blockedBy = append(blockedBy, lsv1alpha1helper.NewBlockingObject(utils.ExecutionKind, exec,
+			lsv1alpha1.DeletionNotConfirmedReason,
+			fmt.Sprintf("execution is waiting for the confirmation of deletion %s", exec.Status.PendingDeletion.Digest)))
+	}
+
+	return append(blockedBy, blockingDeployItems(exec, deployItems)...)
+}
+
+// blockingDeployItems returns the descriptions of the deploy items of the current job of the execution, which are
+// not yet picked up by a deployer, not yet finished, failed, or waiting for a retry.
+func blockingDeployItems(exec *lsv1alpha1.Execution, deployItems []*lsv1alpha1.DeployItem) []lsv1alpha1.BlockingObject {
+	sortedDeployItems := make([]*lsv1alpha1.DeployItem, len(deployItems))
+	copy(sortedDeployItems, deployItems)
+	sort.Slice(sortedDeployItems, func(i, j int) bool {
+		return sortedDeployItems[i].Name < sortedDeployItems[j].Name
+	})
+
+	blockedBy := []lsv1alpha1.BlockingObject{}
+	for _, di := range sortedDeployItems {
+		if di.Status.GetJobID() != exec.Status.JobID {
+			// the deploy item has not yet been triggered by the execution
+			continue
+		}
+
+		if di.Status.JobIDFinished != di.Status.GetJobID() {
+			if !deployitem.HasBeenPickedUp(di) {
+				blockedBy = append(blockedBy, lsv1alpha1helper.NewBlockingObject(utils.DeployItemKind, di,
+					lsv1alpha1.DeployItemNotPickedUpReason,
+					fmt.Sprintf("deploy item of type %s has not been picked up by a deployer", di.Spec.Type)))
+			} else {
+				blockedBy = append(blockedBy, lsv1alpha1helper.NewBlockingObject(utils.DeployItemKind, di,
+					lsv1alpha1.DeployItemNotFinishedReason,
+					fmt.Sprintf("deploy item is in phase %s", di.Status.Phase)))
+			}
+			continue
+		}
+
+		if di.Status.Phase.IsFailed() {
+			if di.Status.Retry != nil && di.Status.Retry.NextRetryTime != nil {
+				blockedBy = append(blockedBy, lsv1alpha1helper.NewBlockingObject(utils.DeployItemKind, di,
+					lsv1alpha1.DeployItemWaitingForRetryReason,
+					fmt.Sprintf("deploy item is retried at %s", di.Status.Retry.NextRetryTime.UTC().Format(time.RFC3339))))
+				continue
+			}
+
+			message := fmt.Sprintf("deploy item is in phase %s", di.Status.Phase)
+			if di.Status.LastError != nil {
+				message = fmt.Sprintf("%s: %s", message, di.Status.LastError.Message)
+			}
+			blockedBy = append(blockedBy, lsv1alpha1helper.NewBlockingObject(utils.DeployItemKind, di,
+				lsv1alpha1.DeployItemFailedReason, message))
+		}
+	}
+
+	return blockedBy
+}
@@ -15,7 +15,8 @@ func isInstFinished(inst *lsv1alpha1.Installation) bool {
 		hasInterruptOperation(inst) ||
 		isNotRootWithReconcileOperation(inst) ||
 		isCreateNewJobID(inst) ||
-		isDifferentJobIDs(inst) {
+		isDifferentJobIDs(inst) ||
+		isResumed(inst) {
 		return false
 	}
 
@@ -32,7 +33,8 @@ func isAutomaticReconcileOnSpecChange(inst *lsv1alpha1.Installation) bool {
 
 func isAutomaticReconcileConfigured(inst *lsv1alpha1.Installation) bool {
 	retryHelper := newRetryHelper(nil, nil)
-	return retryHelper.isRetryActivatedForSucceeded(inst) || retryHelper.isRetryActivatedForFailed(inst)
+	return retryHelper.isRetryActivatedForSucceeded(inst) || retryHelper.isRetryActivatedForFailed(inst) ||
+		retryHelper.isRetryPolicyActivated(inst)
 }
 
 func needsFinalizer(inst *lsv1alpha1.Installation) bool {
@@ -34,8 +34,10 @@ import (
 	"github.com/openmcp-project/landscaper/pkg/landscaper/operation"
 	"github.com/openmcp-project/landscaper/pkg/utils"
 	utilscache "github.com/openmcp-project/landscaper/pkg/utils/cache"
+	"github.com/openmcp-project/landscaper/pkg/utils/deadline"
 	"github.com/openmcp-project/landscaper/pkg/utils/lock"
 	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+	"github.com/openmcp-project/landscaper/pkg/utils/rollout"
 	"github.com/openmcp-project/landscaper/pkg/utils/verify"
 )
 
@@ -65,7 +67,7 @@ func NewController(ctx context.Context,
 		locker:             *lock.NewLocker(lsUncachedClient, hostUncachedClient, callerName),
 	}
 
-	op := operation.NewOperation(scheme, eventRecorder, lsUncachedClient)
+	op := operation.NewOperation(scheme, eventRecorder, lsUncachedClient).SetLsCachedClient(lsCachedClient)
 	ctrl.Operation = *op
 
 	finishedObjectCache, err := prepareFinishedObjectCache(ctx, lsUncachedClient)
@@ -228,6 +230,19 @@ func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 	}
 
+	if isSuspended(inst) {
+		if err := c.handleSuspend(ctx, inst); err != nil {
+			return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if isResumed(inst) {
+		if err := c.handleResume(ctx, inst); err != nil {
+			return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+	}
+
 	return c.handleAutomaticReconcile(ctx, inst)
 }
 
@@ -299,6 +314,20 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		return reconcile.Result{}, nil
 	}
 
+	if hasDeletionImpactOperation(inst) {
+		if err := c.handleDeletionImpactOperation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if hasRunTestsOperation(inst) {
+		if err := c.handleRunTestsOperation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if isNotRootWithReconcileOperation(inst) {
 		// only root installations could be triggered with operation annotation to prevent that end users interfere with overall
 		// algorithm
@@ -331,7 +360,23 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		ctx = octx.BindTo(ctx)
 
 		err := c.handleReconcilePhase(ctx, inst)
-		return utils.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
+		result, resultErr := utils.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
+		if waitTime := rollout.TimeUntilNextWave(inst.Status.Rollout, c.clock.Now()); waitTime > 0 && result.RequeueAfter == 0 {
+			// the rollout of the subinstallations is paused between two waves
+			result.RequeueAfter = waitTime
+		}
+		if waitTime := deadline.TimeUntil(inst, c.clock.Now()); waitTime > 0 && !inst.Status.InstallationPhase.IsFinal() &&
+			!result.Requeue && (result.RequeueAfter == 0 || waitTime < result.RequeueAfter) {
+			// check the timeout of the installation when its deadline is reached
+			result.RequeueAfter = waitTime
+		}
+		return result, resultErr
+	} else if isRunningTestsOnDemand(inst) {
+		// job finished; collect the results of the tests started by the operation run-tests
+		if err := c.handleTestsOnDemand(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
 	} else {
 		// job finished; nothing to do
 		return reconcile.Result{}, nil
@@ -427,13 +472,9 @@ func (c *Controller) handleInterruptOperation(ctx context.Context, inst *lsv1alp
 		return err
 	}
 
-	if exec != nil {
-		lsv1alpha1helper.SetOperation(&exec.ObjectMeta, lsv1alpha1.InterruptOperation)
-		lsv1alpha1helper.Touch(&exec.ObjectMeta)
-
-		if err = c.WriterToLsUncachedClient().UpdateExecution(ctx, read_write_layer.W000098, exec); err != nil {
-			return err
-		}
+	testExec, err := executions.GetTestExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return err
 	}
 
 	subInsts, err := installations.ListSubinstallations(ctx, c.LsUncachedClient(), inst, inst.Status.SubInstCache, read_write_layer.R000090)
@@ -441,11 +482,30 @@ func (c *Controller) handleInterruptOperation(ctx context.Context, inst *lsv1alp
 		return nil
 	}
 
+	return c.interruptSubtree(ctx, subInsts, exec, testExec)
+}
+
+// interruptSubtree triggers the interrupt operation for the executions and the subinstallations of an installation.
+// The subinstallations propagate the interruption to their own subtrees.
+func (c *Controller) interruptSubtree(ctx context.Context, subInsts []*lsv1alpha1.Installation, execs ...*lsv1alpha1.Execution) error {
+	for _, exec := range execs {
+		if exec == nil {
+			continue
+		}
+
+		lsv1alpha1helper.SetOperation(&exec.ObjectMeta, lsv1alpha1.InterruptOperation)
+		lsv1alpha1helper.Touch(&exec.ObjectMeta)
+
+		if err := c.WriterToLsUncachedClient().UpdateExecution(ctx, read_write_layer.W000098, exec); err != nil {
+			return err
+		}
+	}
+
 	for _, subInst := range subInsts {
 		lsv1alpha1helper.SetOperation(&subInst.ObjectMeta, lsv1alpha1.InterruptOperation)
 		lsv1alpha1helper.Touch(&subInst.ObjectMeta)
 
-		if err = c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000099, subInst); err != nil {
+		if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000099, subInst); err != nil {
 			return err
 		}
 	}
@@ -476,6 +536,11 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 	}
 	inst.Status.InstallationPhase = phase
 
+	if phase != lsv1alpha1.InstallationPhases.Init && phase != lsv1alpha1.InstallationPhases.Progressing {
+		// the installation is only blocked by other objects while it waits in phase Init or Progressing
+		inst.Status.BlockedBy = nil
+	}
+
 	if phase.IsFinal() {
 		if installations.IsRootInstallation(inst) {
 			err := utilscache.GetOCMContextCache().RemoveOCMContext(ctx, inst.Status.JobID)
@@ -15,6 +15,7 @@ import (
 
 	"github.com/openmcp-project/landscaper/apis/config"
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
 	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
 	"github.com/openmcp-project/landscaper/pkg/api"
@@ -146,6 +147,117 @@ var _ = Describe("Installation Controller", func() {
 			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subinst), subinst))
 			Expect(subinst.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.InterruptOperation)))
 		})
+
+		Context("suspend", func() {
+
+			var (
+				inst    *lsv1alpha1.Installation
+				exec    *lsv1alpha1.Execution
+				subinst *lsv1alpha1.Installation
+			)
+
+			expectSuspendedCondition := func(obj *lsv1alpha1.Installation, status lsv1alpha1.ConditionStatus, reason string) {
+				cond := lsv1alpha1helper.GetCondition(obj.Status.Conditions, lsv1alpha1.SuspendedCondition)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Status).To(Equal(status))
+				Expect(cond.Reason).To(Equal(reason))
+			}
+
+			BeforeEach(func() {
+				// We consider an unfinished Installation with an Execution and a subinstallation.
+				// The Installation has spec.suspend set to true.
+				ctx := context.Background()
+
+				var err error
+				state, err = testenv.InitResources(ctx, "./testdata/state/test12")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+				inst = &lsv1alpha1.Installation{}
+				inst.Name = "root"
+				inst.Namespace = state.Namespace
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+
+				exec = &lsv1alpha1.Execution{}
+				exec.Name = inst.Status.ExecutionReference.Name
+				exec.Namespace = state.Namespace
+
+				subinst = &lsv1alpha1.Installation{}
+				subinst.Name = "subinst"
+				subinst.Namespace = state.Namespace
+
+				testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			})
+
+			It("should suspend the subtree of a suspended installation", func() {
+				ctx := context.Background()
+
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+				expectSuspendedCondition(inst, lsv1alpha1.ConditionTrue, lsv1alpha1.SuspendedReason)
+				Expect(inst.Status.JobID).To(Equal("job2"))
+				Expect(inst.Status.JobIDFinished).To(Equal("job1"))
+				Expect(inst.Status.InstallationPhase).To(Equal(lsv1alpha1.InstallationPhases.Progressing))
+
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec))
+				Expect(exec.Annotations).To(HaveKeyWithValue(lsv1alpha1.SuspendedAnnotation, "true"))
+
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subinst), subinst))
+				Expect(subinst.Annotations).To(HaveKeyWithValue(lsv1alpha1.SuspendedAnnotation, "true"))
+
+				// the subinstallation is suspended by its parent
+				testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subinst))
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subinst), subinst))
+				expectSuspendedCondition(subinst, lsv1alpha1.ConditionTrue, lsv1alpha1.SuspendedByParentReason)
+				Expect(subinst.Status.JobID).To(Equal("job2"))
+				Expect(subinst.Status.JobIDFinished).To(Equal("job1"))
+			})
+
+			It("should resume the subtree and continue the job in its current phase", func() {
+				ctx := context.Background()
+
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+				inst.Spec.Suspend = false
+				testutils.ExpectNoError(testenv.Client.Update(ctx, inst))
+
+				testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+				expectSuspendedCondition(inst, lsv1alpha1.ConditionFalse, lsv1alpha1.ResumedReason)
+				Expect(inst.Status.JobID).To(Equal("job2"))
+				Expect(inst.Status.JobIDFinished).To(Equal("job1"))
+				Expect(inst.Status.InstallationPhase).To(Equal(lsv1alpha1.InstallationPhases.Progressing))
+
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec))
+				Expect(exec.Annotations).ToNot(HaveKey(lsv1alpha1.SuspendedAnnotation))
+
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subinst), subinst))
+				Expect(subinst.Annotations).ToNot(HaveKey(lsv1alpha1.SuspendedAnnotation))
+			})
+
+			It("should not block the deletion of a suspended installation", func() {
+				ctx := context.Background()
+
+				// the job of the installation has finished
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+				inst.Status.JobIDFinished = inst.Status.JobID
+				inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+				testutils.ExpectNoError(testenv.Client.Status().Update(ctx, inst))
+
+				testutils.ExpectNoError(testenv.Client.Delete(ctx, inst))
+				testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+				// the subtree is resumed and a delete job is started
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+				expectSuspendedCondition(inst, lsv1alpha1.ConditionFalse, lsv1alpha1.ResumedReason)
+				Expect(inst.Status.JobID).ToNot(Equal(inst.Status.JobIDFinished))
+
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec))
+				Expect(exec.Annotations).ToNot(HaveKey(lsv1alpha1.SuspendedAnnotation))
+
+				testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subinst), subinst))
+				Expect(subinst.Annotations).ToNot(HaveKey(lsv1alpha1.SuspendedAnnotation))
+			})
+		})
 	})
 
 })
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/execution"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/installations"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions"
+	"github.com/openmcp-project/landscaper/pkg/utils/deletion"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+func hasDeletionImpactOperation(inst *lsv1alpha1.Installation) bool {
+	return lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.DeletionImpactOperation)
+}
+
+// handleDeletionImpactOperation computes the objects which would be deleted together with the installation and
+// writes them into the status of the installation. Nothing is deleted.
+func (c *Controller) handleDeletionImpactOperation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000175, inst); err != nil {
+		return err
+	}
+
+	impact := &lsv1alpha1.DeletionImpact{
+		ComputationTime: metav1.Now(),
+	}
+	withoutUninstall := lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(inst.ObjectMeta)
+	if err := c.collectDeletionImpact(ctx, inst, withoutUninstall, impact); err != nil {
+		return err
+	}
+	sortDeletionImpact(impact)
+	deletion.LimitImpact(impact)
+
+	logger.Info("deletion impact computed", "installations", impact.TotalInstallations,
+		"executions", impact.TotalExecutions, "deployItems", impact.TotalDeployItems,
+		"managedResources", impact.TotalManagedResources, "truncated", impact.Truncated)
+
+	inst.Status.DeletionImpact = impact
+	return c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000176, inst)
+}
+
+// collectDeletionImpact adds the execution, the deploy items, and the subinstallations of the given installation to
+// the deletion impact, and continues recursively with the subinstallations. The managed resources of the deploy
+// items are only added if they are uninstalled.
+func (c *Controller) collectDeletionImpact(ctx context.Context, inst *lsv1alpha1.Installation, withoutUninstall bool,
+	impact *lsv1alpha1.DeletionImpact) error {
+
+	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return err
+	}
+
+	if exec != nil {
+		impact.Executions = append(impact.Executions, lsv1alpha1.ObjectReference{Name: exec.Name, Namespace: exec.Namespace})
+
+		deployItems, err := execution.ListManagedDeployItems(ctx, c.LsUncachedClient(), exec, read_write_layer.R000129, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, di := range deployItems {
+			impact.DeployItems = append(impact.DeployItems, lsv1alpha1.ObjectReference{Name: di.Name, Namespace: di.Namespace})
+
+			if withoutUninstall || lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(di.ObjectMeta) {
+				continue
+			}
+
+			managedResources, err := deletion.ManagedResources(di)
+			if err != nil {
+				return err
+			}
+			impact.ManagedResources = append(impact.ManagedResources, managedResources...)
+		}
+	}
+
+	subInsts, err := installations.ListSubinstallations(ctx, c.LsUncachedClient(), inst, nil, read_write_layer.R000130)
+	if err != nil {
+		return err
+	}
+
+	for _, subInst := range subInsts {
+		impact.Installations = append(impact.Installations, lsv1alpha1.ObjectReference{Name: subInst.Name, Namespace: subInst.Namespace})
+
+		subWithoutUninstall := withoutUninstall || lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(subInst.ObjectMeta)
+		if err := c.collectDeletionImpact(ctx, subInst, subWithoutUninstall, impact); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sortDeletionImpact(impact *lsv1alpha1.DeletionImpact) {
+	for _, refs := range [][]lsv1alpha1.ObjectReference{impact.Installations, impact.Executions, impact.DeployItems} {
+		sort.Slice(refs, func(i, j int) bool {
+			return refs[i].Name < refs[j].Name
+		})
+	}
+
+	sort.Slice(impact.ManagedResources, func(i, j int) bool {
+		a, b := impact.ManagedResources[i], impact.ManagedResources[j]
+		if a.DeployItem.Name != b.DeployItem.Name {
+			return a.DeployItem.Name < b.DeployItem.Name
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
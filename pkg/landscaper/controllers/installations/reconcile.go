@@ -18,6 +18,7 @@ import (
 	lserrors "github.com/openmcp-project/landscaper/apis/errors"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
 	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/execution"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/exports"
@@ -25,7 +26,11 @@ import (
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/reconcilehelper"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/subinstallations"
 	lsutil "github.com/openmcp-project/landscaper/pkg/utils"
+	"github.com/openmcp-project/landscaper/pkg/utils/deadline"
 	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+	"github.com/openmcp-project/landscaper/pkg/utils/retry"
+	"github.com/openmcp-project/landscaper/pkg/utils/rollout"
+	"github.com/openmcp-project/landscaper/pkg/utils/summary"
 )
 
 func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
@@ -43,6 +48,7 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		}
 
 		inst.Status.SubInstCache = nil
+		inst.Status.TimedOut = nil
 
 		nextPhase := lsv1alpha1.InstallationPhases.Init
 		if !inst.DeletionTimestamp.IsZero() {
@@ -61,7 +67,12 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		}
 	}
 
+	if inst.DeletionTimestamp.IsZero() && deadline.IsExceeded(inst, c.clock.Now()) {
+		return c.handleTimeout(ctx, inst)
+	}
+
 	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Init {
+		inst.Status.BlockedBy = nil
 		fatalError, normalError := c.handlePhaseInit(ctx, inst, subInstCache)
 
 		inst.Status.ObservedGeneration = inst.GetGeneration()
@@ -121,7 +132,7 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 	}
 
 	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Progressing {
-		allSucceeded, failedSubInsts, isExecFailed, err := c.handlePhaseProgressing(ctx, inst)
+		allSucceeded, failedSubInsts, isExecFailed, failureCodes, err := c.handlePhaseProgressing(ctx, inst)
 		if err != nil {
 			// error or unfinished subobjects => phase remains progressing
 			return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, err,
@@ -132,9 +143,22 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		var lsError lserrors.LsError
 
 		if allSucceeded {
-			nextPhase = lsv1alpha1.InstallationPhases.Completing
+			// the tests run after all other children have succeeded
+			testsFailed, err := c.handleTests(ctx, inst)
+			if err != nil {
+				// error or unfinished tests => phase remains progressing
+				return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, err,
+					read_write_layer.W000187, false)
+			}
+
+			if testsFailed {
+				lsError = buildTestError(inst.Status.Tests)
+				nextPhase = lsv1alpha1.InstallationPhases.Failed
+			} else {
+				nextPhase = lsv1alpha1.InstallationPhases.Completing
+			}
 		} else {
-			lsError = buildErrorIfFailedChild(failedSubInsts, isExecFailed)
+			lsError = buildErrorIfFailedChild(failedSubInsts, isExecFailed, failureCodes)
 			nextPhase = lsv1alpha1.InstallationPhases.Failed
 		}
 
@@ -229,7 +253,9 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 	return nil
 }
 
-func buildErrorIfFailedChild(failedSubInsts []string, isExecFailed bool) lserrors.LsError {
+// buildErrorIfFailedChild returns the error of an installation with failed children. The error has the error codes
+// of the failures of the children, so that they can be matched by a retry policy.
+func buildErrorIfFailedChild(failedSubInsts []string, isExecFailed bool, codes []lsv1alpha1.ErrorCode) lserrors.LsError {
 	if len(failedSubInsts) > 0 || isExecFailed {
 		msg := ""
 
@@ -241,7 +267,7 @@ func buildErrorIfFailedChild(failedSubInsts []string, isExecFailed bool) lserror
 		if isExecFailed {
 			msg += "Execution is failed"
 		}
-		return lserrors.NewError("buildErrorIfFailedChild", "children failed", msg)
+		return lserrors.NewError("buildErrorIfFailedChild", "children failed", msg, codes...)
 	}
 	return nil
 }
@@ -266,6 +292,10 @@ func (c *Controller) handlePhaseInit(ctx context.Context, inst *lsv1alpha1.Insta
 	}
 
 	if err := c.CreateImportsAndSubobjects(ctx, instOp, imps, subInstCache); err != nil {
+		if lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorUnfinished) {
+			// the deletion of orphaned subinstallations waits for a confirmation
+			return nil, lserrors.NewWrappedError(err, currentOperation, "CreateImportsAndSubobjects", err.Error())
+		}
 		return lserrors.NewWrappedError(err, currentOperation, "CreateImportsAndSubobjects", err.Error()), nil
 	}
 
@@ -319,7 +349,8 @@ func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation, ru
 
 	predecessorMap := map[string]*installations.InstallationAndImports{}
 
-	if inst.Spec.Optimization == nil || !inst.Spec.Optimization.HasNoSiblingImports {
+	// explicit dependencies are respected even if the installation has no sibling imports
+	if inst.Spec.Optimization == nil || !inst.Spec.Optimization.HasNoSiblingImports || len(inst.Spec.DependsOn) > 0 {
 		predecessors, err := rh.FetchPredecessors(ctx)
 		if err != nil {
 			fatalError = lserrors.NewWrappedError(err, currentOperation, "FetchPredecessors", err.Error())
@@ -333,6 +364,7 @@ func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation, ru
 		}
 
 		if err = rh.AllPredecessorsFinished(ctx, inst, predecessorMap); err != nil {
+			inst.Status.BlockedBy = rh.UnfinishedPredecessors(inst, predecessorMap)
 			normalError := lserrors.NewWrappedError(err, currentOperation, "AllPredecessorsFinished", err.Error())
 			return nil, nil, "", nil, nil, normalError
 		}
@@ -424,14 +456,8 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 	}
 
 	// trigger subinstallations
-	for _, next := range subInsts {
-		if next.Status.JobID != inst.Status.JobID {
-			next.Status.JobID = inst.Status.JobID
-			next.Status.TransitionTimes = lsutil.NewTransitionTimes()
-			if err = c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000083, next); err != nil {
-				return lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationStatus", err.Error())
-			}
-		}
+	if _, lsErr := c.triggerSubinstallations(ctx, inst, subInsts, read_write_layer.W000083); lsErr != nil {
+		return lsErr
 	}
 
 	if inst.Status.ExecutionReference != nil {
@@ -453,23 +479,64 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 	return nil
 }
 
-func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha1.Installation) (allSucceeded bool, failedSubInstNames []string, executionFailed bool, lsErr lserrors.LsError) {
+func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha1.Installation) (allSucceeded bool, failedSubInstNames []string, executionFailed bool,
+	failureCodes []lsv1alpha1.ErrorCode, lsErr lserrors.LsError) {
 	currentOperation := "handlePhaseProgressing"
 
 	allSucceeded = true
 
 	subInsts, err := installations.ListSubinstallations(ctx, c.LsUncachedClient(), inst, inst.Status.SubInstCache, read_write_layer.R000087)
 	if err != nil {
-		return false, nil, false, lserrors.NewWrappedError(err, currentOperation, "ListSubinstallations", err.Error())
+		return false, nil, false, nil, lserrors.NewWrappedError(err, currentOperation, "ListSubinstallations", err.Error())
+	}
+
+	// trigger the subinstallations of the next wave of the rollout
+	waiting, lsErr := c.triggerSubinstallations(ctx, inst, subInsts, read_write_layer.W000154)
+	if lsErr != nil {
+		return false, nil, false, nil, lsErr
+	}
+
+	var exec *lsv1alpha1.Execution
+	if inst.Status.ExecutionReference != nil {
+		key := client.ObjectKey{Namespace: inst.Status.ExecutionReference.Namespace, Name: inst.Status.ExecutionReference.Name}
+		exec = &lsv1alpha1.Execution{}
+		if err := read_write_layer.GetExecution(ctx, c.LsUncachedClient(), key, exec, read_write_layer.R000024); err != nil {
+			return false, nil, false, nil, lserrors.NewWrappedError(err, currentOperation, "GetExecution", err.Error())
+		}
+	}
+
+	var deployItems []*lsv1alpha1.DeployItem
+	if exec != nil {
+		deployItems, err = execution.ListManagedDeployItems(ctx, c.LsUncachedClient(), exec, read_write_layer.R000128,
+			exec.Status.DeployItemCache)
+		if err != nil {
+			return false, nil, false, nil, lserrors.NewWrappedError(err, currentOperation, "ListManagedDeployItems", err.Error())
+		}
 	}
 
+	// collect the subinstallations and deploy items for which the installation is waiting
+	blockedBy := []lsv1alpha1.BlockingObject{}
+	for _, next := range subInsts {
+		if blockingObject := blockingSubinstallation(inst, next); blockingObject != nil {
+			blockedBy = append(blockedBy, *blockingObject)
+		}
+	}
+	inst.Status.BlockedBy = append(blockedBy, blockingExecution(exec, deployItems)...)
+	inst.Status.Summary = summary.Compute(inst, subInsts, exec, deployItems)
+
 	failedSubInstNames = []string{}
+	failedErrors := []*lsv1alpha1.Error{}
 
 	for _, next := range subInsts {
+		if next.Status.JobID != inst.Status.JobID {
+			// the subinstallation is waiting for a later wave of the rollout
+			continue
+		}
+
 		if next.Status.JobIDFinished != next.Status.JobID {
 			// Hack: being unfinished should not be treated as an error
 			message := fmt.Sprintf("installation %s / %s is not finished yet", next.Namespace, next.Name)
-			return false, nil, false, lserrors.NewError(currentOperation, "JobIDFinished", message,
+			return false, nil, false, nil, lserrors.NewError(currentOperation, "JobIDFinished", message,
 				lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
 		}
 
@@ -477,21 +544,32 @@ func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha
 
 		if next.Status.InstallationPhase.IsFailed() {
 			failedSubInstNames = append(failedSubInstNames, next.Name)
+			failedErrors = append(failedErrors, next.Status.LastError)
 		}
 	}
 
-	executionFailed = false
-
-	if inst.Status.ExecutionReference != nil {
-		key := client.ObjectKey{Namespace: inst.Status.ExecutionReference.Namespace, Name: inst.Status.ExecutionReference.Name}
-		exec := &lsv1alpha1.Execution{}
-		if err := read_write_layer.GetExecution(ctx, c.LsUncachedClient(), key, exec, read_write_layer.R000024); err != nil {
-			return false, nil, false, lserrors.NewWrappedError(err, currentOperation, "GetExecution", err.Error())
+	if waiting {
+		if len(failedSubInstNames) == 0 || !rollout.StopOnFailure(inst.Spec.Rollout) {
+			message := fmt.Sprintf("subinstallations of installation %s / %s are waiting for the next wave of the rollout",
+				inst.Namespace, inst.Name)
+			return false, nil, false, nil, lserrors.NewError(currentOperation, "RolloutWaves", message,
+				lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
 		}
 
+		// the rollout stops because of the failed subinstallations
+		allSucceeded = false
+	}
+
+	executionFailed = false
+
+	if exec != nil {
 		if exec.Status.JobIDFinished != exec.Status.JobID {
 			message := fmt.Sprintf("execution %s / %s is not finished yet", exec.Namespace, exec.Name)
-			return false, nil, false, lserrors.NewError(currentOperation, "JobIDFinished", message,
+			if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.WaitingForApproval && exec.Status.PendingApproval != nil {
+				message = fmt.Sprintf("execution %s / %s is waiting for approval of change %s", exec.Namespace, exec.Name,
+					exec.Status.PendingApproval.Digest)
+			}
+			return false, nil, false, nil, lserrors.NewError(currentOperation, "JobIDFinished", message,
 				lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
 		}
 
@@ -499,10 +577,11 @@ func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha
 
 		if exec.Status.ExecutionPhase.IsFailed() {
 			executionFailed = true
+			failedErrors = append(failedErrors, exec.Status.LastError)
 		}
 	}
 
-	return allSucceeded, failedSubInstNames, executionFailed, nil
+	return allSucceeded, failedSubInstNames, executionFailed, retry.FailureCodes(failedErrors...), nil
 }
 
 func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1.Installation) (lserrors.LsError, lserrors.LsError) {
@@ -575,6 +654,9 @@ func (c *Controller) CreateImportsAndSubobjects(ctx context.Context, op *install
 	}
 
 	subinstallation := subinstallations.New(op)
+	if c.LsConfig != nil {
+		subinstallation.DeletionSafeguard = c.LsConfig.DeletionSafeguard
+	}
 	if err := subinstallation.Ensure(ctx, subInstCache); err != nil {
 		return lserrors.NewWrappedError(err, currOp, "EnsureSubinstallations", err.Error())
 	}
@@ -584,6 +666,10 @@ func (c *Controller) CreateImportsAndSubobjects(ctx context.Context, op *install
 		return lserrors.NewWrappedError(err, currOp, "ReconcileExecution", err.Error())
 	}
 
+	if err := exec.EnsureTests(ctx, inst); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ReconcileTestExecution", err.Error())
+	}
+
 	return nil
 }
 
@@ -36,12 +36,12 @@ func (c *Controller) handleDeletionPhaseInit(ctx context.Context, inst *lsv1alph
 		return fatalError, normalError
 	}
 
-	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	execs, err := c.getExecutions(ctx, inst)
 	if err != nil {
 		return lserrors.NewWrappedError(err, op, "GetExecutionForInstallation", err.Error()), nil
 	}
 
-	if exec != nil {
+	for _, exec := range execs {
 		if lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(inst.ObjectMeta) &&
 			!lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(exec.ObjectMeta) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.DeleteWithoutUninstallAnnotation, "true")
@@ -95,16 +95,18 @@ func (c *Controller) handleDeletionPhaseInit(ctx context.Context, inst *lsv1alph
 
 func (c *Controller) handleDeletionPhaseTriggerDeleting(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
 	op := "handleDeletionPhaseTriggerDeleting"
-	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	execs, err := c.getExecutions(ctx, inst)
 	if err != nil {
 		return lserrors.NewWrappedError(err, op, "GetExecutionForInstallation", err.Error())
 	}
 
-	if exec != nil && exec.Status.JobID != inst.Status.JobID {
-		exec.Status.JobID = inst.Status.JobID
-		exec.Status.TransitionTimes = lsutil.NewTransitionTimes()
-		if err = c.WriterToLsUncachedClient().UpdateExecutionStatus(ctx, read_write_layer.W000093, exec); err != nil {
-			return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
+	for _, exec := range execs {
+		if exec.Status.JobID != inst.Status.JobID {
+			exec.Status.JobID = inst.Status.JobID
+			exec.Status.TransitionTimes = lsutil.NewTransitionTimes()
+			if err = c.WriterToLsUncachedClient().UpdateExecutionStatus(ctx, read_write_layer.W000093, exec); err != nil {
+				return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
+			}
 		}
 	}
 
@@ -130,7 +132,7 @@ func (c *Controller) handleDeletionPhaseDeleting(ctx context.Context, inst *lsv1
 	op := "handleDeletionPhaseDeleting"
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
-	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	execs, err := c.getExecutions(ctx, inst)
 	if err != nil {
 		return false, false, lserrors.NewWrappedError(err, op, "GetExecutionForInstallation", err.Error())
 	}
@@ -140,7 +142,7 @@ func (c *Controller) handleDeletionPhaseDeleting(ctx context.Context, inst *lsv1
 		return false, false, lserrors.NewWrappedError(err, op, "ListSubinstallations", err.Error())
 	}
 
-	if exec == nil && len(subInsts) == 0 {
+	if len(execs) == 0 && len(subInsts) == 0 {
 		controllerutil.RemoveFinalizer(inst, lsv1alpha1.LandscaperFinalizer)
 		if err = c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000095, inst); err != nil {
 			return false, false, lserrors.NewWrappedError(err, op, "UpdateInstallation", err.Error())
@@ -174,7 +176,7 @@ func (c *Controller) handleDeletionPhaseDeleting(ctx context.Context, inst *lsv1
 	}
 
 	// check if all finished
-	if exec != nil {
+	for _, exec := range execs {
 		if exec.Status.JobIDFinished != inst.Status.JobID {
 			return false, false, nil
 		}
@@ -190,6 +192,29 @@ func (c *Controller) handleDeletionPhaseDeleting(ctx context.Context, inst *lsv1
 	return true, false, nil
 }
 
+// getExecutions returns the existing executions of an installation, i.e. its execution and its test execution.
+func (c *Controller) getExecutions(ctx context.Context, inst *lsv1alpha1.Installation) ([]*lsv1alpha1.Execution, error) {
+	execs := []*lsv1alpha1.Execution{}
+
+	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return nil, err
+	}
+	if exec != nil {
+		execs = append(execs, exec)
+	}
+
+	testExec, err := executions.GetTestExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return nil, err
+	}
+	if testExec != nil {
+		execs = append(execs, testExec)
+	}
+
+	return execs, nil
+}
+
 func (c *Controller) deleteAllowed(ctx context.Context, inst *lsv1alpha1.Installation) (fatalError lserrors.LsError, normalError lserrors.LsError) {
 	op := "DeleteInstallationAllowed"
 
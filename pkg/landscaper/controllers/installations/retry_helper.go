@@ -6,6 +6,7 @@ package installations
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"github.com/robfig/cron/v3"
@@ -20,6 +21,7 @@ import (
 	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
 	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+	"github.com/openmcp-project/landscaper/pkg/utils/retry"
 )
 
 const (
@@ -50,6 +52,7 @@ func (r *retryHelper) preProcessRetry(ctx context.Context, inst *lsv1alpha1.Inst
 	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) &&
 		!r.hasReconcileReasonRetry(inst.ObjectMeta) {
 		// reconcile was not triggered by the retry mechanism, therefore we reset the retry status
+		inst.Status.Retry = nil
 		if err := r.resetRetryStatus(ctx, inst, read_write_layer.W000051); err != nil {
 			return err
 		}
@@ -89,7 +92,16 @@ func (r *retryHelper) recomputeRetry(ctx context.Context, inst *lsv1alpha1.Insta
 		}
 	}
 
-	if r.isRetryActivatedForFailed(inst) && r.isFailed(inst) {
+	if inst.Status.Retry != nil && r.isSucceeded(inst) {
+		if err := r.resetRetryPolicyStatus(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if r.isRetryPolicyActivated(inst) && r.isFailed(inst) {
+		return r.recomputeRetryForRetryPolicy(ctx, inst, oldResult, oldError)
+
+	} else if r.isRetryActivatedForFailed(inst) && r.isFailed(inst) {
 		return r.recomputeRetryForFailed(ctx, inst, oldResult, oldError)
 
 	} else if r.isRetryActivatedForSucceeded(inst) && r.isSucceeded(inst) {
@@ -147,6 +159,62 @@ func (r *retryHelper) recomputeRetryForFailed(ctx context.Context, inst *lsv1alp
 	}, nil
 }
 
+func (r *retryHelper) isRetryPolicyActivated(inst *lsv1alpha1.Installation) bool {
+	return inst.Spec.RetryPolicy != nil
+}
+
+// recomputeRetryForRetryPolicy retries a failed installation according to its retry policy. Whether the installation
+// is retried depends on the error codes of its last error. The waiting time before a retry grows exponentially.
+func (r *retryHelper) recomputeRetryForRetryPolicy(ctx context.Context, inst *lsv1alpha1.Installation, oldResult reconcile.Result, oldError error) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	codes := retry.FailureCodes(inst.Status.LastError)
+	status, start := retry.Plan(inst.Spec.RetryPolicy, inst.Status.Retry, inst.GetGeneration(), codes, r.now())
+
+	if start {
+		if err := r.addReconcileAnnotation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if !reflect.DeepEqual(status, inst.Status.Retry) {
+		inst.Status.Retry = status
+
+		logger.Info("update retry status of retry policy", "attempts", status.Attempts, "nextRetryTime", status.NextRetryTime)
+		if err := r.writer.UpdateInstallationStatus(ctx, read_write_layer.W000169, inst); err != nil {
+			logger.Error(err, "failed to update retry status of retry policy")
+			return reconcile.Result{}, err
+		}
+	}
+
+	if start {
+		return reconcile.Result{}, nil
+	}
+
+	if status.NextRetryTime != nil {
+		// too early
+		return reconcile.Result{
+			RequeueAfter: retry.TimeUntilNextRetry(status, r.now()),
+		}, nil
+	}
+
+	// no retry for the error codes of the failure, or maximal number of retries done
+	return oldResult, oldError
+}
+
+func (r *retryHelper) resetRetryPolicyStatus(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	logger.Debug("reset retry status of retry policy")
+	inst.Status.Retry = nil
+	if err := r.writer.UpdateInstallationStatus(ctx, read_write_layer.W000170, inst); err != nil {
+		logger.Error(err, "failed to reset retry status of retry policy")
+		return err
+	}
+
+	return nil
+}
+
 func (r *retryHelper) updateRetryStatus(ctx context.Context, inst *lsv1alpha1.Installation, numRetries int, onFailed bool) error {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
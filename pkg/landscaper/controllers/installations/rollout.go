@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/apis/core/validation"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	lsutil "github.com/openmcp-project/landscaper/pkg/utils"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+	"github.com/openmcp-project/landscaper/pkg/utils/rollout"
+)
+
+// triggerSubinstallations starts the current job of the installation for its subinstallations.
+// If the installation has a rollout policy, the subinstallations which import an element of a targetList or targetMap
+// import are started wave by wave. The function returns true if subinstallations are waiting for a later wave.
+func (c *Controller) triggerSubinstallations(ctx context.Context, inst *lsv1alpha1.Installation,
+	subInsts []*lsv1alpha1.Installation, writeID read_write_layer.WriteID) (bool, lserrors.LsError) {
+
+	currentOperation := "triggerSubinstallations"
+
+	released := func(*lsv1alpha1.Installation) bool { return true }
+
+	if inst.Spec.Rollout == nil {
+		inst.Status.Rollout = nil
+	} else {
+		groupNames := []string{}
+		groups := map[string]*rollout.Group{}
+		for _, next := range subInsts {
+			name := rolloutGroupOfSubinstallation(next)
+			if len(name) == 0 {
+				continue
+			}
+
+			group, ok := groups[name]
+			if !ok {
+				group = &rollout.Group{Name: name}
+				groups[name] = group
+				groupNames = append(groupNames, name)
+			}
+
+			if next.Status.JobID != inst.Status.JobID || next.Status.JobIDFinished != inst.Status.JobID {
+				group.Active = true
+			} else if next.Status.InstallationPhase.IsFailed() {
+				group.Failed = true
+			}
+		}
+
+		sort.Strings(groupNames)
+		orderedGroups := make([]rollout.Group, len(groupNames))
+		for i, name := range groupNames {
+			orderedGroups[i] = *groups[name]
+		}
+
+		var releasedGroups sets.Set[string]
+		inst.Status.Rollout, releasedGroups = rollout.Plan(inst.Spec.Rollout, inst.Status.JobID, orderedGroups,
+			inst.Status.Rollout, c.clock.Now())
+
+		released = func(subInst *lsv1alpha1.Installation) bool {
+			name := rolloutGroupOfSubinstallation(subInst)
+			if len(name) == 0 {
+				return true
+			}
+			return releasedGroups.Has(name)
+		}
+	}
+
+	waiting := false
+	for _, next := range subInsts {
+		if !released(next) {
+			waiting = true
+			continue
+		}
+
+		if next.Status.JobID != inst.Status.JobID {
+			next.Status.JobID = inst.Status.JobID
+			next.Status.TransitionTimes = lsutil.NewTransitionTimes()
+			if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, writeID, next); err != nil {
+				return false, lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationStatus", err.Error())
+			}
+		}
+	}
+
+	return waiting, nil
+}
+
+// rolloutGroupOfSubinstallation returns the element of a targetList or targetMap import of the parent installation
+// which is imported by a subinstallation, or an empty string if the subinstallation imports no such element.
+func rolloutGroupOfSubinstallation(subInst *lsv1alpha1.Installation) string {
+	for _, imp := range subInst.Spec.Imports.Targets {
+		if isIndexed, _ := validation.IsIndexed(imp.Target); isIndexed {
+			return imp.Target
+		}
+	}
+	return ""
+}
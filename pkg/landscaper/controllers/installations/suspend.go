@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/installations"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+// isSuspended returns whether the installation is suspended, either by its own spec or by a suspended parent.
+// An installation which is being deleted is not suspended, so that a suspension cannot block the deletion.
+func isSuspended(inst *lsv1alpha1.Installation) bool {
+	return inst.DeletionTimestamp.IsZero() &&
+		(inst.Spec.Suspend || lsv1alpha1helper.HasSuspendedAnnotation(inst.ObjectMeta))
+}
+
+// isResumed returns whether the installation has been suspended and is not suspended anymore.
+func isResumed(inst *lsv1alpha1.Installation) bool {
+	cond := lsv1alpha1helper.GetCondition(inst.Status.Conditions, lsv1alpha1.SuspendedCondition)
+	return !isSuspended(inst) && cond != nil && cond.Status == lsv1alpha1.ConditionTrue
+}
+
+// handleSuspend suspends the subtree of a suspended installation by adding the suspended annotation to its
+// subinstallations and its execution. The execution forwards the annotation to its deploy items.
+// The phase and the job of the installation remain unchanged, so that the reconciliation can continue after a resume.
+func (c *Controller) handleSuspend(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	if err := c.setSuspendedAnnotationOfSubtree(ctx, inst, true); err != nil {
+		return err
+	}
+
+	reason, message := lsv1alpha1.SuspendedReason, "installation is suspended"
+	if !inst.Spec.Suspend {
+		reason, message = lsv1alpha1.SuspendedByParentReason, "installation is suspended by its parent installation"
+	}
+
+	cond := lsv1alpha1helper.GetOrInitCondition(inst.Status.Conditions, lsv1alpha1.SuspendedCondition)
+	if cond.Status == lsv1alpha1.ConditionTrue && cond.Reason == reason {
+		return nil
+	}
+
+	logger.Info("suspending installation", "reason", reason)
+	inst.Status.Conditions = lsv1alpha1helper.MergeConditions(inst.Status.Conditions,
+		lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue, reason, message))
+	return c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000160, inst)
+}
+
+// handleResume removes the suspended annotation from the subtree of a resumed installation.
+func (c *Controller) handleResume(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	logger.Info("resuming installation")
+
+	if err := c.setSuspendedAnnotationOfSubtree(ctx, inst, false); err != nil {
+		return err
+	}
+
+	cond := lsv1alpha1helper.GetOrInitCondition(inst.Status.Conditions, lsv1alpha1.SuspendedCondition)
+	inst.Status.Conditions = lsv1alpha1helper.MergeConditions(inst.Status.Conditions,
+		lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse, lsv1alpha1.ResumedReason, "installation has been resumed"))
+	return c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000161, inst)
+}
+
+func (c *Controller) setSuspendedAnnotationOfSubtree(ctx context.Context, inst *lsv1alpha1.Installation, suspended bool) error {
+	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return err
+	}
+
+	if exec != nil && lsv1alpha1helper.SetSuspendedAnnotation(&exec.ObjectMeta, suspended) {
+		if err = c.WriterToLsUncachedClient().UpdateExecution(ctx, read_write_layer.W000162, exec); err != nil {
+			return err
+		}
+	}
+
+	subInsts, err := installations.ListSubinstallations(ctx, c.LsUncachedClient(), inst, inst.Status.SubInstCache, read_write_layer.R000125)
+	if err != nil {
+		return err
+	}
+
+	for _, subInst := range subInsts {
+		if lsv1alpha1helper.SetSuspendedAnnotation(&subInst.ObjectMeta, suspended) {
+			if err = c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000163, subInst); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/execution"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+// handleTests runs the tests of an installation in phase Progressing, after the execution and all subinstallations
+// have succeeded. It returns whether the tests have failed. While the tests are running, it returns an error with
+// code ErrorUnfinished, so that the installation remains in phase Progressing.
+func (c *Controller) handleTests(ctx context.Context, inst *lsv1alpha1.Installation) (bool, lserrors.LsError) {
+	op := "handleTests"
+
+	if inst.Status.TestExecutionReference == nil {
+		return false, nil
+	}
+
+	testExec, err := executions.GetTestExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return false, lserrors.NewWrappedError(err, op, "GetTestExecutionForInstallation", err.Error())
+	}
+	if testExec == nil {
+		return false, lserrors.NewError(op, "GetTestExecutionForInstallation",
+			fmt.Sprintf("test execution %s not found", inst.Status.TestExecutionReference.Name))
+	}
+
+	if inst.Status.Tests == nil || inst.Status.Tests.JobID != inst.Status.JobID {
+		if err := c.startTests(ctx, inst, testExec, inst.Status.JobID); err != nil {
+			return false, lserrors.NewWrappedError(err, op, "StartTests", err.Error())
+		}
+	}
+
+	if testExec.Status.JobIDFinished != inst.Status.Tests.JobID {
+		inst.Status.BlockedBy = append(inst.Status.BlockedBy, lsv1alpha1helper.NewBlockingObject(utils.ExecutionKind,
+			testExec, lsv1alpha1.TestsNotFinishedReason, "tests are not finished yet"))
+		message := fmt.Sprintf("tests of installation %s / %s are not finished yet", inst.Namespace, inst.Name)
+		return false, lserrors.NewError(op, "TestsNotFinished", message,
+			lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+	}
+
+	if err := c.completeTests(ctx, inst, testExec); err != nil {
+		return false, lserrors.NewWrappedError(err, op, "CompleteTests", err.Error())
+	}
+
+	return inst.Status.Tests.Phase != lsv1alpha1.ExecutionPhases.Succeeded, nil
+}
+
+// startTests starts a job of the test execution and resets the test status of the installation.
+// The caller has to update the status of the installation.
+func (c *Controller) startTests(ctx context.Context, inst *lsv1alpha1.Installation, testExec *lsv1alpha1.Execution,
+	jobID string) error {
+
+	if testExec.Status.JobID != jobID {
+		testExec.Status.JobID = jobID
+		testExec.Status.TransitionTimes = utils.NewTransitionTimes()
+		if err := c.WriterToLsUncachedClient().UpdateExecutionStatus(ctx, read_write_layer.W000183, testExec); err != nil {
+			return err
+		}
+	}
+
+	inst.Status.Tests = &lsv1alpha1.TestStatus{
+		JobID:     jobID,
+		StartTime: ptr.To(metav1.Now()),
+	}
+	return nil
+}
+
+// completeTests writes the results of the finished test deploy items into the test status of the installation.
+// The caller has to update the status of the installation.
+func (c *Controller) completeTests(ctx context.Context, inst *lsv1alpha1.Installation, testExec *lsv1alpha1.Execution) error {
+	deployItems, err := execution.ListManagedDeployItems(ctx, c.LsUncachedClient(), testExec, read_write_layer.R000135,
+		testExec.Status.DeployItemCache)
+	if err != nil {
+		return err
+	}
+
+	inst.Status.Tests = executions.NewTestStatus(inst.Status.Tests, testExec, deployItems)
+	return nil
+}
+
+// buildTestError returns the error of an installation whose tests have failed.
+func buildTestError(tests *lsv1alpha1.TestStatus) lserrors.LsError {
+	msg := "tests have failed"
+	if failed := executions.FailedTests(tests); len(failed) > 0 {
+		msg = "failed tests: " + strings.Join(failed, ", ")
+	}
+	return lserrors.NewError("buildTestError", lsv1alpha1.TestsFailedReason, msg)
+}
+
+func hasRunTestsOperation(inst *lsv1alpha1.Installation) bool {
+	return lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.RunTestsOperation)
+}
+
+// isRunningTestsOnDemand returns whether tests, which have been started by the operation run-tests, are running.
+func isRunningTestsOnDemand(inst *lsv1alpha1.Installation) bool {
+	return inst.Status.Tests != nil && inst.Status.Tests.CompletionTime == nil &&
+		inst.Status.Tests.JobID != inst.Status.JobID
+}
+
+// handleRunTestsOperation runs the tests of a succeeded installation again, without starting a new job of the
+// installation. The phase of the installation is not changed by the result of the tests.
+func (c *Controller) handleRunTestsOperation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000184, inst); err != nil {
+		return err
+	}
+
+	if isDifferentJobIDs(inst) || inst.Status.InstallationPhase != lsv1alpha1.InstallationPhases.Succeeded ||
+		inst.Status.TestExecutionReference == nil || isRunningTestsOnDemand(inst) {
+		logger.Info("operation run-tests is ignored, because the installation is not succeeded, has no tests, or its tests are running")
+		return nil
+	}
+
+	testExec, err := executions.GetTestExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return err
+	}
+	if testExec == nil {
+		logger.Info("operation run-tests is ignored, because the test execution does not exist")
+		return nil
+	}
+
+	if err := c.startTests(ctx, inst, testExec, uuid.New().String()); err != nil {
+		return err
+	}
+	return c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000185, inst)
+}
+
+// handleTestsOnDemand writes the results of the tests, which have been started by the operation run-tests, into the
+// status of the installation as soon as they are finished.
+func (c *Controller) handleTestsOnDemand(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	testExec, err := executions.GetTestExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return err
+	}
+
+	if testExec == nil {
+		inst.Status.Tests = nil
+	} else if testExec.Status.JobIDFinished == inst.Status.Tests.JobID {
+		if err := c.completeTests(ctx, inst, testExec); err != nil {
+			return err
+		}
+	} else {
+		// the installation is reconciled again when the test execution has finished
+		return nil
+	}
+
+	return c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000186, inst)
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/installations"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions"
+	"github.com/openmcp-project/landscaper/pkg/utils/deadline"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+// handleTimeout is called when the deadline of the current job of an installation has passed. It interrupts the
+// execution and the subinstallations, i.e. the deploy items of the whole subtree, and lets the installation fail with
+// error code ERR_TIMEOUT. The status of the installation names the child which has been incomplete the longest.
+func (c *Controller) handleTimeout(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
+	op := "handleTimeout"
+
+	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return lserrors.NewWrappedError(err, op, "GetExecutionForInstallation", err.Error())
+	}
+
+	testExec, err := executions.GetTestExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return lserrors.NewWrappedError(err, op, "GetTestExecutionForInstallation", err.Error())
+	}
+
+	subInsts, err := installations.ListSubinstallations(ctx, c.LsUncachedClient(), inst, inst.Status.SubInstCache, read_write_layer.R000133)
+	if err != nil {
+		return lserrors.NewWrappedError(err, op, "ListSubinstallations", err.Error())
+	}
+
+	details := &lsv1alpha1.TimeoutDetails{
+		Deadline:     metav1.NewTime(*deadline.Get(inst)),
+		SlowestChild: deadline.SlowestChild(inst, subInsts, exec, testExec),
+	}
+
+	if err := c.interruptSubtree(ctx, subInsts, exec, testExec); err != nil {
+		return lserrors.NewWrappedError(err, op, "InterruptSubtree", err.Error())
+	}
+
+	msg := fmt.Sprintf("installation has exceeded its timeout of %s", inst.Spec.Timeout.Duration.String())
+	if child := details.SlowestChild; child != nil {
+		msg = fmt.Sprintf("%s; slowest incomplete child: %s %s (phase %q)", msg, child.Object.Kind,
+			child.Object.NamespacedName().String(), child.Phase)
+	}
+
+	inst.Status.TimedOut = details
+	lsErr := lserrors.NewError(op, lsv1alpha1.ProgressingTimeoutReason, msg, lsv1alpha1.ErrorTimeout)
+	return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhases.Failed, lsErr,
+		read_write_layer.W000177, false)
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installationset
+
+import (
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/openmcp-project/landscaper/apis/config"
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+)
+
+// AddControllerToManager adds the installation set controller to the manager.
+// That controller generates one root installation per element of an installation set.
+// It watches the generated installations to continue the rollout when installations have finished,
+// and the targets of the namespace, as they may be elements of an installation set.
+func AddControllerToManager(lsUncachedClient, lsCachedClient client.Client,
+	logger logging.Logger, lsMgr manager.Manager, config config.CommonControllerConfig) error {
+	log := logger.Reconciles("installationSet", "InstallationSet")
+
+	c := NewController(lsUncachedClient, lsCachedClient, log, lsMgr.GetEventRecorder("Landscaper"))
+
+	return builder.ControllerManagedBy(lsMgr).
+		Named("installationset").
+		For(&lsv1alpha1.InstallationSet{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&lsv1alpha1.Installation{}).
+		Watches(&lsv1alpha1.Target{}, handler.EnqueueRequestsFromMapFunc(c.installationSetsForTarget), builder.OnlyMetadata).
+		WithOptions(utils.ConvertCommonControllerConfigToControllerOptions(config)).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(c)
+}
@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installationset
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/openmcp-project/landscaper/pkg/api"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	reasonInstallationCreated = "InstallationCreated"
+	reasonInstallationUpdated = "InstallationUpdated"
+	reasonInstallationDeleted = "InstallationDeleted"
+	actionCreateInstallation  = "CreateInstallation"
+	actionUpdateInstallation  = "UpdateInstallation"
+	actionDeleteInstallation  = "DeleteInstallation"
+
+	// deletionRequeueInterval is the interval after which a deleted installation set is checked again,
+	// while its installations are being deleted.
+	deletionRequeueInterval = 10 * time.Second
+)
+
+// NewController creates a new installation set controller.
+func NewController(lsUncachedClient, lsCachedClient client.Client,
+	logger logging.Logger,
+	eventRecorder events.EventRecorder) *Controller {
+	return &Controller{
+		lsUncachedClient: lsUncachedClient,
+		lsCachedClient:   lsCachedClient,
+		log:              logger,
+		eventRecorder:    eventRecorder,
+	}
+}
+
+// Controller creates, updates and deletes the installations of installation sets.
+type Controller struct {
+	lsUncachedClient client.Client
+	lsCachedClient   client.Client
+	log              logging.Logger
+	eventRecorder    events.EventRecorder
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := c.log.StartReconcile(req)
+	ctx = logging.NewContext(ctx, logger)
+
+	set := &lsv1alpha1.InstallationSet{}
+	if err := read_write_layer.GetInstallationSet(ctx, c.lsUncachedClient, req.NamespacedName, set, read_write_layer.R000121); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	installations, err := c.listInstallations(ctx, set)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !set.DeletionTimestamp.IsZero() {
+		return c.handleDelete(ctx, set, installations)
+	}
+
+	if !controllerutil.ContainsFinalizer(set, lsv1alpha1.LandscaperFinalizer) {
+		controllerutil.AddFinalizer(set, lsv1alpha1.LandscaperFinalizer)
+		if err := c.Writer().UpdateInstallationSet(ctx, read_write_layer.W000190, set); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if err := c.handleReconcile(ctx, set, installations); err != nil {
+		set.Status.LastError = lserrors.UpdatedError(set.Status.LastError, "Reconcile", "ReconcileInstallationSet", err.Error())
+		set.Status.ObservedGeneration = set.Generation
+		if updateErr := c.Writer().UpdateInstallationSetStatus(ctx, read_write_layer.W000191, set); updateErr != nil {
+			logger.Error(updateErr, "unable to update status of installation set")
+		}
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// handleReconcile creates, updates and deletes the installations of an installation set, such that at most
+// MaxProgressing installations are progressing at the same time.
+func (c *Controller) handleReconcile(ctx context.Context, set *lsv1alpha1.InstallationSet, installations []*lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	elements, err := generateElements(ctx, c.lsUncachedClient, set)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]*lsv1alpha1.Installation{}
+	progressing := 0
+	for _, inst := range installations {
+		existing[inst.Name] = inst
+		if isProgressing(inst) {
+			progressing++
+		}
+	}
+
+	// budget is the number of installations which may additionally start progressing
+	budget := 0
+	if set.Spec.MaxProgressing != nil {
+		budget = int(*set.Spec.MaxProgressing) - progressing
+	}
+	consumeBudget := func() bool {
+		if set.Spec.MaxProgressing == nil {
+			return true
+		}
+		if budget <= 0 {
+			return false
+		}
+		budget--
+		return true
+	}
+
+	pending := 0
+	desiredNames := map[string]bool{}
+	for _, elem := range elements {
+		desired, err := buildInstallation(set, elem)
+		if err != nil {
+			return err
+		}
+		if desiredNames[desired.Name] {
+			return fmt.Errorf("name template computes the installation name %q for more than one element", desired.Name)
+		}
+		desiredNames[desired.Name] = true
+
+		old, ok := existing[desired.Name]
+		if ok && isUpToDate(old, desired) {
+			continue
+		}
+		if !(ok && isProgressing(old)) && !consumeBudget() {
+			pending++
+			continue
+		}
+
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = desired.Name
+		inst.Namespace = desired.Namespace
+		result, err := c.Writer().CreateOrUpdateInstallation(ctx, read_write_layer.W000152, inst, func() error {
+			if inst.Labels == nil {
+				inst.Labels = map[string]string{}
+			}
+			for k, v := range desired.Labels {
+				inst.Labels[k] = v
+			}
+			if inst.Annotations == nil {
+				inst.Annotations = map[string]string{}
+			}
+			for k, v := range desired.Annotations {
+				inst.Annotations[k] = v
+			}
+			lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+			inst.Spec = desired.Spec
+			return controllerutil.SetControllerReference(set, inst, api.LandscaperScheme)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to create or update installation %s for element %q: %w", desired.Name, elem.name, err)
+		}
+
+		existing[inst.Name] = inst
+		switch result {
+		case controllerutil.OperationResultCreated:
+			logger.Info("created installation of installation set", lc.KeyResource, client.ObjectKeyFromObject(inst).String())
+			c.eventRecorder.Eventf(set, inst, corev1.EventTypeNormal, reasonInstallationCreated, actionCreateInstallation,
+				"created installation %s for element %s", inst.Name, elem.name)
+		case controllerutil.OperationResultUpdated:
+			logger.Info("updated installation of installation set", lc.KeyResource, client.ObjectKeyFromObject(inst).String())
+			c.eventRecorder.Eventf(set, inst, corev1.EventTypeNormal, reasonInstallationUpdated, actionUpdateInstallation,
+				"updated installation %s for element %s", inst.Name, elem.name)
+		}
+	}
+
+	deleted := map[string]bool{}
+	for name, inst := range existing {
+		if desiredNames[name] || !inst.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if !consumeBudget() {
+			pending++
+			continue
+		}
+
+		if err := c.Writer().DeleteInstallation(ctx, read_write_layer.W000153, inst); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete installation %s: %w", name, err)
+		}
+		deleted[name] = true
+		logger.Info("deleted installation of installation set", lc.KeyResource, client.ObjectKeyFromObject(inst).String())
+		c.eventRecorder.Eventf(set, inst, corev1.EventTypeNormal, reasonInstallationDeleted, actionDeleteInstallation,
+			"deleted installation %s which belongs to no element", inst.Name)
+	}
+
+	set.Status.ObservedGeneration = set.Generation
+	set.Status.LastError = nil
+	set.Status.Pending = int32(pending)
+	setInstallationStatus(set, existing, deleted)
+
+	return c.Writer().UpdateInstallationSetStatus(ctx, read_write_layer.W000192, set)
+}
+
+// handleDelete deletes all installations of an installation set and removes the finalizer when they are gone.
+func (c *Controller) handleDelete(ctx context.Context, set *lsv1alpha1.InstallationSet, installations []*lsv1alpha1.Installation) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	if len(installations) == 0 {
+		controllerutil.RemoveFinalizer(set, lsv1alpha1.LandscaperFinalizer)
+		if err := c.Writer().UpdateInstallationSet(ctx, read_write_layer.W000193, set); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	for _, inst := range installations {
+		if !inst.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := c.Writer().DeleteInstallation(ctx, read_write_layer.W000194, inst); err != nil && !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("unable to delete installation %s: %w", inst.Name, err)
+		}
+		logger.Info("deleted installation of deleted installation set", lc.KeyResource, client.ObjectKeyFromObject(inst).String())
+	}
+
+	return reconcile.Result{RequeueAfter: deletionRequeueInterval}, nil
+}
+
+// listInstallations returns the installations which are owned by an installation set.
+func (c *Controller) listInstallations(ctx context.Context, set *lsv1alpha1.InstallationSet) ([]*lsv1alpha1.Installation, error) {
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c.lsUncachedClient, instList, read_write_layer.R000122,
+		client.InNamespace(set.Namespace), client.MatchingLabels{lsv1alpha1.InstallationSetLabel: set.Name}); err != nil {
+		return nil, fmt.Errorf("unable to list installations of installation set: %w", err)
+	}
+
+	result := []*lsv1alpha1.Installation{}
+	for i := range instList.Items {
+		inst := &instList.Items[i]
+		if metav1.IsControlledBy(inst, set) {
+			result = append(result, inst)
+		}
+	}
+	return result, nil
+}
+
+// installationSetsForTarget returns reconcile requests for all installation sets in the namespace of a target,
+// which generate their elements from targets.
+func (c *Controller) installationSetsForTarget(ctx context.Context, target client.Object) []reconcile.Request {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	setList := &lsv1alpha1.InstallationSetList{}
+	if err := read_write_layer.ListInstallationSets(ctx, c.lsCachedClient, setList, read_write_layer.R000124,
+		client.InNamespace(target.GetNamespace())); err != nil {
+		logger.Error(err, "unable to list installation sets", lc.KeyResource, client.ObjectKeyFromObject(target).String())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, set := range setList.Items {
+		if set.Spec.Generator.Targets != nil {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&set)})
+		}
+	}
+	return requests
+}
+
+func (c *Controller) Writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(c.lsUncachedClient)
+}
+
+// isProgressing returns true if an installation has a job which has not yet finished,
+// or a job is about to be started or the installation is being deleted.
+func isProgressing(inst *lsv1alpha1.Installation) bool {
+	return inst.Status.JobID != inst.Status.JobIDFinished ||
+		lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) ||
+		!inst.DeletionTimestamp.IsZero()
+}
+
+// isUpToDate returns true if an existing installation has the labels, annotations and spec of the desired installation.
+func isUpToDate(existing, desired *lsv1alpha1.Installation) bool {
+	for k, v := range desired.Labels {
+		if existing.Labels[k] != v {
+			return false
+		}
+	}
+	for k, v := range desired.Annotations {
+		if existing.Annotations[k] != v {
+			return false
+		}
+	}
+
+	// the installation controller defaults the spec of installations, so both specs are compared with defaults
+	defaultedExisting := existing.DeepCopy()
+	api.LandscaperScheme.Default(defaultedExisting)
+	defaultedDesired := desired.DeepCopy()
+	api.LandscaperScheme.Default(defaultedDesired)
+	return reflect.DeepEqual(defaultedExisting.Spec, defaultedDesired.Spec)
+}
+
+func setInstallationStatus(set *lsv1alpha1.InstallationSet, installations map[string]*lsv1alpha1.Installation, deleted map[string]bool) {
+	statuses := []lsv1alpha1.InstallationSetInstallationStatus{}
+	progressing := 0
+	for _, inst := range installations {
+		statuses = append(statuses, lsv1alpha1.InstallationSetInstallationStatus{
+			Element: inst.Annotations[lsv1alpha1.InstallationSetElementAnnotation],
+			Name:    inst.Name,
+			Phase:   inst.Status.InstallationPhase,
+		})
+		if isProgressing(inst) || deleted[inst.Name] {
+			progressing++
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+
+	set.Status.Installations = statuses
+	set.Status.InstallationCount = int32(len(statuses))
+	set.Status.Progressing = int32(progressing)
+}
@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installationset_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/api"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/controllers/installationset"
+)
+
+var _ = Describe("InstallationSet Controller", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		ctrl       *installationset.Controller
+		set        *lsv1alpha1.InstallationSet
+	)
+
+	newTarget := func(name string, labels map[string]string) *lsv1alpha1.Target {
+		return &lsv1alpha1.Target{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Spec:       lsv1alpha1.TargetSpec{Type: "landscaper.gardener.cloud/kubernetes-cluster"},
+		}
+	}
+
+	setup := func(objects ...client.Object) {
+		kubeClient = fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.InstallationSet{}, &lsv1alpha1.Installation{}).
+			WithObjects(append(objects, set)...).
+			Build()
+		ctrl = installationset.NewController(kubeClient, kubeClient, logging.Discard(), events.NewFakeRecorder(10))
+	}
+
+	reconcileSet := func() reconcile.Result {
+		res, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(set)})
+		Expect(err).ToNot(HaveOccurred())
+		return res
+	}
+
+	listInstallations := func() []lsv1alpha1.Installation {
+		instList := &lsv1alpha1.InstallationList{}
+		Expect(kubeClient.List(ctx, instList, client.InNamespace("default"))).To(Succeed())
+		return instList.Items
+	}
+
+	getInstallation := func(name string) *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, inst)).To(Succeed())
+		return inst
+	}
+
+	getSet := func() *lsv1alpha1.InstallationSet {
+		result := &lsv1alpha1.InstallationSet{}
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(set), result)).To(Succeed())
+		return result
+	}
+
+	// finishInstallation simulates the installation controller finishing the job of an installation.
+	finishInstallation := func(name string) {
+		inst := getInstallation(name)
+		delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+		Expect(kubeClient.Update(ctx, inst)).To(Succeed())
+		inst.Status.JobID = "job-1"
+		inst.Status.JobIDFinished = "job-1"
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		Expect(kubeClient.Status().Update(ctx, inst)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		set = &lsv1alpha1.InstallationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-set", Namespace: "default"},
+			Spec: lsv1alpha1.InstallationSetSpec{
+				Template: lsv1alpha1.InstallationSetTemplate{
+					Labels: map[string]string{"team": "a"},
+					Spec: lsv1alpha1.InstallationSpec{
+						Blueprint: lsv1alpha1.BlueprintDefinition{
+							Reference: &lsv1alpha1.RemoteBlueprintReference{ResourceName: "blueprint"},
+						},
+						Imports: lsv1alpha1.InstallationImports{
+							Data: []lsv1alpha1.DataImport{{Name: "config", DataRef: "default-config"}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should create an installation for every parameter set", func() {
+		set.Spec.Generator.Parameters = []lsv1alpha1.InstallationSetParameterSet{
+			{Name: "a", ImportDataMappings: map[string]lsv1alpha1.AnyJSON{"replicas": lsv1alpha1.NewAnyJSON([]byte("1"))}},
+			{Name: "b", ImportDataMappings: map[string]lsv1alpha1.AnyJSON{"replicas": lsv1alpha1.NewAnyJSON([]byte("2"))}},
+		}
+		set.Spec.NameTemplate = "app-{{ .elementName }}"
+		setup()
+
+		reconcileSet()
+
+		Expect(listInstallations()).To(HaveLen(2))
+		inst := getInstallation("app-b")
+		Expect(inst.Labels).To(HaveKeyWithValue(lsv1alpha1.InstallationSetLabel, "my-set"))
+		Expect(inst.Labels).To(HaveKeyWithValue("team", "a"))
+		Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.InstallationSetElementAnnotation, "b"))
+		Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeTrue())
+		Expect(metav1.IsControlledBy(inst, getSet())).To(BeTrue())
+		Expect(inst.Spec.ImportDataMappings).To(HaveKeyWithValue("replicas", lsv1alpha1.NewAnyJSON([]byte("2"))))
+
+		status := getSet().Status
+		Expect(status.InstallationCount).To(Equal(int32(2)))
+		Expect(status.Progressing).To(Equal(int32(2)))
+		Expect(status.Pending).To(BeZero())
+		Expect(status.Installations).To(ConsistOf(
+			lsv1alpha1.InstallationSetInstallationStatus{Element: "a", Name: "app-a"},
+			lsv1alpha1.InstallationSetInstallationStatus{Element: "b", Name: "app-b"},
+		))
+	})
+
+	It("should create an installation for every selected target and apply overrides", func() {
+		set.Spec.Generator.Targets = &lsv1alpha1.InstallationSetTargetsGenerator{
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+		}
+		set.Spec.Generator.TargetImport = "cluster"
+		set.Spec.Overrides = []lsv1alpha1.InstallationSetElementOverride{{
+			Element: "cluster-1",
+			Imports: lsv1alpha1.InstallationImports{
+				Data: []lsv1alpha1.DataImport{{Name: "config", DataRef: "special-config"}},
+			},
+		}}
+		setup(newTarget("cluster-1", map[string]string{"env": "dev"}),
+			newTarget("cluster-2", map[string]string{"env": "dev"}),
+			newTarget("cluster-3", map[string]string{"env": "prod"}))
+
+		reconcileSet()
+
+		Expect(listInstallations()).To(HaveLen(2))
+
+		inst1 := getInstallation("my-set-cluster-1")
+		Expect(inst1.Spec.Imports.Targets).To(ConsistOf(lsv1alpha1.TargetImport{Name: "cluster", Target: "cluster-1"}))
+		Expect(inst1.Spec.Imports.Data).To(ConsistOf(lsv1alpha1.DataImport{Name: "config", DataRef: "special-config"}))
+
+		inst2 := getInstallation("my-set-cluster-2")
+		Expect(inst2.Spec.Imports.Targets).To(ConsistOf(lsv1alpha1.TargetImport{Name: "cluster", Target: "cluster-2"}))
+		Expect(inst2.Spec.Imports.Data).To(ConsistOf(lsv1alpha1.DataImport{Name: "config", DataRef: "default-config"}))
+	})
+
+	It("should limit the number of progressing installations", func() {
+		set.Spec.Generator.TargetMap = map[string]string{"a": "target-a", "b": "target-b", "c": "target-c"}
+		set.Spec.Generator.TargetImport = "cluster"
+		set.Spec.MaxProgressing = ptr.To[int32](1)
+		setup()
+
+		reconcileSet()
+		Expect(listInstallations()).To(HaveLen(1))
+		getInstallation("my-set-a")
+		Expect(getSet().Status.Pending).To(Equal(int32(2)))
+
+		// no further installation is created while the first one is progressing
+		reconcileSet()
+		Expect(listInstallations()).To(HaveLen(1))
+
+		finishInstallation("my-set-a")
+		reconcileSet()
+		Expect(listInstallations()).To(HaveLen(2))
+		getInstallation("my-set-b")
+		Expect(lsv1alpha1helper.HasOperation(getInstallation("my-set-a").ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeFalse())
+
+		status := getSet().Status
+		Expect(status.Progressing).To(Equal(int32(1)))
+		Expect(status.Pending).To(Equal(int32(1)))
+	})
+
+	It("should delete the installations of removed elements", func() {
+		set.Spec.Generator.Parameters = []lsv1alpha1.InstallationSetParameterSet{{Name: "a"}, {Name: "b"}}
+		setup()
+
+		reconcileSet()
+		Expect(listInstallations()).To(HaveLen(2))
+
+		current := getSet()
+		current.Spec.Generator.Parameters = []lsv1alpha1.InstallationSetParameterSet{{Name: "a"}}
+		Expect(kubeClient.Update(ctx, current)).To(Succeed())
+
+		reconcileSet()
+		installations := listInstallations()
+		Expect(installations).To(HaveLen(1))
+		Expect(installations[0].Name).To(Equal("my-set-a"))
+	})
+
+	It("should delete all installations before the installation set is removed", func() {
+		set.Spec.Generator.Parameters = []lsv1alpha1.InstallationSetParameterSet{{Name: "a"}}
+		setup()
+
+		reconcileSet()
+		Expect(listInstallations()).To(HaveLen(1))
+
+		Expect(kubeClient.Delete(ctx, getSet())).To(Succeed())
+
+		res := reconcileSet()
+		Expect(res.RequeueAfter).ToNot(BeZero())
+		Expect(listInstallations()).To(BeEmpty())
+
+		reconcileSet()
+		err := kubeClient.Get(ctx, client.ObjectKeyFromObject(set), &lsv1alpha1.InstallationSet{})
+		Expect(err).To(HaveOccurred())
+	})
+})
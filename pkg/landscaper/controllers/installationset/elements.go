@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installationset
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+// element is an element of an installation set for which an installation is generated.
+type element struct {
+	name               string
+	targetName         string
+	importDataMappings map[string]lsv1alpha1.AnyJSON
+}
+
+// generateElements computes the elements of an installation set from its generator.
+func generateElements(ctx context.Context, c client.Reader, set *lsv1alpha1.InstallationSet) ([]element, error) {
+	generator := &set.Spec.Generator
+	elements := []element{}
+
+	switch {
+	case generator.Targets != nil:
+		selector, err := metav1.LabelSelectorAsSelector(&generator.Targets.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target selector: %w", err)
+		}
+
+		targets := &lsv1alpha1.TargetList{}
+		if err := read_write_layer.ListTargets(ctx, c, targets, read_write_layer.R000123,
+			client.InNamespace(set.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("unable to list targets: %w", err)
+		}
+
+		for _, target := range targets.Items {
+			if !target.DeletionTimestamp.IsZero() {
+				continue
+			}
+			elements = append(elements, element{name: target.Name, targetName: target.Name})
+		}
+		sort.Slice(elements, func(i, j int) bool { return elements[i].name < elements[j].name })
+
+	case generator.TargetMap != nil:
+		for key, targetName := range generator.TargetMap {
+			elements = append(elements, element{name: key, targetName: targetName})
+		}
+		sort.Slice(elements, func(i, j int) bool { return elements[i].name < elements[j].name })
+
+	default:
+		for _, params := range generator.Parameters {
+			elements = append(elements, element{name: params.Name, importDataMappings: params.ImportDataMappings})
+		}
+	}
+
+	return elements, nil
+}
+
+// installationName computes the name of the installation of an element from the name template of the installation set.
+func installationName(set *lsv1alpha1.InstallationSet, elem element) (string, error) {
+	nameTemplate := set.Spec.NameTemplate
+	if len(nameTemplate) == 0 {
+		nameTemplate = lsv1alpha1.DefaultInstallationSetNameTemplate
+	}
+
+	tmpl, err := template.New("name").Option("missingkey=error").Parse(nameTemplate)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse name template: %w", err)
+	}
+
+	values := map[string]interface{}{
+		"setName":     set.Name,
+		"elementName": elem.name,
+		"targetName":  elem.targetName,
+	}
+
+	buf := bytes.Buffer{}
+	if err := tmpl.Execute(&buf, values); err != nil {
+		return "", fmt.Errorf("unable to execute name template for element %q: %w", elem.name, err)
+	}
+
+	name := strings.TrimSpace(buf.String())
+	if len(name) == 0 {
+		return "", fmt.Errorf("name template computes an empty name for element %q", elem.name)
+	}
+	return name, nil
+}
+
+// buildInstallation computes the installation of an element.
+func buildInstallation(set *lsv1alpha1.InstallationSet, elem element) (*lsv1alpha1.Installation, error) {
+	name, err := installationName(set, elem)
+	if err != nil {
+		return nil, err
+	}
+
+	inst := &lsv1alpha1.Installation{}
+	inst.Name = name
+	inst.Namespace = set.Namespace
+	inst.Labels = map[string]string{}
+	for k, v := range set.Spec.Template.Labels {
+		inst.Labels[k] = v
+	}
+	inst.Labels[lsv1alpha1.InstallationSetLabel] = set.Name
+	inst.Annotations = map[string]string{}
+	for k, v := range set.Spec.Template.Annotations {
+		inst.Annotations[k] = v
+	}
+	inst.Annotations[lsv1alpha1.InstallationSetElementAnnotation] = elem.name
+
+	set.Spec.Template.Spec.DeepCopyInto(&inst.Spec)
+
+	if len(elem.targetName) != 0 {
+		setTargetImport(&inst.Spec.Imports, lsv1alpha1.TargetImport{
+			Name:   set.Spec.Generator.TargetImport,
+			Target: elem.targetName,
+		})
+	}
+	setImportDataMappings(&inst.Spec, elem.importDataMappings)
+
+	for _, override := range set.Spec.Overrides {
+		if override.Element != elem.name {
+			continue
+		}
+		for _, imp := range override.Imports.Data {
+			setDataImport(&inst.Spec.Imports, *imp.DeepCopy())
+		}
+		for _, imp := range override.Imports.Targets {
+			setTargetImport(&inst.Spec.Imports, *imp.DeepCopy())
+		}
+		setImportDataMappings(&inst.Spec, override.ImportDataMappings)
+	}
+
+	return inst, nil
+}
+
+func setDataImport(imports *lsv1alpha1.InstallationImports, imp lsv1alpha1.DataImport) {
+	for i := range imports.Data {
+		if imports.Data[i].Name == imp.Name {
+			imports.Data[i] = imp
+			return
+		}
+	}
+	imports.Data = append(imports.Data, imp)
+}
+
+func setTargetImport(imports *lsv1alpha1.InstallationImports, imp lsv1alpha1.TargetImport) {
+	for i := range imports.Targets {
+		if imports.Targets[i].Name == imp.Name {
+			imports.Targets[i] = imp
+			return
+		}
+	}
+	imports.Targets = append(imports.Targets, imp)
+}
+
+func setImportDataMappings(spec *lsv1alpha1.InstallationSpec, mappings map[string]lsv1alpha1.AnyJSON) {
+	if len(mappings) == 0 {
+		return
+	}
+	if spec.ImportDataMappings == nil {
+		spec.ImportDataMappings = map[string]lsv1alpha1.AnyJSON{}
+	}
+	for k, v := range mappings {
+		spec.ImportDataMappings[k] = *v.DeepCopy()
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/openmcp-project/landscaper/apis/config"
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+)
+
+// AddControllerToManager adds the target controller to the manager.
+// That controller periodically probes targets of type kubernetes-cluster and maintains their status.
+func AddControllerToManager(lsUncachedClient, lsCachedClient client.Client,
+	logger logging.Logger, lsMgr manager.Manager, config config.TargetsController) error {
+	log := logger.Reconciles("target", "Target")
+	if config.Config.Disable {
+		log.Info("Target controller is disabled")
+		return nil
+	}
+
+	c := NewController(
+		lsUncachedClient, lsCachedClient,
+		lsMgr.GetConfig(),
+		log,
+		lsMgr.GetEventRecorder("Landscaper"),
+		config.Config,
+	)
+
+	return builder.ControllerManagedBy(lsMgr).
+		For(&lsv1alpha1.Target{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(utils.ConvertCommonControllerConfigToControllerOptions(config.CommonControllerConfig)).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(c)
+}
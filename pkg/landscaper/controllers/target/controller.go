@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/openmcp-project/landscaper/apis/config"
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/deployer/lib"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// reasons of the Reachable condition
+	reasonTargetResolutionFailed = "TargetResolutionFailed"
+	reasonClientCreationFailed   = "ClientCreationFailed"
+	reasonUnreachable            = "Unreachable"
+	reasonReachable              = "Reachable"
+
+	// reasons of the CredentialsValid condition
+	reasonCredentialsUnknown      = "CredentialsUnknown"
+	reasonCredentialsUnauthorized = "Unauthorized"
+	reasonCredentialsExpired      = "CredentialsExpired"
+	reasonCredentialsExpiring     = "CredentialsExpiringSoon"
+	reasonCredentialsValid        = "CredentialsValid"
+
+	actionProbeTarget = "ProbeTarget"
+)
+
+// NewController creates a new target controller that probes targets of type kubernetes-cluster.
+func NewController(lsUncachedClient, lsCachedClient client.Client,
+	lsRestConfig *rest.Config,
+	logger logging.Logger,
+	eventRecorder events.EventRecorder,
+	config config.TargetControllerConfig) *Controller {
+	return &Controller{
+		lsUncachedClient: lsUncachedClient,
+		lsCachedClient:   lsCachedClient,
+		lsRestConfig:     lsRestConfig,
+		log:              logger,
+		eventRecorder:    eventRecorder,
+		config:           config,
+	}
+}
+
+// Controller probes targets of type kubernetes-cluster and records the results in the status of the targets.
+type Controller struct {
+	lsUncachedClient client.Client
+	lsCachedClient   client.Client
+	lsRestConfig     *rest.Config
+	log              logging.Logger
+	eventRecorder    events.EventRecorder
+	config           config.TargetControllerConfig
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := c.log.StartReconcile(req)
+	ctx = logging.NewContext(ctx, logger)
+
+	target := &lsv1alpha1.Target{}
+	if err := read_write_layer.GetTarget(ctx, c.lsUncachedClient, req.NamespacedName, target, read_write_layer.R000111); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if target.Spec.Type != targettypes.KubernetesClusterTargetType || !target.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	now := time.Now()
+	if nextProbe := c.nextProbeTime(target); nextProbe.After(now) {
+		return reconcile.Result{RequeueAfter: nextProbe.Sub(now)}, nil
+	}
+
+	c.probe(ctx, target)
+
+	if err := c.Writer().UpdateTargetStatus(ctx, read_write_layer.W000150, target); err != nil {
+		if apierrors.IsConflict(err) {
+			return reconcile.Result{Requeue: true}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: c.config.ProbeInterval.Duration}, nil
+}
+
+// nextProbeTime returns the time when the given target has to be probed next.
+// Targets that have not been probed yet or that have changed since the last probe are probed immediately.
+func (c *Controller) nextProbeTime(target *lsv1alpha1.Target) time.Time {
+	if target.Status.LastProbeTime == nil || target.Status.ObservedGeneration != target.Generation {
+		return time.Time{}
+	}
+	return target.Status.LastProbeTime.Add(c.config.ProbeInterval.Duration)
+}
+
+// probe checks the reachability and the credentials of the given target and updates its status accordingly.
+func (c *Controller) probe(ctx context.Context, target *lsv1alpha1.Target) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	status := &target.Status
+	now := metav1.Now()
+	status.ObservedGeneration = target.Generation
+	status.LastProbeTime = &now
+
+	reachableCond := lsv1alpha1helper.GetOrInitCondition(status.Conditions, lsv1alpha1.TargetReachableCondition)
+	credentialsCond := lsv1alpha1helper.GetOrInitCondition(status.Conditions, lsv1alpha1.TargetCredentialsValidCondition)
+	oldReachableCond, oldCredentialsCond := reachableCond, credentialsCond
+	defer func() {
+		c.recordConditionChange(target, oldReachableCond, reachableCond)
+		c.recordConditionChange(target, oldCredentialsCond, credentialsCond)
+		status.Conditions = lsv1alpha1helper.MergeConditions(status.Conditions, reachableCond, credentialsCond)
+	}()
+
+	resolvedTarget, err := targetresolver.Resolve(ctx, target, c.lsUncachedClient)
+	if err != nil {
+		logger.Info("unable to resolve target", "error", err.Error())
+		reachableCond = lsv1alpha1helper.UpdatedCondition(reachableCond, lsv1alpha1.ConditionFalse,
+			reasonTargetResolutionFailed, fmt.Sprintf("unable to resolve target: %s", err.Error()))
+		credentialsCond = lsv1alpha1helper.UpdatedCondition(credentialsCond, lsv1alpha1.ConditionUnknown,
+			reasonCredentialsUnknown, "the credentials could not be checked because the target could not be resolved")
+		return
+	}
+
+	expiration, err := GetCredentialsExpirationTime(resolvedTarget)
+	if err != nil {
+		logger.Info("unable to determine the expiration time of the target credentials", "error", err.Error())
+	}
+	if expiration != nil {
+		status.CredentialsExpirationTime = &metav1.Time{Time: *expiration}
+	} else {
+		status.CredentialsExpirationTime = nil
+	}
+
+	unauthorized := false
+	targetAccess, err := lib.NewTargetAccess(ctx, resolvedTarget, c.lsUncachedClient, c.lsRestConfig)
+	if err != nil {
+		reachableCond = lsv1alpha1helper.UpdatedCondition(reachableCond, lsv1alpha1.ConditionFalse,
+			reasonClientCreationFailed, fmt.Sprintf("unable to create client for target: %s", err.Error()))
+	} else if version, err := c.getServerVersion(targetAccess.TargetRestConfig()); err != nil {
+		unauthorized = apierrors.IsUnauthorized(err) || apierrors.IsForbidden(err)
+		reachableCond = lsv1alpha1helper.UpdatedCondition(reachableCond, lsv1alpha1.ConditionFalse,
+			reasonUnreachable, fmt.Sprintf("unable to reach api server of target: %s", err.Error()))
+	} else {
+		status.ServerVersion = version
+		reachableCond = lsv1alpha1helper.UpdatedCondition(reachableCond, lsv1alpha1.ConditionTrue,
+			reasonReachable, fmt.Sprintf("api server with version %s is reachable", version))
+	}
+
+	credentialsCond = c.checkCredentials(credentialsCond, expiration, unauthorized)
+}
+
+// checkCredentials updates the given credentials condition.
+func (c *Controller) checkCredentials(cond lsv1alpha1.Condition, expiration *time.Time, unauthorized bool) lsv1alpha1.Condition {
+
+	switch {
+	case expiration != nil && expiration.Before(time.Now()):
+		msg := fmt.Sprintf("the credentials of the target expired at %s", expiration.UTC().Format(time.RFC3339))
+		return lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse, reasonCredentialsExpired, msg)
+	case unauthorized:
+		return lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse, reasonCredentialsUnauthorized,
+			"the credentials of the target are rejected by the api server")
+	case expiration != nil && time.Until(*expiration) < c.config.CredentialsExpirationWarning.Duration:
+		msg := fmt.Sprintf("the credentials of the target expire at %s", expiration.UTC().Format(time.RFC3339))
+		return lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue, reasonCredentialsExpiring, msg)
+	case expiration != nil:
+		return lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue, reasonCredentialsValid,
+			fmt.Sprintf("the credentials of the target are valid until %s", expiration.UTC().Format(time.RFC3339)))
+	default:
+		return lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue, reasonCredentialsValid,
+			"the credentials of the target have no known expiration time")
+	}
+}
+
+// recordConditionChange emits an event if the reason of a condition has changed since the last probe, so that a
+// target which stays unreachable or whose credentials stay invalid does not produce an event on every probe.
+// A warning is emitted if the target gets into a bad state, and a normal event if it recovers from a bad state.
+func (c *Controller) recordConditionChange(target *lsv1alpha1.Target, oldCond, newCond lsv1alpha1.Condition) {
+	if oldCond.Reason == newCond.Reason {
+		return
+	}
+
+	eventType := corev1.EventTypeNormal
+	if isWarningReason(newCond.Reason) {
+		eventType = corev1.EventTypeWarning
+	} else if !isWarningReason(oldCond.Reason) {
+		return
+	}
+	c.eventRecorder.Eventf(target, nil, eventType, newCond.Reason, actionProbeTarget, "%s", newCond.Message)
+}
+
+// isWarningReason returns whether the given condition reason describes a bad state of a target.
+func isWarningReason(reason string) bool {
+	switch reason {
+	case reasonTargetResolutionFailed, reasonClientCreationFailed, reasonUnreachable,
+		reasonCredentialsUnauthorized, reasonCredentialsExpired, reasonCredentialsExpiring:
+		return true
+	default:
+		return false
+	}
+}
+
+// getServerVersion returns the version of the api server described by the given rest config.
+func (c *Controller) getServerVersion(restConfig *rest.Config) (string, error) {
+	restConfig = rest.CopyConfig(restConfig)
+	restConfig.Timeout = c.config.ProbeTimeout.Duration
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return "", err
+	}
+	version, err := discoveryClient.ServerVersion()
+	if err != nil {
+		return "", err
+	}
+	return version.GitVersion, nil
+}
+
+func (c *Controller) Writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(c.lsUncachedClient)
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
+
+	"github.com/openmcp-project/landscaper/apis/config"
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+)
+
+func createCertificate(notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func createToken(exp time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, exp.Unix())))
+	return header + "." + payload + ".signature"
+}
+
+var _ = Describe("Target Controller", func() {
+
+	Context("CredentialsExpirationTime", func() {
+
+		It("should read the expiration time of a client certificate", func() {
+			notAfter := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+			exp, err := getRestConfigExpirationTime(&rest.Config{
+				TLSClientConfig: rest.TLSClientConfig{CertData: createCertificate(notAfter)},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exp).ToNot(BeNil())
+			Expect(exp.Equal(notAfter)).To(BeTrue())
+		})
+
+		It("should read the expiration time of a json web token", func() {
+			tokenExp := time.Now().Add(time.Hour).Truncate(time.Second)
+			exp, err := getRestConfigExpirationTime(&rest.Config{BearerToken: createToken(tokenExp)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exp).ToNot(BeNil())
+			Expect(exp.Equal(tokenExp)).To(BeTrue())
+		})
+
+		It("should return the earliest expiration time of certificate and token", func() {
+			tokenExp := time.Now().Add(time.Hour).Truncate(time.Second)
+			exp, err := getRestConfigExpirationTime(&rest.Config{
+				BearerToken:     createToken(tokenExp),
+				TLSClientConfig: rest.TLSClientConfig{CertData: createCertificate(time.Now().Add(48 * time.Hour))},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exp.Equal(tokenExp)).To(BeTrue())
+		})
+
+		It("should return no expiration time for opaque tokens", func() {
+			exp, err := getRestConfigExpirationTime(&rest.Config{BearerToken: "opaque-token"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exp).To(BeNil())
+		})
+
+		It("should return no expiration time for targets without kubeconfig", func() {
+			exp, err := GetCredentialsExpirationTime(&lsv1alpha1.ResolvedTarget{
+				Content: `{"selfConfig":{"serviceAccount":{"name":"test"}}}`,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exp).To(BeNil())
+		})
+	})
+
+	Context("CheckCredentials", func() {
+
+		var (
+			c        *Controller
+			recorder *events.FakeRecorder
+			target   *lsv1alpha1.Target
+		)
+
+		BeforeEach(func() {
+			recorder = events.NewFakeRecorder(10)
+			c = NewController(nil, nil, nil, logging.Discard(), recorder, config.TargetControllerConfig{
+				ProbeInterval:                &metav1.Duration{Duration: 10 * time.Minute},
+				ProbeTimeout:                 &metav1.Duration{Duration: 10 * time.Second},
+				CredentialsExpirationWarning: &metav1.Duration{Duration: 24 * time.Hour},
+			})
+			target = &lsv1alpha1.Target{}
+		})
+
+		It("should mark credentials as valid if they expire after the warning threshold", func() {
+			exp := time.Now().Add(48 * time.Hour)
+			cond := c.checkCredentials(lsv1alpha1helper.InitCondition(lsv1alpha1.TargetCredentialsValidCondition), &exp, false)
+			Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(reasonCredentialsValid))
+		})
+
+		It("should warn if the credentials expire within the warning threshold", func() {
+			exp := time.Now().Add(time.Hour)
+			cond := c.checkCredentials(lsv1alpha1helper.InitCondition(lsv1alpha1.TargetCredentialsValidCondition), &exp, false)
+			Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(reasonCredentialsExpiring))
+		})
+
+		It("should mark expired credentials as invalid", func() {
+			exp := time.Now().Add(-time.Hour)
+			cond := c.checkCredentials(lsv1alpha1helper.InitCondition(lsv1alpha1.TargetCredentialsValidCondition), &exp, false)
+			Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(reasonCredentialsExpired))
+		})
+
+		It("should mark credentials rejected by the api server as invalid", func() {
+			cond := c.checkCredentials(lsv1alpha1helper.InitCondition(lsv1alpha1.TargetCredentialsValidCondition), nil, true)
+			Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(reasonCredentialsUnauthorized))
+		})
+
+		It("should probe targets immediately if they have changed since the last probe", func() {
+			lastProbe := metav1.Now()
+			target.Generation = 2
+			target.Status = lsv1alpha1.TargetStatus{ObservedGeneration: 2, LastProbeTime: &lastProbe}
+			Expect(c.nextProbeTime(target)).To(Equal(lastProbe.Add(10 * time.Minute)))
+
+			target.Generation = 3
+			Expect(c.nextProbeTime(target).IsZero()).To(BeTrue())
+		})
+
+		It("should only emit events if the state of the target changes", func() {
+			initial := lsv1alpha1helper.InitCondition(lsv1alpha1.TargetReachableCondition)
+			unreachable := lsv1alpha1helper.UpdatedCondition(initial, lsv1alpha1.ConditionFalse, reasonUnreachable, "unreachable")
+			reachable := lsv1alpha1helper.UpdatedCondition(initial, lsv1alpha1.ConditionTrue, reasonReachable, "reachable")
+
+			// no event for the first successful probe
+			c.recordConditionChange(target, initial, reachable)
+			Expect(recorder.Events).To(BeEmpty())
+
+			c.recordConditionChange(target, reachable, unreachable)
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(HavePrefix("Warning " + reasonUnreachable))
+
+			// no event if the target stays unreachable
+			c.recordConditionChange(target, unreachable, unreachable)
+			Expect(recorder.Events).To(BeEmpty())
+
+			c.recordConditionChange(target, unreachable, reachable)
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(HavePrefix("Normal " + reasonReachable))
+		})
+	})
+})
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+// GetCredentialsExpirationTime returns the time when the credentials of a kubernetes-cluster target expire.
+// Only targets with a kubeconfig are considered, as the tokens of oidc and self targets are requested for every access.
+// Nil is returned if the credentials do not expire or their expiration time cannot be determined.
+func GetCredentialsExpirationTime(resolvedTarget *lsv1alpha1.ResolvedTarget) (*time.Time, error) {
+	targetConfig := &targettypes.KubernetesClusterTargetConfig{}
+	if err := yaml.Unmarshal([]byte(resolvedTarget.Content), targetConfig); err != nil {
+		return nil, fmt.Errorf("unable to parse target configuration: %w", err)
+	}
+	if targetConfig.Kubeconfig.StrVal == nil {
+		return nil, nil
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(*targetConfig.Kubeconfig.StrVal))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create rest config from kubeconfig: %w", err)
+	}
+	return getRestConfigExpirationTime(restConfig)
+}
+
+// getRestConfigExpirationTime returns the earliest expiration time of the client certificate and the bearer token
+// of the given rest config.
+func getRestConfigExpirationTime(restConfig *rest.Config) (*time.Time, error) {
+	var expiration *time.Time
+
+	if len(restConfig.CertData) != 0 {
+		notAfter, err := getCertificateExpirationTime(restConfig.CertData)
+		if err != nil {
+			return nil, err
+		}
+		expiration = notAfter
+	}
+
+	if len(restConfig.BearerToken) != 0 {
+		exp := getTokenExpirationTime(restConfig.BearerToken)
+		if exp != nil && (expiration == nil || exp.Before(*expiration)) {
+			expiration = exp
+		}
+	}
+
+	return expiration, nil
+}
+
+// getCertificateExpirationTime returns the end of the validity period of the first certificate in the given pem data.
+func getCertificateExpirationTime(certData []byte) (*time.Time, error) {
+	for rest := certData; len(rest) != 0; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse client certificate: %w", err)
+		}
+		return &cert.NotAfter, nil
+	}
+	return nil, fmt.Errorf("no client certificate found in kubeconfig")
+}
+
+// getTokenExpirationTime returns the expiration time of a bearer token.
+// Only JSON web tokens with an "exp" claim have a known expiration time, nil is returned for all other tokens.
+func getTokenExpirationTime(token string) *time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil
+	}
+	claims := struct {
+		Exp *int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return nil
+	}
+	exp := time.Unix(*claims.Exp, 0)
+	return &exp
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetchange
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/openmcp-project/landscaper/apis/config"
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+)
+
+// AddControllerToManager adds the target change controller to the manager.
+// That controller reconciles deploy items again whose target content has changed.
+// Next to changes of the targets, it watches the secrets referenced by targets, as rotated credentials
+// do not change the targets themselves. Events of secrets which are not referenced by a target are dropped.
+func AddControllerToManager(lsUncachedClient, lsCachedClient client.Client,
+	logger logging.Logger, lsMgr manager.Manager, config config.TargetChangesController) error {
+	log := logger.Reconciles("targetChange", "Target")
+	if config.Config.Disable {
+		log.Info("Target change controller is disabled")
+		return nil
+	}
+
+	c := NewController(lsUncachedClient, lsCachedClient, log, lsMgr.GetEventRecorder("Landscaper"))
+
+	if err := lsMgr.GetFieldIndexer().IndexField(context.Background(), &lsv1alpha1.Target{},
+		targetSecretRefIndex, indexTargetBySecretRef); err != nil {
+		return err
+	}
+
+	return builder.ControllerManagedBy(lsMgr).
+		Named("targetchange").
+		For(&lsv1alpha1.Target{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(c.targetsForSecret), builder.OnlyMetadata,
+			builder.WithPredicates(predicate.NewPredicateFuncs(c.isReferencedByTarget))).
+		WithOptions(utils.ConvertCommonControllerConfigToControllerOptions(config.CommonControllerConfig)).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(c)
+}
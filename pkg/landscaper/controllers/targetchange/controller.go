@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/openmcp-project/landscaper/pkg/utils/jobs"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	reasonTargetContentChanged = "TargetContentChanged"
+	actionReconcileDeployItem  = "ReconcileDeployItem"
+
+	// reconcileReasonTargetChange is the value of the reconcile reason annotation of installations
+	// which are reconciled because the content of a target has changed.
+	reconcileReasonTargetChange = "target-change"
+
+	// requeueInterval is the interval after which a target is checked again, if one of its deploy items
+	// is still running with an outdated target content.
+	requeueInterval = 30 * time.Second
+)
+
+// NewController creates a new target change controller.
+func NewController(lsUncachedClient, lsCachedClient client.Client,
+	logger logging.Logger,
+	eventRecorder events.EventRecorder) *Controller {
+	return &Controller{
+		lsUncachedClient: lsUncachedClient,
+		lsCachedClient:   lsCachedClient,
+		log:              logger,
+		eventRecorder:    eventRecorder,
+	}
+}
+
+// Controller starts a new reconciliation of deploy items with ReconcileOnTargetChange,
+// when the resolved content of their target differs from the content they have been reconciled with.
+type Controller struct {
+	lsUncachedClient client.Client
+	lsCachedClient   client.Client
+	log              logging.Logger
+	eventRecorder    events.EventRecorder
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := c.log.StartReconcile(req)
+	ctx = logging.NewContext(ctx, logger)
+
+	target := &lsv1alpha1.Target{}
+	if err := read_write_layer.GetTarget(ctx, c.lsUncachedClient, req.NamespacedName, target, read_write_layer.R000115); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !target.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	deployItems, err := c.listDeployItemsOfTarget(ctx, target)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(deployItems) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	rt, err := targetresolver.Resolve(ctx, target, c.lsUncachedClient)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to resolve target: %w", err)
+	}
+
+	requeue := false
+	starter := jobs.NewStarter(c.lsUncachedClient, reconcileReasonTargetChange)
+	for _, di := range deployItems {
+		if !lsv1alpha1helper.IsTargetContentChanged(di, rt) {
+			continue
+		}
+
+		if di.Status.GetJobID() != di.Status.JobIDFinished {
+			// the running job might still use the previous content, so check again when it is finished
+			requeue = true
+			continue
+		}
+
+		logger.Info("starting reconciliation of deploy item because the content of its target has changed",
+			lc.KeyResource, client.ObjectKeyFromObject(di).String())
+
+		started, err := starter.StartJob(ctx, di)
+		if err != nil {
+			if apierrors.IsConflict(err) {
+				requeue = true
+				continue
+			}
+			return reconcile.Result{}, err
+		}
+		if !started {
+			// a job of the owning installation or execution is still running, so check again when it is finished
+			requeue = true
+			continue
+		}
+
+		c.eventRecorder.Eventf(di, nil, corev1.EventTypeNormal, reasonTargetContentChanged, actionReconcileDeployItem,
+			"the content of target %s has changed", target.Name)
+	}
+
+	if requeue {
+		return reconcile.Result{RequeueAfter: requeueInterval}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// listDeployItemsOfTarget returns all deploy items that use the given target and should be reconciled
+// when the content of the target changes.
+func (c *Controller) listDeployItemsOfTarget(ctx context.Context, target *lsv1alpha1.Target) ([]*lsv1alpha1.DeployItem, error) {
+	diList := &lsv1alpha1.DeployItemList{}
+	if err := read_write_layer.ListDeployItems(ctx, c.lsUncachedClient, diList, read_write_layer.R000116,
+		client.InNamespace(target.Namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list deploy items: %w", err)
+	}
+
+	result := []*lsv1alpha1.DeployItem{}
+	for i := range diList.Items {
+		di := &diList.Items[i]
+		if di.Spec.ReconcileOnTargetChange && di.DeletionTimestamp.IsZero() &&
+			di.Spec.Target != nil && di.Spec.Target.Name == target.Name {
+			result = append(result, di)
+		}
+	}
+	return result, nil
+}
+
+// targetsForSecret returns reconcile requests for all targets that reference the given secret.
+func (c *Controller) targetsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	targets := &lsv1alpha1.TargetList{}
+	if err := read_write_layer.ListTargets(ctx, c.lsCachedClient, targets, read_write_layer.R000117,
+		client.InNamespace(secret.GetNamespace()), client.MatchingFields{targetSecretRefIndex: secret.GetName()}); err != nil {
+		logger.Error(err, "unable to list targets", lc.KeyResource, client.ObjectKeyFromObject(secret).String())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, target := range targets.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&target)})
+	}
+	return requests
+}
+
+// targetSecretRefIndex is the name of the index of targets by the name of the secret they reference.
+const targetSecretRefIndex = "spec.secretRef.name"
+
+// indexTargetBySecretRef returns the name of the secret referenced by a target for the targetSecretRefIndex.
+func indexTargetBySecretRef(obj client.Object) []string {
+	target, ok := obj.(*lsv1alpha1.Target)
+	if !ok || target.Spec.SecretRef == nil {
+		return nil
+	}
+	return []string{target.Spec.SecretRef.Name}
+}
+
+// isReferencedByTarget returns whether a secret is referenced by a target, so that events of other secrets
+// are dropped before they are queued.
+// If the targets cannot be listed, the event is not dropped.
+func (c *Controller) isReferencedByTarget(secret client.Object) bool {
+	ctx := logging.NewContext(context.Background(), c.log)
+
+	targets := &lsv1alpha1.TargetList{}
+	if err := read_write_layer.ListTargets(ctx, c.lsCachedClient, targets, read_write_layer.R000141,
+		client.InNamespace(secret.GetNamespace()), client.MatchingFields{targetSecretRefIndex: secret.GetName()}); err != nil {
+		c.log.Error(err, "unable to list targets", lc.KeyResource, client.ObjectKeyFromObject(secret).String())
+		return true
+	}
+	return len(targets.Items) > 0
+}
@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetchange_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/api"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/controllers/targetchange"
+)
+
+var _ = Describe("Target Change Controller", func() {
+
+	var (
+		ctx    context.Context
+		secret *corev1.Secret
+		target *lsv1alpha1.Target
+	)
+
+	newDeployItem := func(name string, reconcileOnTargetChange bool, contentHash string) *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: lsv1alpha1.DeployItemSpec{
+				Type:                    "landscaper.gardener.cloud/mock",
+				Target:                  &lsv1alpha1.ObjectReference{Name: target.Name, Namespace: target.Namespace},
+				ReconcileOnTargetChange: reconcileOnTargetChange,
+			},
+		}
+		di.Status.SetJobID("job-1")
+		di.Status.JobIDFinished = "job-1"
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		di.Status.TargetContentHash = contentHash
+		return di
+	}
+
+	contentHash := func(content string) string {
+		return lsv1alpha1helper.ResolvedTargetContentHash(&lsv1alpha1.ResolvedTarget{Content: content})
+	}
+
+	reconcileTarget := func(objects ...client.Object) client.Client {
+		kubeClient := fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.DeployItem{}, &lsv1alpha1.Execution{}, &lsv1alpha1.Installation{}).
+			WithObjects(append(objects, secret, target)...).
+			Build()
+		c := targetchange.NewController(kubeClient, kubeClient, logging.Discard(), events.NewFakeRecorder(10))
+		_, err := c.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(target)})
+		Expect(err).ToNot(HaveOccurred())
+		return kubeClient
+	}
+
+	getDeployItem := func(kubeClient client.Client, name string) *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, di)).To(Succeed())
+		return di
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "target-secret", Namespace: "default"},
+			Data:       map[string][]byte{"kubeconfig": []byte("new-content")},
+		}
+		target = &lsv1alpha1.Target{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-target", Namespace: "default"},
+			Spec: lsv1alpha1.TargetSpec{
+				Type:      "landscaper.gardener.cloud/kubernetes-cluster",
+				SecretRef: &lsv1alpha1.LocalSecretReference{Name: secret.Name, Key: "kubeconfig"},
+			},
+		}
+	})
+
+	It("should start a new job for a deploy item whose target content has changed", func() {
+		kubeClient := reconcileTarget(newDeployItem("changed", true, contentHash("old-content")))
+
+		di := getDeployItem(kubeClient, "changed")
+		Expect(di.Status.GetJobID()).ToNot(Equal("job-1"))
+		Expect(di.Status.JobIDFinished).To(Equal("job-1"))
+	})
+
+	It("should not start a new job for a deploy item whose target content has not changed", func() {
+		kubeClient := reconcileTarget(newDeployItem("unchanged", true, contentHash("new-content")))
+
+		di := getDeployItem(kubeClient, "unchanged")
+		Expect(di.Status.GetJobID()).To(Equal("job-1"))
+	})
+
+	It("should not start a new job for a deploy item that did not opt in", func() {
+		kubeClient := reconcileTarget(newDeployItem("not-opted-in", false, contentHash("old-content")))
+
+		di := getDeployItem(kubeClient, "not-opted-in")
+		Expect(di.Status.GetJobID()).To(Equal("job-1"))
+	})
+
+	Context("deploy items managed by an execution", func() {
+
+		var (
+			root *lsv1alpha1.Installation
+			sub  *lsv1alpha1.Installation
+			exec *lsv1alpha1.Execution
+		)
+
+		ownerReference := func(kind, name string) metav1.OwnerReference {
+			return metav1.OwnerReference{APIVersion: lsv1alpha1.SchemeGroupVersion.String(), Kind: kind, Name: name, UID: types.UID("uid-" + name)}
+		}
+
+		newManagedDeployItem := func(name string) *lsv1alpha1.DeployItem {
+			di := newDeployItem(name, true, contentHash("old-content"))
+			di.Labels = map[string]string{lsv1alpha1.ExecutionManagedByLabel: exec.Name}
+			return di
+		}
+
+		getInstallation := func(kubeClient client.Client, name string) *lsv1alpha1.Installation {
+			inst := &lsv1alpha1.Installation{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, inst)).To(Succeed())
+			return inst
+		}
+
+		BeforeEach(func() {
+			root = &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "default"}}
+			root.Status.JobID = "job-1"
+			root.Status.JobIDFinished = "job-1"
+			sub = &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{
+				Name:            "sub",
+				Namespace:       "default",
+				OwnerReferences: []metav1.OwnerReference{ownerReference("Installation", root.Name)},
+			}}
+			exec = &lsv1alpha1.Execution{ObjectMeta: metav1.ObjectMeta{
+				Name:            "sub",
+				Namespace:       "default",
+				OwnerReferences: []metav1.OwnerReference{ownerReference("Installation", sub.Name)},
+			}}
+			exec.Status.JobID = "job-1"
+			exec.Status.JobIDFinished = "job-1"
+		})
+
+		It("should start a new job for the root installation instead of the deploy item", func() {
+			kubeClient := reconcileTarget(root, sub, exec, newManagedDeployItem("a"), newManagedDeployItem("b"))
+
+			inst := getInstallation(kubeClient, root.Name)
+			Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeTrue())
+			Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.ReconcileReasonAnnotation, "target-change"))
+			Expect(lsv1alpha1helper.HasOperation(getInstallation(kubeClient, sub.Name).ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeFalse())
+
+			// the job of the deploy items is started by their execution
+			Expect(getDeployItem(kubeClient, "a").Status.GetJobID()).To(Equal("job-1"))
+			Expect(getDeployItem(kubeClient, "b").Status.GetJobID()).To(Equal("job-1"))
+		})
+
+		It("should wait until the running job of the root installation is finished", func() {
+			root.Status.JobID = "job-2"
+
+			kubeClient := fake.NewClientBuilder().
+				WithScheme(api.LandscaperScheme).
+				WithStatusSubresource(&lsv1alpha1.DeployItem{}, &lsv1alpha1.Execution{}, &lsv1alpha1.Installation{}).
+				WithObjects(root, sub, exec, newManagedDeployItem("a"), secret, target).
+				Build()
+			c := targetchange.NewController(kubeClient, kubeClient, logging.Discard(), events.NewFakeRecorder(10))
+			res, err := c.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(target)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.RequeueAfter).ToNot(BeZero())
+
+			inst := getInstallation(kubeClient, root.Name)
+			Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeFalse())
+		})
+
+		It("should start a new job for an execution without installation", func() {
+			exec.OwnerReferences = nil
+
+			kubeClient := reconcileTarget(exec, newManagedDeployItem("a"))
+
+			updated := &lsv1alpha1.Execution{}
+			Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(exec), updated)).To(Succeed())
+			Expect(updated.Status.JobID).ToNot(Equal("job-1"))
+			Expect(updated.Status.JobIDFinished).To(Equal("job-1"))
+			Expect(getDeployItem(kubeClient, "a").Status.GetJobID()).To(Equal("job-1"))
+		})
+	})
+
+	It("should not interrupt a running job of a deploy item", func() {
+		running := newDeployItem("running", true, contentHash("old-content"))
+		running.Status.SetJobID("job-2")
+
+		kubeClient := fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.DeployItem{}).
+			WithObjects(running, secret, target).
+			Build()
+		c := targetchange.NewController(kubeClient, kubeClient, logging.Discard(), events.NewFakeRecorder(10))
+		res, err := c.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(target)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).ToNot(BeZero())
+
+		di := getDeployItem(kubeClient, "running")
+		Expect(di.Status.GetJobID()).To(Equal("job-2"))
+	})
+})
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetchange
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/api"
+)
+
+var _ = Describe("Secrets referenced by targets", func() {
+
+	var c *Controller
+
+	newTarget := func(name, secretName string) *lsv1alpha1.Target {
+		target := &lsv1alpha1.Target{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       lsv1alpha1.TargetSpec{Type: "landscaper.gardener.cloud/kubernetes-cluster"},
+		}
+		if len(secretName) != 0 {
+			target.Spec.SecretRef = &lsv1alpha1.LocalSecretReference{Name: secretName, Key: "kubeconfig"}
+		}
+		return target
+	}
+
+	newSecret := func(namespace, name string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	}
+
+	BeforeEach(func() {
+		kubeClient := fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithIndex(&lsv1alpha1.Target{}, targetSecretRefIndex, indexTargetBySecretRef).
+			WithObjects(newTarget("a", "shared"), newTarget("b", "shared"), newTarget("c", "other"), newTarget("d", "")).
+			Build()
+		c = NewController(kubeClient, kubeClient, logging.Discard(), events.NewFakeRecorder(10))
+	})
+
+	It("should map a secret to the targets which reference it", func() {
+		Expect(c.targetsForSecret(context.Background(), newSecret("default", "shared"))).To(ConsistOf(
+			reconcile.Request{NamespacedName: client.ObjectKey{Name: "a", Namespace: "default"}},
+			reconcile.Request{NamespacedName: client.ObjectKey{Name: "b", Namespace: "default"}},
+		))
+		Expect(c.targetsForSecret(context.Background(), newSecret("other-namespace", "shared"))).To(BeEmpty())
+	})
+
+	It("should drop events of secrets which are not referenced by a target", func() {
+		Expect(c.isReferencedByTarget(newSecret("default", "shared"))).To(BeTrue())
+		Expect(c.isReferencedByTarget(newSecret("default", "other"))).To(BeTrue())
+		Expect(c.isReferencedByTarget(newSecret("default", "unrelated"))).To(BeFalse())
+		Expect(c.isReferencedByTarget(newSecret("other-namespace", "shared"))).To(BeFalse())
+	})
+
+	It("should let events pass if the targets cannot be listed", func() {
+		kubeClient := fake.NewClientBuilder().
+			WithScheme(api.LandscaperScheme).
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					return errors.New("list failed")
+				},
+			}).
+			Build()
+		c = NewController(kubeClient, kubeClient, logging.Discard(), events.NewFakeRecorder(10))
+
+		Expect(c.isReferencedByTarget(newSecret("default", "unrelated"))).To(BeTrue())
+	})
+})
@@ -21,8 +21,12 @@ const (
 
 	labelKeyTargetSync          = clusters.LabelKeyTargetSync
 	labelValueOk                = clusters.LabelValueTargetSyncOk
+	labelKeyTargetSyncSource    = lsv1alpha1.LandscaperDomain + "/targetsync-source"
 	annotationKeyLastTargetSync = lsv1alpha1.LandscaperDomain + "/lasttargetsync"
 	kubeconfigRenewalSeconds    = 12 * 60 * 60
 	kubeconfigExpirationSeconds = 2 * kubeconfigRenewalSeconds
 	kubeconfigKey               = targettypes.DefaultKubeconfigKey
+
+	clusterAPIKubeconfigSecretSuffix = "-kubeconfig"
+	clusterAPIKubeconfigKey          = "value"
 )
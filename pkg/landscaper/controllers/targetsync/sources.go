@@ -0,0 +1,460 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetsync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+var clusterAPIClusterListGVK = schema.GroupVersionKind{
+	Group:   "cluster.x-k8s.io",
+	Version: "v1beta1",
+	Kind:    "ClusterList",
+}
+
+// targetSyncSource is a source from which a TargetSync creates targets.
+type targetSyncSource interface {
+	// sourceType returns the type of the source as shown in the status of the TargetSync.
+	sourceType() lsv1alpha1.TargetSyncSourceType
+
+	// sync creates or updates the targets of the source. The result contains all targets which belong to the source,
+	// including the unchanged ones, together with the operation that was performed on them.
+	// A target is only created or updated if its name can be claimed for the source.
+	sync(ctx context.Context, claims *targetNameClaims) (map[string]controllerutil.OperationResult, []error)
+}
+
+// targetNameClaims records which source produces a target during a sync, so that two sources of a TargetSync which
+// produce a target with the same name do not overwrite each other's target. The first source which claims a name
+// gets the target; the name is recorded as conflict for the other sources.
+type targetNameClaims struct {
+	owners    map[string]lsv1alpha1.TargetSyncSourceType
+	conflicts map[lsv1alpha1.TargetSyncSourceType][]string
+}
+
+func newTargetNameClaims() *targetNameClaims {
+	return &targetNameClaims{
+		owners:    map[string]lsv1alpha1.TargetSyncSourceType{},
+		conflicts: map[lsv1alpha1.TargetSyncSourceType][]string{},
+	}
+}
+
+// claim claims a target name for a source. It returns false if the name has already been claimed by another source.
+func (t *targetNameClaims) claim(ctx context.Context, targetName string, sourceType lsv1alpha1.TargetSyncSourceType) bool {
+	owner, ok := t.owners[targetName]
+	if !ok {
+		t.owners[targetName] = sourceType
+		return true
+	}
+	if owner == sourceType {
+		return true
+	}
+
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+	logger.Info("target is not synced because another source of the targetsync object produces a target with the same name",
+		"target", targetName, "source", sourceType, "otherSource", owner)
+	t.conflicts[sourceType] = append(t.conflicts[sourceType], targetName)
+	return false
+}
+
+// buildSources returns the sources which are configured in the spec of a TargetSync.
+func (c *TargetSyncController) buildSources(targetSync *lsv1alpha1.TargetSync, sourceClient client.Client) []targetSyncSource {
+	sources := []targetSyncSource{}
+
+	if targetSync.Spec.SecretNameExpression != "" {
+		sources = append(sources, &secretsSource{c: c, targetSync: targetSync, sourceClient: sourceClient})
+	}
+
+	if targetSync.Spec.ShootNameExpression != "" {
+		sources = append(sources, &shootsSource{c: c, targetSync: targetSync})
+	}
+
+	if targetSync.Spec.ClusterAPI != nil {
+		sources = append(sources, &clusterAPISource{c: c, targetSync: targetSync, sourceClient: sourceClient})
+	}
+
+	if targetSync.Spec.SecretSelector != nil {
+		sources = append(sources, &secretSelectorSource{c: c, targetSync: targetSync, sourceClient: sourceClient})
+	}
+
+	if targetSync.Spec.CreateTargetToSource {
+		sources = append(sources, &targetToSourceSource{c: c, targetSync: targetSync})
+	}
+
+	return sources
+}
+
+// secretsSource syncs the secrets of the source namespace whose names match the SecretNameExpression.
+type secretsSource struct {
+	c            *TargetSyncController
+	targetSync   *lsv1alpha1.TargetSync
+	sourceClient client.Client
+}
+
+func (s *secretsSource) sourceType() lsv1alpha1.TargetSyncSourceType {
+	return lsv1alpha1.TargetSyncSourceTypeSecrets
+}
+
+func (s *secretsSource) sync(ctx context.Context, claims *targetNameClaims) (map[string]controllerutil.OperationResult, []error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	secrFilter, err := newNameFilter(s.targetSync.Spec.SecretNameExpression)
+	if err != nil {
+		logger.Error(err, "building secret name filter of targetsync object failed: "+s.targetSync.Spec.SecretNameExpression)
+		return nil, []error{err}
+	}
+
+	secrets := &corev1.SecretList{}
+	if err = read_write_layer.ListSecrets(ctx, s.sourceClient, secrets, read_write_layer.R000064,
+		client.InNamespace(s.targetSync.Spec.SourceNamespace)); err != nil {
+		logger.Error(err, "fetching secret list for targetsync object failed")
+		return nil, []error{err}
+	}
+
+	results := map[string]controllerutil.OperationResult{}
+	errors := []error{}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if secrFilter.shouldBeProcessed(secret) && claims.claim(ctx, secret.Name, s.sourceType()) {
+			secretLogger := logger.WithValues(lc.KeyResource, client.ObjectKeyFromObject(secret).String())
+			secretCtx := logging.NewContext(ctx, secretLogger)
+
+			results[secret.Name] = controllerutil.OperationResultNone
+
+			op, err := s.c.handleSecret(secretCtx, s.targetSync, secret, kubeconfigKey, s.sourceType())
+			if err != nil {
+				msg := fmt.Sprintf("handling secret %s of targetsync object failed", client.ObjectKeyFromObject(secret).String())
+				secretLogger.Error(err, msg)
+				errors = append(errors, err)
+				continue
+			}
+			results[secret.Name] = op
+		}
+	}
+
+	return results, errors
+}
+
+// shootsSource creates targets with short living access data for the shoots whose names match the ShootNameExpression.
+type shootsSource struct {
+	c          *TargetSyncController
+	targetSync *lsv1alpha1.TargetSync
+}
+
+func (s *shootsSource) sourceType() lsv1alpha1.TargetSyncSourceType {
+	return lsv1alpha1.TargetSyncSourceTypeShoots
+}
+
+func (s *shootsSource) sync(ctx context.Context, claims *targetNameClaims) (map[string]controllerutil.OperationResult, []error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	shootFilter, err := newNameFilter(s.targetSync.Spec.ShootNameExpression)
+	if err != nil {
+		logger.Error(err, "building shoot name filter of targetsync object failed: "+s.targetSync.Spec.ShootNameExpression)
+		return nil, []error{err}
+	}
+
+	shootClient, err := s.c.sourceClientProvider.GetSourceShootClient(ctx, s.targetSync, s.c.lsUncachedClient)
+	if err != nil {
+		logger.Error(err, "failed to get shoot client for targetsync")
+		return nil, []error{err}
+	}
+
+	shootList, err := shootClient.ListShoots(ctx, s.targetSync.Spec.SourceNamespace)
+	if err != nil {
+		logger.Error(err, "failed to list shoots for targetsync")
+		return nil, []error{err}
+	}
+
+	results := map[string]controllerutil.OperationResult{}
+	errors := []error{}
+	for i := range shootList.Items {
+		shoot := &shootList.Items[i]
+		if shootFilter.shouldBeProcessed(shoot) {
+			shootLogger := logger.WithValues(lc.KeyResource, client.ObjectKeyFromObject(shoot).String())
+			shootCtx := logging.NewContext(ctx, shootLogger)
+
+			targetName := s.c.deriveTargetNameFromShootName(shoot.GetName())
+			if !claims.claim(ctx, targetName, s.sourceType()) {
+				continue
+			}
+			results[targetName] = controllerutil.OperationResultNone
+
+			op, err := s.c.handleShoot(shootCtx, s.targetSync, shootClient, shoot)
+			if err != nil {
+				msg := fmt.Sprintf("handling shoot %s of targetsync object failed", client.ObjectKeyFromObject(shoot).String())
+				shootLogger.Error(err, msg)
+				errors = append(errors, err)
+				continue
+			}
+			results[targetName] = op
+		}
+	}
+
+	return results, errors
+}
+
+// clusterAPISource creates targets for the Cluster API clusters of the source namespace. Cluster API stores the
+// kubeconfig of a cluster in the secret "<cluster name>-kubeconfig" under the key "value".
+type clusterAPISource struct {
+	c            *TargetSyncController
+	targetSync   *lsv1alpha1.TargetSync
+	sourceClient client.Client
+}
+
+func (s *clusterAPISource) sourceType() lsv1alpha1.TargetSyncSourceType {
+	return lsv1alpha1.TargetSyncSourceTypeClusterAPI
+}
+
+func (s *clusterAPISource) sync(ctx context.Context, claims *targetNameClaims) (map[string]controllerutil.OperationResult, []error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	nameExpression := s.targetSync.Spec.ClusterAPI.ClusterNameExpression
+	if nameExpression == "" {
+		nameExpression = "*"
+	}
+
+	clusterFilter, err := newNameFilter(nameExpression)
+	if err != nil {
+		logger.Error(err, "building cluster name filter of targetsync object failed: "+nameExpression)
+		return nil, []error{err}
+	}
+
+	clusterList := &unstructured.UnstructuredList{}
+	clusterList.SetGroupVersionKind(clusterAPIClusterListGVK)
+	if err := read_write_layer.ListUnstructured(ctx, s.sourceClient, clusterList, read_write_layer.R000118,
+		client.InNamespace(s.targetSync.Spec.SourceNamespace)); err != nil {
+		logger.Error(err, "failed to list cluster api clusters for targetsync")
+		return nil, []error{err}
+	}
+
+	results := map[string]controllerutil.OperationResult{}
+	errors := []error{}
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+		if !clusterFilter.shouldBeProcessed(cluster) {
+			continue
+		}
+
+		clusterLogger := logger.WithValues(lc.KeyResource, client.ObjectKeyFromObject(cluster).String())
+		clusterCtx := logging.NewContext(ctx, clusterLogger)
+
+		targetName := cluster.GetName()
+		if !claims.claim(ctx, targetName, s.sourceType()) {
+			continue
+		}
+		results[targetName] = controllerutil.OperationResultNone
+
+		secret := &corev1.Secret{}
+		secretKey := client.ObjectKey{Namespace: cluster.GetNamespace(), Name: cluster.GetName() + clusterAPIKubeconfigSecretSuffix}
+		if err := read_write_layer.GetSecret(clusterCtx, s.sourceClient, secretKey, secret, read_write_layer.R000119); err != nil {
+			if apierrors.IsNotFound(err) {
+				// the cluster is not yet provisioned; an already existing target is kept
+				clusterLogger.Info("kubeconfig secret of cluster api cluster does not exist yet", "secret", secretKey.String())
+				continue
+			}
+			clusterLogger.Error(err, "fetching kubeconfig secret of cluster api cluster failed")
+			errors = append(errors, err)
+			continue
+		}
+
+		op, err := s.c.createOrUpdateTargetAndSecret(clusterCtx, s.targetSync, targetName, secret, clusterAPIKubeconfigKey, s.sourceType())
+		if err != nil {
+			msg := fmt.Sprintf("handling cluster api cluster %s of targetsync object failed", client.ObjectKeyFromObject(cluster).String())
+			clusterLogger.Error(err, msg)
+			errors = append(errors, err)
+			continue
+		}
+		results[targetName] = op
+	}
+
+	return results, errors
+}
+
+// secretSelectorSource syncs the secrets of the source namespace which match a label selector.
+type secretSelectorSource struct {
+	c            *TargetSyncController
+	targetSync   *lsv1alpha1.TargetSync
+	sourceClient client.Client
+}
+
+func (s *secretSelectorSource) sourceType() lsv1alpha1.TargetSyncSourceType {
+	return lsv1alpha1.TargetSyncSourceTypeSecretSelector
+}
+
+func (s *secretSelectorSource) sync(ctx context.Context, claims *targetNameClaims) (map[string]controllerutil.OperationResult, []error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	selector, err := metav1.LabelSelectorAsSelector(&s.targetSync.Spec.SecretSelector.LabelSelector)
+	if err != nil {
+		logger.Error(err, "building label selector of targetsync object failed")
+		return nil, []error{err}
+	}
+
+	key := s.targetSync.Spec.SecretSelector.KubeconfigKey
+	if key == "" {
+		key = kubeconfigKey
+	}
+
+	secrets := &corev1.SecretList{}
+	if err = read_write_layer.ListSecrets(ctx, s.sourceClient, secrets, read_write_layer.R000120,
+		client.InNamespace(s.targetSync.Spec.SourceNamespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		logger.Error(err, "fetching selected secrets for targetsync object failed")
+		return nil, []error{err}
+	}
+
+	results := map[string]controllerutil.OperationResult{}
+	errors := []error{}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if !claims.claim(ctx, secret.Name, s.sourceType()) {
+			continue
+		}
+
+		secretLogger := logger.WithValues(lc.KeyResource, client.ObjectKeyFromObject(secret).String())
+		secretCtx := logging.NewContext(ctx, secretLogger)
+
+		results[secret.Name] = controllerutil.OperationResultNone
+
+		if _, ok := secret.Data[key]; !ok {
+			err := fmt.Errorf("selected secret %s contains no kubeconfig with key %s", client.ObjectKeyFromObject(secret).String(), key)
+			secretLogger.Error(err, "handling selected secret of targetsync object failed")
+			errors = append(errors, err)
+			continue
+		}
+
+		op, err := s.c.handleSecret(secretCtx, s.targetSync, secret, key, s.sourceType())
+		if err != nil {
+			msg := fmt.Sprintf("handling selected secret %s of targetsync object failed", client.ObjectKeyFromObject(secret).String())
+			secretLogger.Error(err, msg)
+			errors = append(errors, err)
+			continue
+		}
+		results[secret.Name] = op
+	}
+
+	return results, errors
+}
+
+// targetToSourceSource creates the target which references the secret with the kubeconfig of the source cluster.
+type targetToSourceSource struct {
+	c          *TargetSyncController
+	targetSync *lsv1alpha1.TargetSync
+}
+
+func (s *targetToSourceSource) sourceType() lsv1alpha1.TargetSyncSourceType {
+	return lsv1alpha1.TargetSyncSourceTypeTargetToSource
+}
+
+func (s *targetToSourceSource) sync(ctx context.Context, claims *targetNameClaims) (map[string]controllerutil.OperationResult, []error) {
+	targetName := s.targetSync.Spec.TargetToSourceName
+	if targetName == "" {
+		targetName = s.targetSync.Spec.SourceNamespace
+	}
+
+	if !claims.claim(ctx, targetName, s.sourceType()) {
+		return nil, nil
+	}
+
+	results := map[string]controllerutil.OperationResult{targetName: controllerutil.OperationResultNone}
+
+	op, err := s.c.createOrUpdateTarget(ctx, s.targetSync, targetName, s.targetSync.Spec.SecretRef.Name,
+		s.targetSync.Spec.SecretRef.Key, false, s.sourceType())
+	if err != nil {
+		return results, []error{err}
+	}
+	results[targetName] = op
+	return results, nil
+}
+
+// mergeOperationResults combines the results of the operations on a target and its secret.
+func mergeOperationResults(results ...controllerutil.OperationResult) controllerutil.OperationResult {
+	merged := controllerutil.OperationResultNone
+	for _, result := range results {
+		switch result {
+		case controllerutil.OperationResultCreated:
+			return controllerutil.OperationResultCreated
+		case controllerutil.OperationResultNone:
+		default:
+			merged = controllerutil.OperationResultUpdated
+		}
+	}
+	return merged
+}
+
+// sourceStatusBuilder collects the per-source status of a sync.
+type sourceStatusBuilder struct {
+	order    []lsv1alpha1.TargetSyncSourceType
+	statuses map[lsv1alpha1.TargetSyncSourceType]*lsv1alpha1.TargetSyncSourceStatus
+}
+
+func newSourceStatusBuilder() *sourceStatusBuilder {
+	return &sourceStatusBuilder{
+		statuses: map[lsv1alpha1.TargetSyncSourceType]*lsv1alpha1.TargetSyncSourceStatus{},
+	}
+}
+
+func (b *sourceStatusBuilder) get(sourceType lsv1alpha1.TargetSyncSourceType) *lsv1alpha1.TargetSyncSourceStatus {
+	status, ok := b.statuses[sourceType]
+	if !ok {
+		status = &lsv1alpha1.TargetSyncSourceStatus{Type: sourceType}
+		b.statuses[sourceType] = status
+		b.order = append(b.order, sourceType)
+	}
+	return status
+}
+
+func (b *sourceStatusBuilder) addResults(sourceType lsv1alpha1.TargetSyncSourceType, results map[string]controllerutil.OperationResult) {
+	status := b.get(sourceType)
+	for targetName, op := range results {
+		switch op {
+		case controllerutil.OperationResultCreated:
+			status.CreatedTargets = append(status.CreatedTargets, targetName)
+		case controllerutil.OperationResultNone:
+		default:
+			status.UpdatedTargets = append(status.UpdatedTargets, targetName)
+		}
+	}
+}
+
+func (b *sourceStatusBuilder) addConflictingTargets(sourceType lsv1alpha1.TargetSyncSourceType, targetNames []string) {
+	if len(targetNames) == 0 {
+		return
+	}
+	status := b.get(sourceType)
+	status.ConflictingTargets = append(status.ConflictingTargets, targetNames...)
+}
+
+func (b *sourceStatusBuilder) addRemovedTarget(sourceType lsv1alpha1.TargetSyncSourceType, targetName string) {
+	status := b.get(sourceType)
+	status.RemovedTargets = append(status.RemovedTargets, targetName)
+}
+
+func (b *sourceStatusBuilder) build() []lsv1alpha1.TargetSyncSourceStatus {
+	result := make([]lsv1alpha1.TargetSyncSourceStatus, 0, len(b.order))
+	for _, sourceType := range b.order {
+		status := b.statuses[sourceType]
+		sort.Strings(status.CreatedTargets)
+		sort.Strings(status.UpdatedTargets)
+		sort.Strings(status.RemovedTargets)
+		sort.Strings(status.ConflictingTargets)
+		result = append(result, *status)
+	}
+	return result
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetsync
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/api"
+	"github.com/openmcp-project/landscaper/pkg/utils/clusters"
+)
+
+var _ = Describe("TargetSync Sources", func() {
+
+	const (
+		namespace       = "landscaper"
+		sourceNamespace = "source"
+	)
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		c          *TargetSyncController
+		targetSync *lsv1alpha1.TargetSync
+	)
+
+	newCluster := func(name string) *unstructured.Unstructured {
+		cluster := &unstructured.Unstructured{}
+		cluster.SetGroupVersionKind(schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "Cluster"})
+		cluster.SetName(name)
+		cluster.SetNamespace(sourceNamespace)
+		return cluster
+	}
+
+	newSecret := func(name string, labels map[string]string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: sourceNamespace, Labels: labels},
+			Data:       data,
+		}
+	}
+
+	getTarget := func(name string) *lsv1alpha1.Target {
+		target := &lsv1alpha1.Target{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, target)).To(Succeed())
+		return target
+	}
+
+	buildClient := func(objects ...client.Object) {
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(objects...).Build()
+		c = NewTargetSyncController(kubeClient, kubeClient, logging.Discard(), clusters.NewTrivialSourceClientProvider(kubeClient, nil)).(*TargetSyncController)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		targetSync = &lsv1alpha1.TargetSync{
+			ObjectMeta: metav1.ObjectMeta{Name: "sync", Namespace: namespace},
+			Spec: lsv1alpha1.TargetSyncSpec{
+				SourceNamespace: sourceNamespace,
+				ClusterAPI:      &lsv1alpha1.TargetSyncClusterAPISource{ClusterNameExpression: "prod-.*"},
+			},
+		}
+	})
+
+	Context("Cluster API", func() {
+
+		It("should create targets for the provisioned clusters whose names match the expression", func() {
+			buildClient(
+				newCluster("prod-1"), newCluster("prod-2"), newCluster("dev-1"),
+				newSecret("prod-1"+clusterAPIKubeconfigSecretSuffix, nil, map[string][]byte{clusterAPIKubeconfigKey: []byte("kubeconfig-1")}),
+				newSecret("dev-1"+clusterAPIKubeconfigSecretSuffix, nil, map[string][]byte{clusterAPIKubeconfigKey: []byte("kubeconfig-dev")}),
+			)
+
+			source := &clusterAPISource{c: c, targetSync: targetSync, sourceClient: kubeClient}
+			results, errs := source.sync(ctx, newTargetNameClaims())
+			Expect(errs).To(BeEmpty())
+			// the cluster prod-2 is not provisioned yet, as its kubeconfig secret does not exist
+			Expect(results).To(Equal(map[string]controllerutil.OperationResult{
+				"prod-1": controllerutil.OperationResultCreated,
+				"prod-2": controllerutil.OperationResultNone,
+			}))
+
+			target := getTarget("prod-1")
+			Expect(target.Labels).To(HaveKeyWithValue(labelKeyTargetSyncSource, string(lsv1alpha1.TargetSyncSourceTypeClusterAPI)))
+			Expect(target.Spec.SecretRef.Name).To(Equal("prod-1"))
+			Expect(target.Spec.SecretRef.Key).To(Equal(clusterAPIKubeconfigKey))
+
+			secret := &corev1.Secret{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "prod-1", Namespace: namespace}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue(clusterAPIKubeconfigKey, []byte("kubeconfig-1")))
+
+			// a second sync does not change anything
+			results, errs = source.sync(ctx, newTargetNameClaims())
+			Expect(errs).To(BeEmpty())
+			Expect(results).To(HaveKeyWithValue("prod-1", controllerutil.OperationResultNone))
+		})
+	})
+
+	Context("conflicts", func() {
+
+		It("should not let two sources overwrite each other's target", func() {
+			selectorLabels := map[string]string{"cluster-manager.example.com/kubeconfig": "true"}
+			targetSync.Spec.SecretSelector = &lsv1alpha1.TargetSyncSecretSelectorSource{
+				LabelSelector: metav1.LabelSelector{MatchLabels: selectorLabels},
+			}
+			buildClient(
+				newCluster("prod-1"),
+				newSecret("prod-1"+clusterAPIKubeconfigSecretSuffix, nil, map[string][]byte{clusterAPIKubeconfigKey: []byte("from-cluster-api")}),
+				newSecret("prod-1", selectorLabels, map[string][]byte{kubeconfigKey: []byte("from-selector")}),
+				newSecret("other", selectorLabels, map[string][]byte{kubeconfigKey: []byte("other")}),
+			)
+
+			statuses, errs := c.handleSources(ctx, targetSync, kubeClient)
+			Expect(errs).To(BeEmpty())
+			Expect(statuses).To(ConsistOf(
+				lsv1alpha1.TargetSyncSourceStatus{
+					Type:           lsv1alpha1.TargetSyncSourceTypeClusterAPI,
+					CreatedTargets: []string{"prod-1"},
+				},
+				lsv1alpha1.TargetSyncSourceStatus{
+					Type:               lsv1alpha1.TargetSyncSourceTypeSecretSelector,
+					CreatedTargets:     []string{"other"},
+					ConflictingTargets: []string{"prod-1"},
+				},
+			))
+
+			target := getTarget("prod-1")
+			Expect(target.Labels).To(HaveKeyWithValue(labelKeyTargetSyncSource, string(lsv1alpha1.TargetSyncSourceTypeClusterAPI)))
+			Expect(target.Spec.SecretRef.Key).To(Equal(clusterAPIKubeconfigKey))
+
+			secret := &corev1.Secret{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "prod-1", Namespace: namespace}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue(clusterAPIKubeconfigKey, []byte("from-cluster-api")))
+		})
+
+		It("should let a source claim a name several times", func() {
+			claims := newTargetNameClaims()
+			Expect(claims.claim(ctx, "a", lsv1alpha1.TargetSyncSourceTypeSecrets)).To(BeTrue())
+			Expect(claims.claim(ctx, "a", lsv1alpha1.TargetSyncSourceTypeSecrets)).To(BeTrue())
+			Expect(claims.claim(ctx, "a", lsv1alpha1.TargetSyncSourceTypeClusterAPI)).To(BeFalse())
+			Expect(claims.conflicts).To(Equal(map[lsv1alpha1.TargetSyncSourceType][]string{
+				lsv1alpha1.TargetSyncSourceTypeClusterAPI: {"a"},
+			}))
+		})
+	})
+})
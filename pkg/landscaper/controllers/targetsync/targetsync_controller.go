@@ -183,7 +183,11 @@ func (c *TargetSyncController) handleReconcile(ctx context.Context, targetSync *
 				logger.Error(err, "refreshing token failed")
 				errors = append(errors, err)
 			} else {
-				errors = c.handleSecretsAndShoots(ctx, targetSync, sourceClient)
+				var sourceStatuses []lsv1alpha1.TargetSyncSourceStatus
+				sourceStatuses, errors = c.handleSources(ctx, targetSync, sourceClient)
+				if sourceStatuses != nil {
+					targetSync.Status.Sources = sourceStatuses
+				}
 			}
 		}
 	}
@@ -241,8 +245,8 @@ func (c *TargetSyncController) handleDelete(ctx context.Context, targetSync *lsv
 	return nil
 }
 
-func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
-	sourceClient client.Client) []error {
+func (c *TargetSyncController) handleSources(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
+	sourceClient client.Client) ([]lsv1alpha1.TargetSyncSourceStatus, []error) {
 
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 	errors := []error{}
@@ -251,96 +255,25 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 		msg := "a targetsync object with both, secretNameExpression and shootNameExpression, is not allowed"
 		logger.Error(nil, msg)
 		errors = append(errors, errors2.New(msg))
-		return errors
+		return nil, errors
 	}
 
 	oldTargets, err := c.fetchOldTargets(ctx, targetSync)
 	if err != nil {
 		errors = append(errors, err)
-		return errors
+		return nil, errors
 	}
 
-	if targetSync.Spec.SecretNameExpression != "" {
-		secrFilter, err := newNameFilter(targetSync.Spec.SecretNameExpression)
-		if err != nil {
-			logger.Error(err, "building secret name filter of targetsync object failed: "+targetSync.Spec.SecretNameExpression)
-			errors = append(errors, err)
-			return errors
-		}
-
-		secrets := &corev1.SecretList{}
-		if err = read_write_layer.ListSecrets(ctx, sourceClient, secrets, read_write_layer.R000064,
-			client.InNamespace(targetSync.Spec.SourceNamespace)); err != nil {
-			logger.Error(err, "fetching secret list for targetsync object failed")
-			errors = append(errors, err)
-			return errors
-		}
-
-		for _, secret := range secrets.Items {
-			if secrFilter.shouldBeProcessed(&secret) {
-				secretLogger := logger.WithValues(lc.KeyResource, client.ObjectKeyFromObject(&secret).String())
-				secretCtx := logging.NewContext(ctx, secretLogger)
-
-				delete(oldTargets, secret.Name)
-
-				if err = c.handleSecret(secretCtx, targetSync, &secret); err != nil {
-					msg := fmt.Sprintf("handling secret %s of targetsync object failed", client.ObjectKeyFromObject(&secret).String())
-					secretLogger.Error(err, msg)
-					errors = append(errors, err)
-				}
-			}
-		}
-	}
-
-	if targetSync.Spec.ShootNameExpression != "" {
-		shootFilter, err := newNameFilter(targetSync.Spec.ShootNameExpression)
-		if err != nil {
-			logger.Error(err, "building shoot name filter of targetsync object failed: "+targetSync.Spec.ShootNameExpression)
-			errors = append(errors, err)
-			return errors
-		}
-
-		shootClient, err := c.sourceClientProvider.GetSourceShootClient(ctx, targetSync, c.lsUncachedClient)
-		if err != nil {
-			logger.Error(err, "failed to get shoot client for targetsync")
-			errors = append(errors, err)
-			return errors
-		}
-
-		shootList, err := shootClient.ListShoots(ctx, targetSync.Spec.SourceNamespace)
-		if err != nil {
-			logger.Error(err, "failed to list shoots for targetsync")
-			errors = append(errors, err)
-			return errors
-		}
-
-		for _, shoot := range shootList.Items {
-			if shootFilter.shouldBeProcessed(&shoot) {
-				shootLogger := logger.WithValues(lc.KeyResource, client.ObjectKeyFromObject(&shoot).String())
-				shootCtx := logging.NewContext(ctx, shootLogger)
-
-				targetName := c.deriveTargetNameFromShootName(shoot.GetName())
-				delete(oldTargets, targetName)
-
-				if err = c.handleShoot(shootCtx, targetSync, shootClient, &shoot); err != nil {
-					msg := fmt.Sprintf("handling shoot %s of targetsync object failed", client.ObjectKeyFromObject(&shoot).String())
-					shootLogger.Error(err, msg)
-					errors = append(errors, err)
-				}
-			}
-		}
-	}
-
-	if targetSync.Spec.CreateTargetToSource {
-		targetName := targetSync.Spec.TargetToSourceName
-		if targetName == "" {
-			targetName = targetSync.Spec.SourceNamespace
-		}
-		delete(oldTargets, targetName)
-		if err := c.createOrUpdateTarget(ctx, targetSync, targetName, targetSync.Spec.SecretRef.Name,
-			targetSync.Spec.SecretRef.Key, false); err != nil {
-			errors = append(errors, err)
+	statusBuilder := newSourceStatusBuilder()
+	claims := newTargetNameClaims()
+	for _, source := range c.buildSources(targetSync, sourceClient) {
+		results, sourceErrors := source.sync(ctx, claims)
+		for targetName := range results {
+			delete(oldTargets, targetName)
 		}
+		statusBuilder.addResults(source.sourceType(), results)
+		statusBuilder.addConflictingTargets(source.sourceType(), claims.conflicts[source.sourceType()])
+		errors = append(errors, sourceErrors...)
 	}
 
 	if len(errors) == 0 {
@@ -361,59 +294,76 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 				msg := fmt.Sprintf("deleting old target %s of targetsync object failed", client.ObjectKeyFromObject(&target).String())
 				logger.Error(err, msg)
 				errors = append(errors, err)
+				continue
+			}
+
+			if sourceType := nextOldTarget.Labels[labelKeyTargetSyncSource]; sourceType != "" {
+				statusBuilder.addRemovedTarget(lsv1alpha1.TargetSyncSourceType(sourceType), key)
 			}
 		}
 	}
 
-	return errors
+	return statusBuilder.build(), errors
+}
+
+func (c *TargetSyncController) handleSecret(ctx context.Context, targetSync *lsv1alpha1.TargetSync, secret *corev1.Secret,
+	key string, sourceType lsv1alpha1.TargetSyncSourceType) (controllerutil.OperationResult, error) {
+	return c.createOrUpdateTargetAndSecret(ctx, targetSync, secret.GetName(), secret, key, sourceType)
 }
 
-func (c *TargetSyncController) handleSecret(ctx context.Context, targetSync *lsv1alpha1.TargetSync, secret *corev1.Secret) error {
-	targetName := secret.GetName()
-	err := c.createOrUpdateTarget(ctx, targetSync, targetName, "", "", false)
+// createOrUpdateTargetAndSecret copies a secret of the source namespace into the namespace of the TargetSync and
+// creates or updates a target referencing the copy.
+func (c *TargetSyncController) createOrUpdateTargetAndSecret(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
+	targetName string, secret *corev1.Secret, key string, sourceType lsv1alpha1.TargetSyncSourceType) (controllerutil.OperationResult, error) {
+
+	targetOp, err := c.createOrUpdateTarget(ctx, targetSync, targetName, "", key, false, sourceType)
 	if err != nil {
-		return err
+		return controllerutil.OperationResultNone, err
 	}
 
-	err = c.createOrUpdateSecret(ctx, targetSync, secret)
-	return err
+	secretOp, err := c.createOrUpdateSecret(ctx, targetSync, targetName, secret)
+	if err != nil {
+		return targetOp, err
+	}
+
+	return mergeOperationResults(targetOp, secretOp), nil
 }
 
 func (c *TargetSyncController) handleShoot(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
-	shootClient *clusters.ShootClient, shoot *unstructured.Unstructured) error {
+	shootClient *clusters.ShootClient, shoot *unstructured.Unstructured) (controllerutil.OperationResult, error) {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
 	targetName := c.deriveTargetNameFromShootName(shoot.GetName())
 
 	due, err := c.isRenewalOfShortLivedKubeconfigDue(ctx, targetName, targetSync.Namespace)
 	if err != nil {
-		return err
+		return controllerutil.OperationResultNone, err
 	} else if !due {
-		return nil
+		return controllerutil.OperationResultNone, nil
 	}
 
 	kubeconfig, _, err := shootClient.GetShootAdminKubeconfig(ctx, shoot.GetName(), shoot.GetNamespace(), kubeconfigExpirationSeconds)
 	if err != nil {
 		msg := "targetsync for shoot failed to get admin kubeconfig"
 		logger.Error(err, msg)
-		return fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
+		return controllerutil.OperationResultNone, fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
 	}
 
-	err = c.createOrUpdateSecretForShoot(ctx, targetSync, targetName, kubeconfig)
+	secretOp, err := c.createOrUpdateSecretForShoot(ctx, targetSync, targetName, kubeconfig)
 	if err != nil {
 		msg := "targetsync for shoot failed: could not create or update secret"
 		logger.Error(err, msg)
-		return fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
+		return controllerutil.OperationResultNone, fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
 	}
 
-	err = c.createOrUpdateTarget(ctx, targetSync, targetName, "", "", true)
+	targetOp, err := c.createOrUpdateTarget(ctx, targetSync, targetName, "", "", true, lsv1alpha1.TargetSyncSourceTypeShoots)
 	if err != nil {
 		msg := "targetsync for shoot failed: could not create or update target"
 		logger.Error(err, msg)
-		return fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
+		return controllerutil.OperationResultNone, fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
 	}
 
-	return nil
+	return mergeOperationResults(targetOp, secretOp), nil
 }
 
 func (c *TargetSyncController) isRenewalOfShortLivedKubeconfigDue(ctx context.Context, targetName, targetNamespace string) (due bool, err error) {
@@ -449,15 +399,17 @@ func (c *TargetSyncController) isRenewalOfShortLivedKubeconfigDue(ctx context.Co
 }
 
 func (c *TargetSyncController) createOrUpdateTarget(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
-	targetName, alternativeSecretName, alternativeKubeconfigKey string, addLastTargetSyncAnnotation bool) error {
+	targetName, alternativeSecretName, alternativeKubeconfigKey string, addLastTargetSyncAnnotation bool,
+	sourceType lsv1alpha1.TargetSyncSourceType) (controllerutil.OperationResult, error) {
 
 	newTarget := &lsv1alpha1.Target{
 		ObjectMeta: controllerruntime.ObjectMeta{Name: targetName, Namespace: targetSync.Namespace},
 	}
 
-	_, err := controllerruntime.CreateOrUpdate(ctx, c.lsUncachedClient, newTarget, func() error {
+	return controllerruntime.CreateOrUpdate(ctx, c.lsUncachedClient, newTarget, func() error {
 		newTarget.Labels = map[string]string{
-			labelKeyTargetSync: labelValueOk,
+			labelKeyTargetSync:       labelValueOk,
+			labelKeyTargetSyncSource: string(sourceType),
 		}
 		if addLastTargetSyncAnnotation {
 			helper.SetTimestampAnnotationNow(&newTarget.ObjectMeta, annotationKeyLastTargetSync)
@@ -482,16 +434,15 @@ func (c *TargetSyncController) createOrUpdateTarget(ctx context.Context, targetS
 		}
 		return nil
 	})
-
-	return err
 }
 
-func (c *TargetSyncController) createOrUpdateSecret(ctx context.Context, targetSync *lsv1alpha1.TargetSync, secret *corev1.Secret) error {
+func (c *TargetSyncController) createOrUpdateSecret(ctx context.Context, targetSync *lsv1alpha1.TargetSync, secretName string,
+	secret *corev1.Secret) (controllerutil.OperationResult, error) {
 	newSecret := &corev1.Secret{
-		ObjectMeta: controllerruntime.ObjectMeta{Name: secret.Name, Namespace: targetSync.Namespace},
+		ObjectMeta: controllerruntime.ObjectMeta{Name: secretName, Namespace: targetSync.Namespace},
 	}
 
-	_, err := controllerruntime.CreateOrUpdate(ctx, c.lsUncachedClient, newSecret, func() error {
+	return controllerruntime.CreateOrUpdate(ctx, c.lsUncachedClient, newSecret, func() error {
 		newSecret.Labels = map[string]string{
 			labelKeyTargetSync: labelValueOk,
 		}
@@ -499,12 +450,10 @@ func (c *TargetSyncController) createOrUpdateSecret(ctx context.Context, targetS
 		newSecret.Type = secret.Type
 		return nil
 	})
-
-	return err
 }
 
 func (c *TargetSyncController) createOrUpdateSecretForShoot(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
-	targetName string, kubeconfig string) error {
+	targetName string, kubeconfig string) (controllerutil.OperationResult, error) {
 
 	newSecret := &corev1.Secret{
 		ObjectMeta: controllerruntime.ObjectMeta{Name: targetName, Namespace: targetSync.Namespace},
@@ -512,10 +461,10 @@ func (c *TargetSyncController) createOrUpdateSecretForShoot(ctx context.Context,
 
 	kubeconfigBytes, err := base64.StdEncoding.DecodeString(kubeconfig)
 	if err != nil {
-		return err
+		return controllerutil.OperationResultNone, err
 	}
 
-	_, err = controllerruntime.CreateOrUpdate(ctx, c.lsUncachedClient, newSecret, func() error {
+	return controllerruntime.CreateOrUpdate(ctx, c.lsUncachedClient, newSecret, func() error {
 		newSecret.Labels = map[string]string{
 			labelKeyTargetSync: labelValueOk,
 		}
@@ -525,8 +474,6 @@ func (c *TargetSyncController) createOrUpdateSecretForShoot(ctx context.Context,
 		newSecret.Type = corev1.SecretTypeOpaque
 		return nil
 	})
-
-	return err
 }
 
 func (c *TargetSyncController) removeTargetsAndSecrets(ctx context.Context, targetSync *lsv1alpha1.TargetSync) error {
@@ -214,6 +214,60 @@ var _ = Describe("TargetSync Controller", func() {
 			checkTargetAndSecretDoNotExist(ctx, secretName2)
 		})
 
+		It("should sync secrets selected by labels and report the changes per source", func() {
+			ctx := context.Background()
+
+			const (
+				targetSyncName   = "test-target-sync"
+				secretName1      = "managed-cluster-1"
+				secretName2      = "managed-cluster-2"
+				unselectedSecret = "unselected-cluster"
+			)
+
+			var err error
+			state, err = testenv.InitResourcesWithTwoNamespaces(ctx, "./testdata/state/test3")
+			Expect(err).ToNot(HaveOccurred())
+
+			tgs := &lsv1alpha1.TargetSync{}
+			tgs.Name = targetSyncName
+			tgs.Namespace = state.Namespace
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(tgs), tgs))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(tgs))
+
+			checkTargetAndSecret(ctx, secretName1)
+			checkTargetAndSecret(ctx, secretName2)
+			checkTarget(ctx, secretName1, secretName1, "config")
+			checkTargetAndSecretDoNotExist(ctx, unselectedSecret)
+
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(tgs), tgs))
+			Expect(tgs.Status.LastErrors).To(BeEmpty())
+			Expect(tgs.Status.Sources).To(ConsistOf(lsv1alpha1.TargetSyncSourceStatus{
+				Type:           lsv1alpha1.TargetSyncSourceTypeSecretSelector,
+				CreatedTargets: []string{secretName1, secretName2},
+			}))
+
+			// Remove the label from a secret
+
+			sourceSecret1 := &corev1.Secret{}
+			sourceSecret1.Name = secretName1
+			sourceSecret1.Namespace = state.Namespace2
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(sourceSecret1), sourceSecret1))
+			sourceSecret1.Labels = nil
+			testutils.ExpectNoError(state.Client.Update(ctx, sourceSecret1))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(tgs))
+
+			checkTargetAndSecretDoNotExist(ctx, secretName1)
+			checkTargetAndSecret(ctx, secretName2)
+
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(tgs), tgs))
+			Expect(tgs.Status.Sources).To(ConsistOf(lsv1alpha1.TargetSyncSourceStatus{
+				Type:           lsv1alpha1.TargetSyncSourceTypeSecretSelector,
+				RemovedTargets: []string{secretName1},
+			}))
+		})
+
 		It("should not sync if there is more than one TargetSync object", func() {
 			ctx := context.Background()
 
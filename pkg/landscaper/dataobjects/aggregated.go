@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataobjects
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+)
+
+// NewAggregatedDataObjectFromList merges the given contributions to an aggregated data object into one internal data object.
+// The key of every contribution is read from its aggregation key label.
+// Depending on the format of the import definition, the contributions are merged into a map from contribution key to value,
+// or into a list of values sorted by the contribution key.
+func NewAggregatedDataObjectFromList(doList *lsv1alpha1.DataObjectList, def *lsv1alpha1.DataImport) (*DataObject, error) {
+	contributions := make(map[string]interface{}, len(doList.Items))
+	for i := range doList.Items {
+		do := &doList.Items[i]
+
+		key, ok := do.GetLabels()[lsv1alpha1.DataObjectAggregationKeyLabel]
+		if !ok {
+			return nil, fmt.Errorf("data object %s is missing the label for the aggregation key", do.Name)
+		}
+		if _, ok := contributions[key]; ok {
+			return nil, fmt.Errorf("aggregation key %q of data object %s is contributed multiple times", key, do.Name)
+		}
+
+		var data interface{}
+		if err := yaml.Unmarshal(do.Data.RawMessage, &data); err != nil {
+			return nil, fmt.Errorf("error while decoding data object %s: %w", do.Name, err)
+		}
+		contributions[key] = data
+	}
+
+	var aggregated interface{} = contributions
+	if def.Aggregated != nil && def.Aggregated.Format == lsv1alpha1.AggregationFormatList {
+		keys := make([]string, 0, len(contributions))
+		for key := range contributions {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		list := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			list = append(list, contributions[key])
+		}
+		aggregated = list
+	}
+
+	raw := &lsv1alpha1.DataObject{}
+	var err error
+	raw.Data.RawMessage, err = json.Marshal(aggregated)
+	if err != nil {
+		return nil, err
+	}
+
+	do, err := NewFromDataObject(raw)
+	if err != nil {
+		return nil, err
+	}
+	do.Def = def
+	return do, nil
+}
@@ -36,15 +36,16 @@ type DataObject struct {
 // Metadata describes the metadata of a data object.
 // This metadata is also represented as annotations/labels at the object.
 type Metadata struct {
-	Namespace    string
-	Context      string
-	SourceType   lsv1alpha1.DataObjectSourceType
-	Source       string
-	Key          string
-	Hash         string
-	Index        *int
-	TargetMapKey *string
-	JobID        string
+	Namespace      string
+	Context        string
+	SourceType     lsv1alpha1.DataObjectSourceType
+	Source         string
+	Key            string
+	Hash           string
+	Index          *int
+	TargetMapKey   *string
+	AggregationKey *string
+	JobID          string
 }
 
 // generateHash returns the internal data generation function for dataobjects or targets.
@@ -98,6 +99,9 @@ func GetMetadataFromObject(objAcc metav1.Object, data []byte) Metadata {
 		if targetMapKey, ok := labels[lsv1alpha1.DataObjectTargetMapKeyLabel]; ok {
 			meta.TargetMapKey = &targetMapKey
 		}
+		if aggregationKey, ok := labels[lsv1alpha1.DataObjectAggregationKeyLabel]; ok {
+			meta.AggregationKey = &aggregationKey
+		}
 		if jobID, ok := labels[lsv1alpha1.DataObjectJobIDLabel]; ok {
 			meta.JobID = jobID
 		}
@@ -147,6 +151,11 @@ func SetMetadataFromObject(objAcc metav1.Object, meta Metadata) {
 	} else {
 		delete(labels, lsv1alpha1.DataObjectTargetMapKeyLabel)
 	}
+	if meta.AggregationKey != nil {
+		labels[lsv1alpha1.DataObjectAggregationKeyLabel] = *meta.AggregationKey
+	} else {
+		delete(labels, lsv1alpha1.DataObjectAggregationKeyLabel)
+	}
 	if len(meta.JobID) != 0 {
 		labels[lsv1alpha1.DataObjectJobIDLabel] = meta.JobID
 	} else {
@@ -211,13 +220,27 @@ func (do *DataObject) SetKey(key string) *DataObject {
 	return do
 }
 
+// SetAggregationKey sets the key under which the given data object contributes to an aggregated data object.
+func (do *DataObject) SetAggregationKey(key string) *DataObject {
+	do.Metadata.AggregationKey = &key
+	return do
+}
+
+// generateName returns the name of the in-cluster data object.
+func (do DataObject) generateName() string {
+	if do.Metadata.AggregationKey != nil {
+		return lsv1alpha1helper.GenerateDataObjectNameWithAggregationKey(do.Metadata.Context, do.Metadata.Key, *do.Metadata.AggregationKey)
+	}
+	return lsv1alpha1helper.GenerateDataObjectName(do.Metadata.Context, do.Metadata.Key)
+}
+
 // Build creates a new data object based on the given data and metadata.
 func (do DataObject) Build() (*lsv1alpha1.DataObject, error) {
 	var (
 		raw = &lsv1alpha1.DataObject{}
 		err error
 	)
-	raw.Name = lsv1alpha1helper.GenerateDataObjectName(do.Metadata.Context, do.Metadata.Key)
+	raw.Name = do.generateName()
 	raw.Namespace = do.Metadata.Namespace
 	raw.Data.RawMessage, err = json.MarshalIndent(do.Data, "", "  ")
 	if err != nil {
@@ -233,7 +256,7 @@ func (do DataObject) Apply(raw *lsv1alpha1.DataObject) error {
 	var (
 		err error
 	)
-	raw.Name = lsv1alpha1helper.GenerateDataObjectName(do.Metadata.Context, do.Metadata.Key)
+	raw.Name = do.generateName()
 	raw.Namespace = do.Metadata.Namespace
 	raw.Data.RawMessage, err = json.MarshalIndent(do.Data, "", "  ")
 	if err != nil {
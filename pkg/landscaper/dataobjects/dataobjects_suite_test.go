@@ -11,6 +11,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 )
 
@@ -40,3 +42,73 @@ var _ = Describe("Targets", func() {
 	})
 
 })
+
+var _ = Describe("Aggregated DataObjects", func() {
+
+	contribution := func(key string, data string) lsv1alpha1.DataObject {
+		do := lsv1alpha1.DataObject{}
+		do.Name = key
+		do.Labels = map[string]string{lsv1alpha1.DataObjectAggregationKeyLabel: key}
+		do.Data.RawMessage = []byte(data)
+		return do
+	}
+
+	It("should merge all contributions into a map", func() {
+		doList := &lsv1alpha1.DataObjectList{Items: []lsv1alpha1.DataObject{
+			contribution("b", `{"name":"b"}`),
+			contribution("a", `{"name":"a"}`),
+		}}
+		do, err := NewAggregatedDataObjectFromList(doList, &lsv1alpha1.DataImport{Name: "imp", DataRef: "agg"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(do.Data).To(Equal(map[string]interface{}{
+			"a": map[string]interface{}{"name": "a"},
+			"b": map[string]interface{}{"name": "b"},
+		}))
+		Expect(do.Metadata.Hash).NotTo(BeEmpty())
+		Expect(do.GetImportReference()).To(Equal("agg"))
+	})
+
+	It("should merge all contributions into a list sorted by the aggregation key", func() {
+		doList := &lsv1alpha1.DataObjectList{Items: []lsv1alpha1.DataObject{
+			contribution("b", `"valB"`),
+			contribution("a", `"valA"`),
+			contribution("c", `"valC"`),
+		}}
+		do, err := NewAggregatedDataObjectFromList(doList, &lsv1alpha1.DataImport{
+			Name:       "imp",
+			DataRef:    "agg",
+			Aggregated: &lsv1alpha1.DataImportAggregation{Format: lsv1alpha1.AggregationFormatList},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(do.Data).To(Equal([]interface{}{"valA", "valB", "valC"}))
+	})
+
+	It("should compute the same hash independent of the order of the contributions", func() {
+		do1, err := NewAggregatedDataObjectFromList(&lsv1alpha1.DataObjectList{Items: []lsv1alpha1.DataObject{
+			contribution("a", `1`), contribution("b", `2`),
+		}}, &lsv1alpha1.DataImport{})
+		Expect(err).NotTo(HaveOccurred())
+		do2, err := NewAggregatedDataObjectFromList(&lsv1alpha1.DataObjectList{Items: []lsv1alpha1.DataObject{
+			contribution("b", `2`), contribution("a", `1`),
+		}}, &lsv1alpha1.DataImport{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(do1.ComputeConfigGeneration()).To(Equal(do2.ComputeConfigGeneration()))
+	})
+
+	It("should fail if a contribution has no aggregation key", func() {
+		do := lsv1alpha1.DataObject{ObjectMeta: metav1.ObjectMeta{Name: "x"}}
+		do.Data.RawMessage = []byte(`1`)
+		_, err := NewAggregatedDataObjectFromList(&lsv1alpha1.DataObjectList{Items: []lsv1alpha1.DataObject{do}}, &lsv1alpha1.DataImport{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should name contributions by data reference and aggregation key", func() {
+		do1, err := New().SetContext("ctx").SetKey("agg").SetAggregationKey("a").SetData(1).Build()
+		Expect(err).NotTo(HaveOccurred())
+		do2, err := New().SetContext("ctx").SetKey("agg").SetAggregationKey("b").SetData(1).Build()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(do1.Name).NotTo(Equal(do2.Name))
+		Expect(do1.Labels).To(HaveKeyWithValue(lsv1alpha1.DataObjectAggregationKeyLabel, "a"))
+	})
+
+})
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+)
+
+// pendingDeployItemChange describes the change of one deploy item. It is the input for the digest of a pending change.
+type pendingDeployItemChange struct {
+	Name          string                      `json:"name"`
+	Deleted       bool                        `json:"deleted,omitempty"`
+	Type          lsv1alpha1.DeployItemType   `json:"type,omitempty"`
+	Target        *lsv1alpha1.ObjectReference `json:"target,omitempty"`
+	Configuration interface{}                 `json:"config,omitempty"`
+}
+
+// GetPendingApproval determines the change of the deploy items which the current job of the execution would apply.
+// It returns nil if the deploy items are already up to date.
+func (o *Operation) GetPendingApproval(ctx context.Context, deployItemCache *lsv1alpha1.DeployItemCache) (*lsv1alpha1.PendingApproval, lserrors.LsError) {
+	op := "GetPendingApproval"
+
+	items, orphaned, lsErr := o.getDeployItems(ctx, deployItemCache)
+	if lsErr != nil {
+		return nil, lsErr
+	}
+
+	pendingApproval, err := computePendingApproval(items, orphaned)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, op, "ComputePendingApproval", err.Error())
+	}
+	return pendingApproval, nil
+}
+
+// IsApproved returns whether the given pending change has been approved by the approve annotation of the execution.
+func IsApproved(exec *lsv1alpha1.Execution, pendingApproval *lsv1alpha1.PendingApproval) bool {
+	if pendingApproval == nil {
+		return true
+	}
+	digest, ok := exec.GetAnnotations()[lsv1alpha1.ApproveAnnotation]
+	return ok && digest == pendingApproval.Digest
+}
+
+// computePendingApproval compares the deploy item templates with the existing deploy items. The deploy items which
+// are created, updated or deleted are combined into a pending change, identified by a digest of the change.
+func computePendingApproval(items []*executionItem, orphaned []*lsv1alpha1.DeployItem) (*lsv1alpha1.PendingApproval, error) {
+	changes := []pendingDeployItemChange{}
+
+	for _, item := range items {
+		configuration, err := decodeConfiguration(item.Info.Configuration)
+		if err != nil {
+			return nil, err
+		}
+
+		if item.DeployItem != nil && item.DeployItem.DeletionTimestamp.IsZero() {
+			existingConfiguration, err := decodeConfiguration(item.DeployItem.Spec.Configuration)
+			if err != nil {
+				return nil, err
+			}
+
+			if item.DeployItem.Spec.Type == item.Info.Type &&
+				reflect.DeepEqual(item.DeployItem.Spec.Target, item.Info.Target) &&
+				reflect.DeepEqual(existingConfiguration, configuration) {
+				continue
+			}
+		}
+
+		changes = append(changes, pendingDeployItemChange{
+			Name:          item.Info.Name,
+			Type:          item.Info.Type,
+			Target:        item.Info.Target,
+			Configuration: configuration,
+		})
+	}
+
+	for _, di := range orphaned {
+		name := di.GetLabels()[lsv1alpha1.ExecutionManagedNameLabel]
+		if len(name) == 0 {
+			name = di.GetName()
+		}
+		changes = append(changes, pendingDeployItemChange{
+			Name:    name,
+			Deleted: true,
+		})
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+
+	// the configurations are decoded, so that the encoding is canonical
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(data)
+
+	pendingApproval := &lsv1alpha1.PendingApproval{
+		Digest: hex.EncodeToString(hash[:]),
+	}
+	for _, change := range changes {
+		pendingApproval.DeployItems = append(pendingApproval.DeployItems, change.Name)
+	}
+	return pendingApproval, nil
+}
+
+func decodeConfiguration(raw *runtime.RawExtension) (interface{}, error) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil, nil
+	}
+
+	var configuration interface{}
+	if err := json.Unmarshal(raw.Raw, &configuration); err != nil {
+		return nil, err
+	}
+	return configuration, nil
+}
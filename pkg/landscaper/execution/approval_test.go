@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Approval", func() {
+
+	buildExecutionItem := func(name, templateConfig, deployedConfig string) *executionItem {
+		item := &executionItem{
+			Info: lsv1alpha1.DeployItemTemplate{
+				Name:          name,
+				Type:          "landscaper.gardener.cloud/mock",
+				Configuration: &runtime.RawExtension{Raw: []byte(templateConfig)},
+			},
+		}
+		if len(deployedConfig) > 0 {
+			item.DeployItem = &lsv1alpha1.DeployItem{
+				ObjectMeta: metav1.ObjectMeta{Name: name + "-xyz"},
+				Spec: lsv1alpha1.DeployItemSpec{
+					Type:          "landscaper.gardener.cloud/mock",
+					Configuration: &runtime.RawExtension{Raw: []byte(deployedConfig)},
+				},
+			}
+		}
+		return item
+	}
+
+	It("should not require an approval if the deploy items are up to date", func() {
+		items := []*executionItem{
+			buildExecutionItem("a", `{"x": 1, "y": 2}`, `{"y":2,"x":1}`),
+		}
+
+		pendingApproval, err := computePendingApproval(items, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pendingApproval).To(BeNil())
+	})
+
+	It("should list the created, updated and deleted deploy items", func() {
+		items := []*executionItem{
+			buildExecutionItem("a", `{"x": 1}`, `{"x": 1}`),
+			buildExecutionItem("b", `{"x": 2}`, `{"x": 1}`),
+			buildExecutionItem("c", `{"x": 1}`, ""),
+		}
+		orphaned := []*lsv1alpha1.DeployItem{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "d-xyz",
+					Labels: map[string]string{lsv1alpha1.ExecutionManagedNameLabel: "d"},
+				},
+			},
+		}
+
+		pendingApproval, err := computePendingApproval(items, orphaned)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pendingApproval).NotTo(BeNil())
+		Expect(pendingApproval.Digest).NotTo(BeEmpty())
+		Expect(pendingApproval.DeployItems).To(Equal([]string{"b", "c", "d"}))
+	})
+
+	It("should compute a digest which identifies the change", func() {
+		pendingApproval1, err := computePendingApproval([]*executionItem{buildExecutionItem("a", `{"x": 2}`, `{"x": 1}`)}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		pendingApproval2, err := computePendingApproval([]*executionItem{buildExecutionItem("a", `{"x": 2}`, `{"x": 0}`)}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		pendingApproval3, err := computePendingApproval([]*executionItem{buildExecutionItem("a", `{"x": 3}`, `{"x": 1}`)}, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(pendingApproval1.Digest).To(Equal(pendingApproval2.Digest))
+		Expect(pendingApproval1.Digest).NotTo(Equal(pendingApproval3.Digest))
+	})
+
+	It("should only accept an approve annotation with the digest of the pending change", func() {
+		exec := &lsv1alpha1.Execution{}
+		pendingApproval := &lsv1alpha1.PendingApproval{Digest: "abc"}
+
+		Expect(IsApproved(exec, nil)).To(BeTrue())
+		Expect(IsApproved(exec, pendingApproval)).To(BeFalse())
+
+		metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.ApproveAnnotation, "xyz")
+		Expect(IsApproved(exec, pendingApproval)).To(BeFalse())
+
+		metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.ApproveAnnotation, "abc")
+		Expect(IsApproved(exec, pendingApproval)).To(BeTrue())
+	})
+})
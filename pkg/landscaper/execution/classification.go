@@ -2,9 +2,15 @@ package execution
 
 import (
 	"fmt"
+	"reflect"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	"github.com/openmcp-project/landscaper/pkg/utils/retry"
+	"github.com/openmcp-project/landscaper/pkg/utils/rollout"
 )
 
 // DeployItemClassification divides all the deploy items of an execution into the following classes.
@@ -12,14 +18,20 @@ import (
 // - running items:   they have the same jobID as the execution, but are unfinished
 // - succeeded items: they have the same jobID as the execution, are finished and succeeded
 // - failed items:    they have the same jobID as the execution, are finished and not succeeded (=> failed)
+// - retrying items:  they have the same jobID as the execution, have failed and wait for a retry according to their retry policy
 // - runnableItems:   they have an old jobID, which can be updated because there are no pending dependencies
 // - pending items:   they have an old jobID, which can not be updated because of pending dependencies
+// - waiting items:   they have an old jobID, which can not be updated because their wave of the rollout is not yet released
+// - queued items:    they have an old jobID, which can not be updated because the maximum number of parallel items is reached
 type DeployItemClassification struct {
 	runningItems   []*executionItem
 	succeededItems []*executionItem
 	failedItems    []*executionItem
+	retryingItems  []*executionItem
 	runnableItems  []*executionItem
 	pendingItems   []*executionItem
+	waitingItems   []*executionItem
+	queuedItems    []*executionItem
 }
 
 func (c *DeployItemClassification) HasRunningItems() bool {
@@ -34,6 +46,10 @@ func (c *DeployItemClassification) HasFailedItems() bool {
 	return len(c.failedItems) > 0
 }
 
+func (c *DeployItemClassification) HasRetryingItems() bool {
+	return len(c.retryingItems) > 0
+}
+
 func (c *DeployItemClassification) HasRunnableItems() bool {
 	return len(c.runnableItems) > 0
 }
@@ -42,21 +58,44 @@ func (c *DeployItemClassification) HasPendingItems() bool {
 	return len(c.pendingItems) > 0
 }
 
+func (c *DeployItemClassification) HasWaitingItems() bool {
+	return len(c.waitingItems) > 0
+}
+
+func (c *DeployItemClassification) HasQueuedItems() bool {
+	return len(c.queuedItems) > 0
+}
+
 func (c *DeployItemClassification) AllSucceeded() bool {
-	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems()
+	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRetryingItems() && !c.HasRunnableItems() &&
+		!c.HasPendingItems() && !c.HasWaitingItems() && !c.HasQueuedItems()
 }
 
 func (c *DeployItemClassification) GetRunnableItems() []*executionItem {
 	return c.runnableItems
 }
 
+// GetFailureCodes returns the error codes describing the failures of the failed items.
+func (c *DeployItemClassification) GetFailureCodes() []lsv1alpha1.ErrorCode {
+	errs := []*lsv1alpha1.Error{}
+	for _, item := range c.failedItems {
+		if item.DeployItem != nil {
+			errs = append(errs, item.DeployItem.Status.GetLastError())
+		}
+	}
+	return retry.FailureCodes(errs...)
+}
+
 func newDeployItemClassification(executionJobID string, items []*executionItem) (*DeployItemClassification, lserrors.LsError) {
 	c := &DeployItemClassification{
 		runningItems:   []*executionItem{},
 		succeededItems: []*executionItem{},
 		failedItems:    []*executionItem{},
+		retryingItems:  []*executionItem{},
 		runnableItems:  []*executionItem{},
 		pendingItems:   []*executionItem{},
+		waitingItems:   []*executionItem{},
+		queuedItems:    []*executionItem{},
 	}
 
 	for i := range items {
@@ -92,6 +131,121 @@ func newDeployItemClassification(executionJobID string, items []*executionItem)
 	return c, nil
 }
 
+// applyRollout moves the runnable items of rollout groups which are not yet released into the class of waiting items.
+// It returns the updated rollout status.
+func (c *DeployItemClassification) applyRollout(policy *lsv1alpha1.RolloutPolicy, executionJobID string,
+	items []*executionItem, status *lsv1alpha1.RolloutStatus, now time.Time) *lsv1alpha1.RolloutStatus {
+
+	groups := []rollout.Group{}
+	groupIndex := map[string]int{}
+	for _, item := range items {
+		name := item.Info.RolloutGroup
+		if len(name) == 0 {
+			continue
+		}
+		if _, ok := groupIndex[name]; !ok {
+			groupIndex[name] = len(groups)
+			groups = append(groups, rollout.Group{Name: name})
+		}
+	}
+
+	for _, item := range c.runningItems {
+		if i, ok := groupIndex[item.Info.RolloutGroup]; ok {
+			groups[i].Active = true
+		}
+	}
+	for _, item := range c.runnableItems {
+		if i, ok := groupIndex[item.Info.RolloutGroup]; ok {
+			groups[i].Active = true
+		}
+	}
+	for _, item := range c.retryingItems {
+		if i, ok := groupIndex[item.Info.RolloutGroup]; ok {
+			groups[i].Active = true
+		}
+	}
+	for _, item := range c.failedItems {
+		if i, ok := groupIndex[item.Info.RolloutGroup]; ok {
+			groups[i].Failed = true
+		}
+	}
+
+	status, released := rollout.Plan(policy, executionJobID, groups, status, now)
+
+	runnableItems := []*executionItem{}
+	for _, item := range c.runnableItems {
+		if len(item.Info.RolloutGroup) != 0 && !released.Has(item.Info.RolloutGroup) {
+			c.waitingItems = append(c.waitingItems, item)
+		} else {
+			runnableItems = append(runnableItems, item)
+		}
+	}
+	c.runnableItems = runnableItems
+
+	return status
+}
+
+// applyRetries moves the failed items, which are retried according to the retry policies of their templates, into
+// the class of retrying items or, if the retry is due, into the class of running items. The retry status of the
+// deploy items is updated in memory. It returns the items whose retry is due, and the items whose retry status has
+// changed.
+func (c *DeployItemClassification) applyRetries(now time.Time) (dueItems, updatedItems []*executionItem) {
+	dueItems = []*executionItem{}
+	updatedItems = []*executionItem{}
+	failedItems := []*executionItem{}
+
+	for _, item := range c.failedItems {
+		if item.DeployItem == nil || item.Info.RetryPolicy == nil {
+			failedItems = append(failedItems, item)
+			continue
+		}
+
+		di := item.DeployItem
+		status, start := retry.Plan(item.Info.RetryPolicy, di.Status.Retry, di.GetGeneration(),
+			retry.FailureCodes(di.Status.GetLastError()), now)
+		if !reflect.DeepEqual(status, di.Status.Retry) {
+			di.Status.Retry = status
+			updatedItems = append(updatedItems, item)
+		}
+
+		if start {
+			dueItems = append(dueItems, item)
+			c.runningItems = append(c.runningItems, item)
+		} else if status.NextRetryTime != nil {
+			c.retryingItems = append(c.retryingItems, item)
+		} else {
+			failedItems = append(failedItems, item)
+		}
+	}
+	c.failedItems = failedItems
+
+	return dueItems, updatedItems
+}
+
+// nextRetryTime returns the time of the next scheduled retry of the retrying items.
+func (c *DeployItemClassification) nextRetryTime() *metav1.Time {
+	var nextRetryTime *metav1.Time
+	for _, item := range c.retryingItems {
+		status := item.DeployItem.Status.Retry
+		if nextRetryTime == nil || status.NextRetryTime.Before(nextRetryTime) {
+			nextRetryTime = status.NextRetryTime
+		}
+	}
+	return nextRetryTime
+}
+
+// applyMaxParallel moves the runnable items into the class of queued items, which would exceed the maximum number
+// of running items. The runnable items are started in the order of the deploy item templates.
+func (c *DeployItemClassification) applyMaxParallel(maxParallel int) {
+	free := max(maxParallel-len(c.runningItems), 0)
+	if len(c.runnableItems) <= free {
+		return
+	}
+
+	c.queuedItems = append(c.queuedItems, c.runnableItems[free:]...)
+	c.runnableItems = c.runnableItems[:free]
+}
+
 func isItemRunnable(executionJobID string, item *executionItem, items []*executionItem) (bool, lserrors.LsError) {
 	if len(item.Info.DependsOn) == 0 {
 		return true, nil
@@ -104,8 +258,10 @@ func isItemRunnable(executionJobID string, item *executionItem, items []*executi
 				fmt.Sprintf("dependent deployitem %s of deployitem %s not found", dependentItemName, item.Info.Name))
 		}
 
-		// check that the dependentItem has finished the current job
-		if dependentItem.DeployItem == nil || dependentItem.DeployItem.Status.JobIDFinished != executionJobID {
+		// check that the dependentItem has successfully finished the current job. The dependents of a failed or
+		// retrying item remain pending, even if the rollout continues on failure.
+		if dependentItem.DeployItem == nil || dependentItem.DeployItem.Status.JobIDFinished != executionJobID ||
+			dependentItem.DeployItem.Status.Phase != lsv1alpha1.DeployItemPhases.Succeeded {
 			return false, nil
 		}
 	}
@@ -127,8 +283,11 @@ func newDeployItemClassificationForDelete(executionJobID string, items []*execut
 		runningItems:   []*executionItem{},
 		succeededItems: []*executionItem{},
 		failedItems:    []*executionItem{},
+		retryingItems:  []*executionItem{},
 		runnableItems:  []*executionItem{},
 		pendingItems:   []*executionItem{},
+		waitingItems:   []*executionItem{},
+		queuedItems:    []*executionItem{},
 	}
 
 	for i := range items {
@@ -5,9 +5,12 @@
 package execution
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 )
@@ -84,6 +87,23 @@ var _ = Describe("DeployItem Classification", func() {
 		Expect(classification.pendingItems).To(ConsistOf(items[5], items[6]))
 	})
 
+	It("should not run the dependents of a failed item", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Failed),
+			buildExecutionItem("b", []string{"a"}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("c", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(classification.failedItems).To(ConsistOf(items[0]))
+		Expect(classification.runnableItems).To(ConsistOf(items[2]))
+		Expect(classification.pendingItems).To(ConsistOf(items[1]))
+	})
+
 	It("should classify execution items for delete", func() {
 		currJobID := "02"
 		prevJobID := "01"
@@ -112,4 +132,109 @@ var _ = Describe("DeployItem Classification", func() {
 		Expect(classification.runnableItems).To(ConsistOf(items[3], items[4]))
 		Expect(classification.pendingItems).To(ConsistOf(items[5], items[6]))
 	})
+
+	It("should hold back the runnable items of rollout groups which are not yet released", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("b", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("c", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("d", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+		items[0].Info.RolloutGroup = "clusters[a]"
+		items[1].Info.RolloutGroup = "clusters[b]"
+		items[2].Info.RolloutGroup = "clusters[c]"
+
+		policy := &lsv1alpha1.RolloutPolicy{BatchSize: ptr.To[int32](1)}
+		status := &lsv1alpha1.RolloutStatus{JobID: currJobID, CurrentWave: 1}
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+		status = classification.applyRollout(policy, currJobID, items, status, time.Now())
+
+		Expect(status.CurrentWave).To(Equal(int32(2)))
+		Expect(status.Waves).To(Equal(int32(3)))
+		Expect(classification.runnableItems).To(ConsistOf(items[1], items[3]))
+		Expect(classification.waitingItems).To(ConsistOf(items[2]))
+		Expect(classification.AllSucceeded()).To(BeFalse())
+	})
+
+	It("should queue the runnable items which exceed the maximum number of parallel items", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, prevJobID, lsv1alpha1.DeployItemPhases.Progressing),
+			buildExecutionItem("b", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("c", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("d", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("e", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+		classification.applyMaxParallel(2)
+
+		Expect(classification.runnableItems).To(ConsistOf(items[2]))
+		Expect(classification.queuedItems).To(ConsistOf(items[3], items[4]))
+		Expect(classification.AllSucceeded()).To(BeFalse())
+
+		classification.applyMaxParallel(1)
+		Expect(classification.runnableItems).To(BeEmpty())
+		Expect(classification.queuedItems).To(ConsistOf(items[2], items[3], items[4]))
+	})
+
+	It("should retry the failed items according to their retry policies", func() {
+		currJobID := "02"
+		now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		retryPolicy := &lsv1alpha1.RetryPolicy{
+			RetryOn: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout},
+			Backoff: &lsv1alpha1.RetryBackoff{JitterPercentage: ptr.To[int32](0)},
+		}
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Failed),
+			buildExecutionItem("b", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Failed),
+			buildExecutionItem("c", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Failed),
+			buildExecutionItem("d", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Failed),
+		}
+		for _, item := range items[:3] {
+			item.Info.RetryPolicy = retryPolicy
+			item.DeployItem.Status.LastError = &lsv1alpha1.Error{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout}}
+		}
+		items[1].DeployItem.Status.Retry = &lsv1alpha1.RetryStatus{NextRetryTime: ptr.To(metav1.NewTime(now.Add(-time.Second)))}
+		items[2].DeployItem.Status.LastError.Codes = []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem}
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+		dueItems, updatedItems := classification.applyRetries(now)
+
+		Expect(dueItems).To(ConsistOf(items[1]))
+		Expect(updatedItems).To(ConsistOf(items[0], items[1], items[2]))
+		Expect(classification.runningItems).To(ConsistOf(items[1]))
+		Expect(classification.retryingItems).To(ConsistOf(items[0]))
+		Expect(classification.failedItems).To(ConsistOf(items[2], items[3]))
+		Expect(classification.nextRetryTime().Time).To(Equal(now.Add(30 * time.Second)))
+		Expect(classification.GetFailureCodes()).To(ConsistOf(lsv1alpha1.ErrorConfigurationProblem))
+		Expect(items[1].DeployItem.Status.Retry.Attempts).To(Equal(int32(1)))
+	})
+
+	It("should not run the dependents of a retrying item", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Failed),
+			buildExecutionItem("b", []string{"a"}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+		items[0].Info.RetryPolicy = &lsv1alpha1.RetryPolicy{RetryOn: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout}}
+		items[0].DeployItem.Status.LastError = &lsv1alpha1.Error{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout}}
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+		classification.applyRetries(now)
+
+		Expect(classification.retryingItems).To(ConsistOf(items[0]))
+		Expect(classification.runnableItems).To(BeEmpty())
+		Expect(classification.pendingItems).To(ConsistOf(items[1]))
+	})
 })
@@ -7,6 +7,8 @@ package execution
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
 
@@ -16,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	"github.com/openmcp-project/landscaper/apis/config"
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
 	lserrors "github.com/openmcp-project/landscaper/apis/errors"
@@ -26,6 +29,7 @@ import (
 	"github.com/openmcp-project/landscaper/pkg/landscaper/operation"
 	"github.com/openmcp-project/landscaper/pkg/utils/clusters"
 	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+	"github.com/openmcp-project/landscaper/pkg/utils/rollout"
 )
 
 // Operation contains all execution operations
@@ -33,6 +37,9 @@ type Operation struct {
 	*operation.Operation
 	exec           *lsv1alpha1.Execution
 	forceReconcile bool
+
+	// DeletionSafeguard limits the number of orphaned deploy items which are deleted without confirmation.
+	DeletionSafeguard *config.DeletionSafeguard
 }
 
 // NewOperation creates a new execution operations
@@ -52,6 +59,10 @@ func (o *Operation) UpdateDeployItems(ctx context.Context, deployItemCache *lsv1
 		return lsErr
 	}
 
+	if lsErr := o.checkDeletionSafeguard(ctx, executionItems, orphaned); lsErr != nil {
+		return lsErr
+	}
+
 	if err := o.cleanupOrphanedDeployItemsForNewReconcile(ctx, orphaned); err != nil {
 		return lserrors.NewWrappedError(err, op, "CleanupOrphanedDeployItems", err.Error())
 	}
@@ -124,8 +135,30 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 		return nil, lsErr
 	}
 
-	// Start the runnable items, provided there are no failed items
-	if !classification.HasFailedItems() {
+	// Retry the failed items according to the retry policies of their templates
+	dueItems, updatedItems := classification.applyRetries(time.Now())
+	for _, item := range updatedItems {
+		if err := o.updateDeployItemRetryStatus(ctx, item.DeployItem, slices.Contains(dueItems, item)); err != nil {
+			return nil, err
+		}
+	}
+	o.exec.Status.NextRetryTime = classification.nextRetryTime()
+
+	// Release the items of the rollout groups wave by wave
+	if o.exec.Spec.Rollout == nil {
+		o.exec.Status.Rollout = nil
+	} else {
+		o.exec.Status.Rollout = classification.applyRollout(o.exec.Spec.Rollout, o.exec.Status.JobID, items,
+			o.exec.Status.Rollout, time.Now())
+	}
+
+	// Limit the number of deploy items which are processed in parallel
+	if o.exec.Spec.MaxParallel != nil {
+		classification.applyMaxParallel(int(*o.exec.Spec.MaxParallel))
+	}
+
+	// Start the runnable items, provided there are no failed or retrying items or the rollout continues on failure
+	if (!classification.HasFailedItems() && !classification.HasRetryingItems()) || !rollout.StopOnFailure(o.exec.Spec.Rollout) {
 		runnableItems := classification.GetRunnableItems()
 		for _, item := range runnableItems {
 			if err := o.triggerDeployItem(ctx, item.DeployItem, read_write_layer.W000056); err != nil {
@@ -200,12 +233,41 @@ func (o *Operation) triggerDeployItem(ctx context.Context, di *lsv1alpha1.Deploy
 	di.Status.TransitionTimes = utils.NewTransitionTimes()
 	now := metav1.Now()
 	di.Status.JobIDGenerationTime = &now
+	di.Status.Retry = nil
 	if err := o.WriterToLsUncachedClient().UpdateDeployItemStatus(ctx, writeId, di); err != nil {
 		return lserrors.NewWrappedError(err, op, "UpdateDeployItemStatus", err.Error())
 	}
 
 	return nil
 }
+
+// updateDeployItemRetryStatus writes the retry status of a failed deploy item. If the retry is due, the deploy item
+// is processed again by its deployer with the current job ID of the execution.
+func (o *Operation) updateDeployItemRetryStatus(ctx context.Context, di *lsv1alpha1.DeployItem, restart bool) lserrors.LsError {
+	op := "UpdateDeployItemRetryStatus"
+
+	retryStatus := di.Status.Retry
+
+	key := kutil.ObjectKeyFromObject(di)
+	di = &lsv1alpha1.DeployItem{}
+	if err := read_write_layer.GetDeployItem(ctx, o.LsUncachedClient(), key, di, read_write_layer.R000127); err != nil {
+		return lserrors.NewWrappedError(err, op, "GetDeployItem", err.Error())
+	}
+
+	di.Status.Retry = retryStatus
+	if restart {
+		di.Status.JobIDFinished = ""
+		di.Status.TransitionTimes = utils.NewTransitionTimes()
+		now := metav1.Now()
+		di.Status.JobIDGenerationTime = &now
+	}
+	if err := o.WriterToLsUncachedClient().UpdateDeployItemStatus(ctx, read_write_layer.W000171, di); err != nil {
+		return lserrors.NewWrappedError(err, op, "UpdateDeployItemStatus", err.Error())
+	}
+
+	return nil
+}
+
 func (o *Operation) skipUninstall(ctx context.Context, di *lsv1alpha1.DeployItem) (bool, lserrors.LsError) {
 	op := "skipUninstall"
 
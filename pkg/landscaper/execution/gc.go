@@ -13,8 +13,58 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+	"github.com/openmcp-project/landscaper/pkg/utils/deletion"
 )
 
+// checkDeletionSafeguard returns an error if the deletion of the orphaned deploy items exceeds the deletion safeguard
+// and has not been confirmed. Orphaned deploy items which are already being deleted are not counted.
+// A confirmation annotation which has been used is removed.
+func (o *Operation) checkDeletionSafeguard(ctx context.Context, items []*executionItem,
+	orphaned []*lsv1alpha1.DeployItem) lserrors.LsError {
+	op := "CheckDeletionSafeguard"
+
+	o.exec.Status.PendingDeletion = nil
+
+	newlyOrphaned := []string{}
+	for _, item := range orphaned {
+		if item.DeletionTimestamp.IsZero() {
+			newlyOrphaned = append(newlyOrphaned, item.Name)
+		}
+	}
+
+	total := len(orphaned)
+	for _, item := range items {
+		if item.DeployItem != nil {
+			total++
+		}
+	}
+
+	if !deletion.ExceedsSafeguard(o.DeletionSafeguard, len(newlyOrphaned), total) {
+		return nil
+	}
+
+	pendingDeletion := deletion.NewPendingDeletion(newlyOrphaned, total)
+	if !deletion.IsConfirmed(o.exec, pendingDeletion) {
+		o.exec.Status.PendingDeletion = pendingDeletion
+		return deletion.NewNotConfirmedError(op, utils.DeployItemKind, pendingDeletion)
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	logger.Info("deletion of orphaned deploy items confirmed", "digest", pendingDeletion.Digest)
+
+	// the update of the execution overwrites the status with the stored one; it is restored afterwards
+	status := o.exec.Status.DeepCopy()
+	delete(o.exec.Annotations, lsv1alpha1.ConfirmDeletionAnnotation)
+	if err := o.WriterToLsUncachedClient().UpdateExecution(ctx, read_write_layer.W000173, o.exec); err != nil {
+		return lserrors.NewWrappedError(err, op, "RemoveConfirmDeletionAnnotation", err.Error())
+	}
+	o.exec.Status = *status
+	return nil
+}
+
 // cleanupOrphanedDeployItemsForNewReconcile deletes all orphaned deploy items that are not defined by their execution anymore.
 func (o *Operation) cleanupOrphanedDeployItemsForNewReconcile(ctx context.Context, orphaned []*lsv1alpha1.DeployItem) error {
 	if len(orphaned) == 0 {
@@ -27,6 +27,7 @@ func ApplyDeployItemTemplate(di *lsv1alpha1.DeployItem, tmpl lsv1alpha1.DeployIt
 	di.Spec.Configuration = tmpl.Configuration
 	di.Spec.Timeout = tmpl.Timeout
 	di.Spec.UpdateOnChangeOnly = tmpl.UpdateOnChangeOnly
+	di.Spec.ReconcileOnTargetChange = tmpl.ReconcileOnTargetChange
 	di.Spec.OnDelete = tmpl.OnDelete
 	for k, v := range tmpl.Labels {
 		kutil.SetMetaDataLabel(&di.ObjectMeta, k, v)
@@ -55,14 +56,21 @@ func getDeployItemIndexByManagedName(items []*lsv1alpha1.DeployItem, name string
 // The managed execution is identified by the managed by label and the ownership.
 func (o *Operation) ListManagedDeployItems(ctx context.Context, readID read_write_layer.ReadID,
 	deployItemCache *lsv1alpha1.DeployItemCache) ([]*lsv1alpha1.DeployItem, error) {
+	return ListManagedDeployItems(ctx, o.LsUncachedClient(), o.exec, readID, deployItemCache)
+}
+
+// ListManagedDeployItems collects all deploy items that are managed by the given execution. If a deploy item cache is
+// given, the deploy items are fetched by the names in the cache, otherwise they are listed by the managed by label.
+func ListManagedDeployItems(ctx context.Context, c client.Client, exec *lsv1alpha1.Execution, readID read_write_layer.ReadID,
+	deployItemCache *lsv1alpha1.DeployItemCache) ([]*lsv1alpha1.DeployItem, error) {
 
 	deployItems := []*lsv1alpha1.DeployItem{}
 
 	if deployItemCache != nil {
 		for i := range deployItemCache.OrphanedDIs {
 			nextDi := &lsv1alpha1.DeployItem{}
-			key := client.ObjectKey{Namespace: o.exec.Namespace, Name: deployItemCache.OrphanedDIs[i]}
-			if err := read_write_layer.GetDeployItem(ctx, o.LsUncachedClient(), key, nextDi, readID); err != nil {
+			key := client.ObjectKey{Namespace: exec.Namespace, Name: deployItemCache.OrphanedDIs[i]}
+			if err := read_write_layer.GetDeployItem(ctx, c, key, nextDi, readID); err != nil {
 				if apierrors.IsNotFound(err) {
 					continue
 				}
@@ -73,8 +81,8 @@ func (o *Operation) ListManagedDeployItems(ctx context.Context, readID read_writ
 
 		for i := range deployItemCache.ActiveDIs {
 			nextDi := &lsv1alpha1.DeployItem{}
-			key := client.ObjectKey{Namespace: o.exec.Namespace, Name: deployItemCache.ActiveDIs[i].ObjectName}
-			if err := read_write_layer.GetDeployItem(ctx, o.LsUncachedClient(), key, nextDi, readID); err != nil {
+			key := client.ObjectKey{Namespace: exec.Namespace, Name: deployItemCache.ActiveDIs[i].ObjectName}
+			if err := read_write_layer.GetDeployItem(ctx, c, key, nextDi, readID); err != nil {
 				if apierrors.IsNotFound(err) {
 					continue
 				}
@@ -86,7 +94,7 @@ func (o *Operation) ListManagedDeployItems(ctx context.Context, readID read_writ
 		return deployItems, nil
 
 	} else {
-		deployItemList, err := read_write_layer.ListManagedDeployItems(ctx, o.LsUncachedClient(), client.ObjectKeyFromObject(o.exec), readID)
+		deployItemList, err := read_write_layer.ListManagedDeployItems(ctx, c, client.ObjectKeyFromObject(exec), readID)
 		if err != nil {
 			return nil, err
 		}
@@ -13,6 +13,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -57,6 +58,18 @@ func New(op *installations.Operation) *ExecutionOperation {
 
 func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 	inst *installations.InstallationImportsAndBlueprint) (core.DeployItemTemplateList, error) {
+	return o.renderDeployItemTemplates(ctx, inst, false)
+}
+
+// RenderTestDeployItemTemplates renders the templates of the test deploy items defined by the testExecutions of the
+// blueprint. It returns nil if the blueprint defines no tests.
+func (o *ExecutionOperation) RenderTestDeployItemTemplates(ctx context.Context,
+	inst *installations.InstallationImportsAndBlueprint) (core.DeployItemTemplateList, error) {
+	return o.renderDeployItemTemplates(ctx, inst, true)
+}
+
+func (o *ExecutionOperation) renderDeployItemTemplates(ctx context.Context,
+	inst *installations.InstallationImportsAndBlueprint, tests bool) (core.DeployItemTemplateList, error) {
 
 	op := "RenderDeployItemTemplates"
 
@@ -68,14 +81,23 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 	}
 	targetResolver := genericresolver.New(o.LsUncachedClient())
 	tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver))
-	executions, err := tmpl.TemplateDeployExecutions(
-		template.NewDeployExecutionOptions(
-			template.NewBlueprintExecutionOptions(
-				o.Context().External.InjectComponentDescriptorRef(inst.GetInstallation()),
-				inst.GetBlueprint(),
-				o.ComponentVersion,
-				o.ResolvedComponentDescriptorList,
-				inst.GetImports())))
+	opts := template.NewDeployExecutionOptions(
+		template.NewBlueprintExecutionOptions(
+			o.Context().External.InjectComponentDescriptorRef(inst.GetInstallation()),
+			inst.GetBlueprint(),
+			o.ComponentVersion,
+			o.ResolvedComponentDescriptorList,
+			inst.GetImports()))
+
+	var executions []template.DeployItemSpecification
+	var err error
+	fldPath := field.NewPath("deployExecutions")
+	if tests {
+		executions, err = tmpl.TemplateTestExecutions(opts)
+		fldPath = field.NewPath("testExecutions")
+	} else {
+		executions, err = tmpl.TemplateDeployExecutions(opts)
+	}
 
 	if err != nil {
 		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
@@ -92,6 +114,7 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 	execTemplates := make(core.DeployItemTemplateList, len(executions))
 	for i, elem := range executions {
 		var target *core.ObjectReference
+		var rolloutGroup string
 		if elem.Target != nil {
 			target = &core.ObjectReference{
 				Name:      elem.Target.Name,
@@ -109,6 +132,7 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 				rawTarget := ti.GetTargetExtensions()[*elem.Target.Index].GetTarget()
 				target.Name = rawTarget.Name
 				target.Namespace = rawTarget.Namespace
+				rolloutGroup = fmt.Sprintf("%s[%d]", elem.Target.Import, *elem.Target.Index)
 			} else if elem.Target.Key != nil {
 				// targetmap import
 				ti := o.GetTargetMapImport(elem.Target.Import)
@@ -122,6 +146,7 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 				rawTarget := targetExt.GetTarget()
 				target.Name = rawTarget.Name
 				target.Namespace = rawTarget.Namespace
+				rolloutGroup = fmt.Sprintf("%s[%s]", elem.Target.Import, *elem.Target.Key)
 			} else if len(elem.Target.Import) > 0 {
 				// single target import reference
 				t := o.GetTargetImport(elem.Target.Import)
@@ -152,10 +177,13 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 			Timeout:            timeout,
 			UpdateOnChangeOnly: elem.UpdateOnChangeOnly,
 			OnDelete:           elem.OnDelete,
+
+			ReconcileOnTargetChange: elem.ReconcileOnTargetChange || inst.GetInstallation().Spec.ReconcileOnTargetChange,
+			RolloutGroup:            rolloutGroup,
 		}
 	}
 
-	if err := validation.ValidateDeployItemTemplateList(field.NewPath("deployExecutions"), execTemplates).ToAggregate(); err != nil {
+	if err := validation.ValidateDeployItemTemplateList(fldPath, execTemplates).ToAggregate(); err != nil {
 		err2 := fmt.Errorf("error validating deployitem templates: %w", err)
 		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
 			TemplatingFailedReason, err2.Error()))
@@ -165,6 +193,15 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 	return execTemplates, nil
 }
 
+// requiresApproval returns whether changes of the deploy items of the installation have to be approved.
+// The setting of the installation takes precedence over the setting of its context.
+func (o *ExecutionOperation) requiresApproval(inst *lsv1alpha1.Installation) bool {
+	if inst.Spec.RequireApproval != nil {
+		return *inst.Spec.RequireApproval
+	}
+	return o.Context().External.RequireApproval
+}
+
 func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.InstallationImportsAndBlueprint) error {
 	execTemplates, err := o.RenderDeployItemTemplates(ctx, inst)
 	if execTemplates == nil || err != nil {
@@ -188,6 +225,12 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 	if _, err := o.WriterToLsUncachedClient().CreateOrUpdateExecution(ctx, read_write_layer.W000022, exec, func() error {
 		exec.Spec.Context = inst.GetInstallation().Spec.Context
 		exec.Spec.DeployItems = versionedDeployItemTemplateList
+		exec.Spec.Rollout = inst.GetInstallation().Spec.Rollout.DeepCopy()
+		exec.Spec.RequireApproval = o.requiresApproval(inst.GetInstallation())
+		exec.Spec.MaxParallel = nil
+		if inst.GetBlueprint().Info.MaxParallelDeployItems != nil {
+			exec.Spec.MaxParallel = ptr.To(*inst.GetBlueprint().Info.MaxParallelDeployItems)
+		}
 
 		if lsv1alpha1helper.HasOperation(inst.GetInstallation().ObjectMeta, lsv1alpha1.ForceReconcileOperation) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ForceReconcileOperation))
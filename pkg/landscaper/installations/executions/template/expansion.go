@@ -0,0 +1,360 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/openmcp-project/landscaper/apis/core"
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/apis/core/validation"
+	"github.com/openmcp-project/landscaper/pkg/utils/blueprints"
+)
+
+// eachPlaceholder matches the placeholders "$(each.key)", "$(each.value)" and "$(each.value.<path>)".
+var eachPlaceholder = regexp.MustCompile(`\$\(each\.(key|value)((?:\.[^.()\s]+)*)\)`)
+
+// forEachElement is an element over which a deploy item is expanded.
+type forEachElement struct {
+	key    interface{}
+	value  interface{}
+	target *TargetReference
+}
+
+// suffix returns the suffix of the name of the deploy item generated for the element.
+func (e forEachElement) suffix() string {
+	return fmt.Sprint(e.key)
+}
+
+// ExpandDeployItems evaluates the conditions and forEach expansions of the deploy items rendered by the deploy
+// executions of a blueprint.
+// Deploy items whose condition is false are omitted, and references to them are removed from the dependencies of
+// the other deploy items. A deploy item with forEach is replaced by one deploy item per element. A dependency on the
+// name of an expanded deploy item is replaced by dependencies on all generated deploy items; a dependency on a single
+// generated deploy item can be expressed with the placeholder "$(each.key)".
+func ExpandDeployItems(specs []DeployItemSpecification, blueprint *blueprints.Blueprint, values map[string]interface{}) ([]DeployItemSpecification, error) {
+	if !hasDeployItemExpansions(specs) {
+		return specs, nil
+	}
+	if err := validateDeployItemExpansions(specs); err != nil {
+		return nil, err
+	}
+
+	omitted := map[string]bool{}
+	generatedNames := map[string][]string{}
+	elements := make([][]forEachElement, len(specs))
+	for i, spec := range specs {
+		if spec.When != nil && !*spec.When {
+			omitted[spec.Name] = true
+			continue
+		}
+		if spec.ForEach == nil {
+			continue
+		}
+
+		elems, err := getForEachElements(spec, blueprint, values)
+		if err != nil {
+			return nil, fmt.Errorf("unable to expand deploy item %q: %w", spec.Name, err)
+		}
+		elements[i] = elems
+		generatedNames[spec.Name] = []string{}
+		for _, elem := range elems {
+			generatedNames[spec.Name] = append(generatedNames[spec.Name], spec.Name+"-"+elem.suffix())
+		}
+	}
+
+	result := []DeployItemSpecification{}
+	for i, spec := range specs {
+		if omitted[spec.Name] {
+			continue
+		}
+		spec.When = nil
+
+		if spec.ForEach == nil {
+			spec.DependsOn = expandDependencies(spec.DependsOn, omitted, generatedNames)
+			result = append(result, spec)
+			continue
+		}
+
+		for _, elem := range elements[i] {
+			generated, err := expandDeployItem(spec, elem)
+			if err != nil {
+				return nil, fmt.Errorf("unable to expand deploy item %q for element %q: %w", spec.Name, elem.suffix(), err)
+			}
+			generated.DependsOn = expandDependencies(generated.DependsOn, omitted, generatedNames)
+			result = append(result, generated)
+		}
+	}
+
+	return result, nil
+}
+
+func hasDeployItemExpansions(specs []DeployItemSpecification) bool {
+	for _, spec := range specs {
+		if spec.When != nil || spec.ForEach != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// validateDeployItemExpansions validates the conditions and forEach expansions of the deploy items.
+func validateDeployItemExpansions(specs []DeployItemSpecification) error {
+	list := make(core.DeployItemTemplateList, len(specs))
+	for i, spec := range specs {
+		list[i] = core.DeployItemTemplate{
+			Name:      spec.Name,
+			Type:      spec.Type,
+			DependsOn: spec.DependsOn,
+			When:      spec.When,
+			ForEach:   spec.ForEach,
+		}
+	}
+
+	allErrs := validation.ValidateDeployItemExpansions(field.NewPath("deployItems"), list)
+	for i, spec := range specs {
+		if spec.ForEach != nil && len(spec.ForEach.TargetImport) != 0 && spec.Target != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("deployItems").Index(i).Key(spec.Name).Child("target"),
+				"must not be set if forEach.targetImport is set"))
+		}
+	}
+
+	if err := allErrs.ToAggregate(); err != nil {
+		return fmt.Errorf("error validating deployitem expansions: %w", err)
+	}
+	return nil
+}
+
+// getForEachElements returns the elements over which a deploy item is expanded, sorted by their keys.
+func getForEachElements(spec DeployItemSpecification, blueprint *blueprints.Blueprint, values map[string]interface{}) ([]forEachElement, error) {
+	if len(spec.ForEach.TargetImport) != 0 {
+		return getTargetImportElements(spec.ForEach.TargetImport, blueprint, values)
+	}
+
+	var items interface{}
+	if err := json.Unmarshal(spec.ForEach.Items.RawMessage, &items); err != nil {
+		return nil, fmt.Errorf("unable to decode items: %w", err)
+	}
+
+	elements := []forEachElement{}
+	switch typedItems := items.(type) {
+	case []interface{}:
+		for i, item := range typedItems {
+			elements = append(elements, forEachElement{key: i, value: item})
+		}
+	case map[string]interface{}:
+		for _, key := range sortedKeys(typedItems) {
+			elements = append(elements, forEachElement{key: key, value: typedItems[key]})
+		}
+	}
+	return elements, nil
+}
+
+// getTargetImportElements returns the elements of a targetList or targetMap import.
+// An optional import which is not satisfied has no elements.
+func getTargetImportElements(importName string, blueprint *blueprints.Blueprint, values map[string]interface{}) ([]forEachElement, error) {
+	def := findImportDefinition(blueprint.Info.Imports, importName)
+	if def == nil {
+		return nil, fmt.Errorf("import %q is not defined by the blueprint", importName)
+	}
+
+	var imported interface{}
+	if imports, ok := values["imports"].(map[string]interface{}); ok {
+		imported = imports[importName]
+	}
+
+	elements := []forEachElement{}
+	switch def.Type {
+	case lsv1alpha1.ImportTypeTargetList:
+		list, _ := imported.([]interface{})
+		for i, target := range list {
+			elements = append(elements, forEachElement{
+				key:    i,
+				value:  target,
+				target: &TargetReference{Import: importName, Index: &i},
+			})
+		}
+	case lsv1alpha1.ImportTypeTargetMap:
+		targets, _ := imported.(map[string]interface{})
+		for _, key := range sortedKeys(targets) {
+			elements = append(elements, forEachElement{
+				key:    key,
+				value:  targets[key],
+				target: &TargetReference{Import: importName, Key: &key},
+			})
+		}
+	default:
+		return nil, fmt.Errorf("import %q is neither a targetList nor a targetMap import", importName)
+	}
+	return elements, nil
+}
+
+func findImportDefinition(defs lsv1alpha1.ImportDefinitionList, name string) *lsv1alpha1.ImportDefinition {
+	for i := range defs {
+		if defs[i].Name == name {
+			return &defs[i]
+		}
+		if def := findImportDefinition(defs[i].ConditionalImports, name); def != nil {
+			return def
+		}
+	}
+	return nil
+}
+
+// expandDeployItem generates the deploy item for an element by replacing the placeholders in the deploy item.
+func expandDeployItem(spec DeployItemSpecification, elem forEachElement) (DeployItemSpecification, error) {
+	spec.ForEach = nil
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return DeployItemSpecification{}, err
+	}
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return DeployItemSpecification{}, err
+	}
+
+	raw, err = replacePlaceholders(raw, elem)
+	if err != nil {
+		return DeployItemSpecification{}, err
+	}
+
+	data, err = json.Marshal(raw)
+	if err != nil {
+		return DeployItemSpecification{}, err
+	}
+	generated := DeployItemSpecification{}
+	if err := json.Unmarshal(data, &generated); err != nil {
+		return DeployItemSpecification{}, err
+	}
+
+	generated.Name = spec.Name + "-" + elem.suffix()
+	if elem.target != nil {
+		generated.Target = elem.target
+	}
+	return generated, nil
+}
+
+// replacePlaceholders replaces the placeholders in all strings of a decoded json value.
+// A string which consists of a single placeholder is replaced by the referenced value itself,
+// otherwise the placeholders are replaced by the string representation of the referenced values.
+func replacePlaceholders(raw interface{}, elem forEachElement) (interface{}, error) {
+	switch typed := raw.(type) {
+	case map[string]interface{}:
+		for k, v := range typed {
+			replaced, err := replacePlaceholders(v, elem)
+			if err != nil {
+				return nil, err
+			}
+			typed[k] = replaced
+		}
+		return typed, nil
+	case []interface{}:
+		for i, v := range typed {
+			replaced, err := replacePlaceholders(v, elem)
+			if err != nil {
+				return nil, err
+			}
+			typed[i] = replaced
+		}
+		return typed, nil
+	case string:
+		if match := eachPlaceholder.FindStringSubmatch(typed); match != nil && match[0] == typed {
+			return resolvePlaceholder(match, elem)
+		}
+
+		var resolveErr error
+		replaced := eachPlaceholder.ReplaceAllStringFunc(typed, func(placeholder string) string {
+			value, err := resolvePlaceholder(eachPlaceholder.FindStringSubmatch(placeholder), elem)
+			if err != nil {
+				resolveErr = err
+				return placeholder
+			}
+			if s, ok := value.(string); ok {
+				return s
+			}
+			data, err := json.Marshal(value)
+			if err != nil {
+				resolveErr = err
+				return placeholder
+			}
+			return string(data)
+		})
+		return replaced, resolveErr
+	default:
+		return raw, nil
+	}
+}
+
+// resolvePlaceholder returns the value referenced by a matched placeholder.
+func resolvePlaceholder(match []string, elem forEachElement) (interface{}, error) {
+	if match[1] == "key" {
+		if len(match[2]) != 0 {
+			return nil, fmt.Errorf("placeholder %q must not reference a path", match[0])
+		}
+		return elem.key, nil
+	}
+
+	value := elem.value
+	for _, name := range strings.Split(strings.TrimPrefix(match[2], "."), ".") {
+		if len(name) == 0 {
+			continue
+		}
+		switch typed := value.(type) {
+		case map[string]interface{}:
+			v, ok := typed[name]
+			if !ok {
+				return nil, fmt.Errorf("placeholder %q references undefined field %q", match[0], name)
+			}
+			value = v
+		case []interface{}:
+			i, err := strconv.Atoi(name)
+			if err != nil || i < 0 || i >= len(typed) {
+				return nil, fmt.Errorf("placeholder %q references invalid index %q", match[0], name)
+			}
+			value = typed[i]
+		default:
+			return nil, fmt.Errorf("placeholder %q references field %q of a value which is neither a map nor a list", match[0], name)
+		}
+	}
+	return value, nil
+}
+
+// expandDependencies removes dependencies on omitted deploy items and replaces dependencies on expanded deploy
+// items by dependencies on all generated deploy items.
+func expandDependencies(dependsOn []string, omitted map[string]bool, generatedNames map[string][]string) []string {
+	if dependsOn == nil {
+		return nil
+	}
+
+	result := []string{}
+	for _, dep := range dependsOn {
+		if omitted[dep] {
+			continue
+		}
+		if names, ok := generatedNames[dep]; ok {
+			result = append(result, names...)
+			continue
+		}
+		result = append(result, dep)
+	}
+	return result
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
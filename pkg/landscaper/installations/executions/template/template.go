@@ -157,7 +157,19 @@ type DeployItemSpecification struct {
 	// +optional
 	UpdateOnChangeOnly bool `json:"updateOnChangeOnly,omitempty"`
 
+	// ReconcileOnTargetChange specifies that the deploy item is reconciled again when the resolved content of its target changes.
+	// +optional
+	ReconcileOnTargetChange bool `json:"reconcileOnTargetChange,omitempty"`
+
 	OnDelete *core.OnDeleteConfig
+
+	// When specifies whether the deploy item is created. The deploy item is omitted if the value is false.
+	// +optional
+	When *bool `json:"when,omitempty"`
+
+	// ForEach expands the deploy item into one deploy item per element of a list, a map, or a targetList or targetMap import.
+	// +optional
+	ForEach *core.DeployItemForEach `json:"forEach,omitempty"`
 }
 
 // DeployExecutorOutput describes the output of deploy executor.
@@ -241,14 +253,25 @@ func (o *Templater) TemplateSubinstallationExecutions(opts DeployExecutionOption
 
 // TemplateDeployExecutions templates all deploy executions and returns a aggregated list of all templated deploy item templates.
 func (o *Templater) TemplateDeployExecutions(opts DeployExecutionOptions) ([]DeployItemSpecification, error) {
+	return o.templateDeployItems(opts, opts.Blueprint.Info.DeployExecutions)
+}
+
+// TemplateTestExecutions templates all test executions and returns a aggregated list of all templated test deploy item templates.
+func (o *Templater) TemplateTestExecutions(opts DeployExecutionOptions) ([]DeployItemSpecification, error) {
+	if len(opts.Blueprint.Info.TestExecutions) == 0 {
+		return nil, nil
+	}
+	return o.templateDeployItems(opts, opts.Blueprint.Info.TestExecutions)
+}
 
+func (o *Templater) templateDeployItems(opts DeployExecutionOptions, tmplExecs []lsv1alpha1.TemplateExecutor) ([]DeployItemSpecification, error) {
 	values, err := opts.Values()
 	if err != nil {
 		return nil, err
 	}
 
 	deployItemTemplateList := []DeployItemSpecification{}
-	for _, tmplExec := range opts.Blueprint.Info.DeployExecutions {
+	for _, tmplExec := range tmplExecs {
 		impl, ok := o.impl[tmplExec.Type]
 		if !ok {
 			return nil, fmt.Errorf("unknown template type %s", tmplExec.Type)
@@ -264,7 +287,7 @@ func (o *Templater) TemplateDeployExecutions(opts DeployExecutionOptions) ([]Dep
 		deployItemTemplateList = append(deployItemTemplateList, output.DeployItems...)
 	}
 
-	return deployItemTemplateList, nil
+	return ExpandDeployItems(deployItemTemplateList, opts.Blueprint, values)
 }
 
 // TemplateExportExecutions templates all exports.
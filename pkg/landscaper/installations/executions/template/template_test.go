@@ -142,6 +142,41 @@ func runTestSuite(testdataDir, sharedTestdataDir string) {
 		})
 	})
 
+	Context("TemplateTestExecutions", func() {
+		It("should template the test executions of the blueprint", func() {
+			tmpl, err := os.ReadFile(filepath.Join(testdataDir, "template-01.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			exec := make([]lsv1alpha1.TemplateExecutor, 0)
+			Expect(yaml.Unmarshal(tmpl, &exec)).ToNot(HaveOccurred())
+
+			blue := &lsv1alpha1.Blueprint{}
+			blue.TestExecutions = exec
+			op := template.New(gotemplate.New(stateHandler, nil), spiff.New(stateHandler, nil))
+
+			opts := template.NewDeployExecutionOptions(
+				template.NewBlueprintExecutionOptions(nil, &blueprints.Blueprint{Info: blue, Fs: nil}, nil, nil, nil))
+			res, err := op.TemplateTestExecutions(opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(res[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Name": Equal("init"),
+				"Type": Equal(core.DeployItemType("container")),
+			}))
+
+			res, err = op.TemplateDeployExecutions(opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeEmpty())
+		})
+
+		It("should return nil if the blueprint defines no tests", func() {
+			op := template.New(gotemplate.New(stateHandler, nil), spiff.New(stateHandler, nil))
+			res, err := op.TemplateTestExecutions(template.NewDeployExecutionOptions(
+				template.NewBlueprintExecutionOptions(nil, &blueprints.Blueprint{Info: &lsv1alpha1.Blueprint{}, Fs: nil}, nil, nil, nil)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+	})
+
 	Context("TemplateDeployExecutions", func() {
 		It("should return the raw template if no templating funcs are defined", func() {
 			tmpl, err := os.ReadFile(filepath.Join(testdataDir, "template-01.yaml"))
@@ -184,6 +219,62 @@ func runTestSuite(testdataDir, sharedTestdataDir string) {
 			Expect(config).To(HaveKeyWithValue("image", "my-custom-image:0.0.0"))
 		})
 
+		It("should evaluate the conditions and forEach expansions of the deploy items", func() {
+			tmpl, err := os.ReadFile(filepath.Join(testdataDir, "template-36.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			exec := make([]lsv1alpha1.TemplateExecutor, 0)
+			Expect(yaml.Unmarshal(tmpl, &exec)).ToNot(HaveOccurred())
+
+			blue := &lsv1alpha1.Blueprint{}
+			blue.DeployExecutions = exec
+			blue.Imports = lsv1alpha1.ImportDefinitionList{
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "clusters", TargetType: "landscaper.gardener.cloud/kubernetes-cluster"},
+					Type:                 lsv1alpha1.ImportTypeTargetList,
+				},
+			}
+			op := template.New(gotemplate.New(stateHandler, nil), spiff.New(stateHandler, nil))
+
+			res, err := op.TemplateDeployExecutions(template.NewDeployExecutionOptions(
+				template.NewBlueprintExecutionOptions(nil, &blueprints.Blueprint{Info: blue, Fs: nil}, nil, nil,
+					map[string]interface{}{
+						"monitoring": false,
+						"regions": []interface{}{
+							map[string]interface{}{"name": "eu", "replicas": 2},
+							map[string]interface{}{"name": "us", "replicas": 3},
+						},
+						"clusters": []interface{}{
+							map[string]interface{}{"metadata": map[string]interface{}{"name": "a"}},
+							map[string]interface{}{"metadata": map[string]interface{}{"name": "b"}},
+						},
+					})))
+			Expect(err).ToNot(HaveOccurred())
+
+			names := []string{}
+			for _, item := range res {
+				names = append(names, item.Name)
+				Expect(item.When).To(BeNil())
+				Expect(item.ForEach).To(BeNil())
+			}
+			Expect(names).To(Equal([]string{"namespace", "region-0", "region-1", "cluster-0", "cluster-1", "final"}))
+
+			config := make(map[string]interface{})
+			Expect(yaml.Unmarshal(res[2].Configuration.Raw, &config)).ToNot(HaveOccurred())
+			Expect(config).To(HaveKeyWithValue("region", "us"))
+			Expect(config).To(HaveKeyWithValue("replicas", BeNumerically("==", 3)))
+			Expect(config).To(HaveKeyWithValue("description", "region 1 is us"))
+			Expect(res[2].DependsOn).To(ConsistOf("namespace"))
+
+			Expect(yaml.Unmarshal(res[4].Configuration.Raw, &config)).ToNot(HaveOccurred())
+			Expect(config).To(HaveKeyWithValue("cluster", "b"))
+			Expect(res[4].Target).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Import": Equal("clusters"),
+				"Index":  PointTo(Equal(1)),
+			})))
+			Expect(res[4].DependsOn).To(ConsistOf("region-0", "region-1"))
+			Expect(res[5].DependsOn).To(ConsistOf("cluster-0", "cluster-1"))
+		})
+
 		It("should read the content of a file to template", func() {
 			tmpl, err := os.ReadFile(filepath.Join(testdataDir, "template-03.yaml"))
 			Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package executions
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/pkg/api"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/installations"
+	lsutil "github.com/openmcp-project/landscaper/pkg/utils"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+// testExecutionSuffix is appended to the name of an installation to get the name of its test execution.
+const testExecutionSuffix = "-test"
+
+// TestExecutionName returns the name of the test execution of an installation.
+func TestExecutionName(instName string) string {
+	return instName + testExecutionSuffix
+}
+
+// EnsureTests creates or updates the execution of the test deploy items defined by the testExecutions of the
+// blueprint. The test execution is not started here, but only after all other deploy items and all subinstallations
+// of the installation have succeeded. If the blueprint defines no tests, an existing test execution is deleted.
+func (o *ExecutionOperation) EnsureTests(ctx context.Context, inst *installations.InstallationImportsAndBlueprint) error {
+	installation := inst.GetInstallation()
+
+	testTemplates, err := o.RenderTestDeployItemTemplates(ctx, inst)
+	if err != nil {
+		return err
+	}
+
+	if testTemplates == nil {
+		return o.deleteTestExecution(ctx, installation)
+	}
+
+	versionedDeployItemTemplateList := lsv1alpha1.DeployItemTemplateList{}
+	if err := lsv1alpha1.Convert_core_DeployItemTemplateList_To_v1alpha1_DeployItemTemplateList(&testTemplates, &versionedDeployItemTemplateList, nil); err != nil {
+		return fmt.Errorf("error converting internal representation of test deployitem templates to versioned one: %w", err)
+	}
+
+	exec := &lsv1alpha1.Execution{}
+	exec.Name = TestExecutionName(installation.Name)
+	exec.Namespace = installation.Namespace
+
+	if _, err := o.WriterToLsUncachedClient().CreateOrUpdateExecution(ctx, read_write_layer.W000178, exec, func() error {
+		exec.Spec.Context = installation.Spec.Context
+		exec.Spec.DeployItems = versionedDeployItemTemplateList
+		exec.Spec.MaxParallel = nil
+		if inst.GetBlueprint().Info.MaxParallelDeployItems != nil {
+			exec.Spec.MaxParallel = ptr.To(*inst.GetBlueprint().Info.MaxParallelDeployItems)
+		}
+
+		if exec.CreationTimestamp.IsZero() && exec.DeletionTimestamp.IsZero() {
+			controllerutil.AddFinalizer(exec, lsv1alpha1.LandscaperFinalizer)
+		}
+
+		if err := controllerutil.SetControllerReference(installation, exec, api.LandscaperScheme); err != nil {
+			return err
+		}
+		o.Scheme().Default(exec)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	installation.Status.TestExecutionReference = &lsv1alpha1.ObjectReference{
+		Name:      exec.Name,
+		Namespace: exec.Namespace,
+	}
+	return o.UpdateInstallationStatus(ctx, installation, read_write_layer.W000179)
+}
+
+// deleteTestExecution deletes the test execution of an installation whose blueprint defines no tests anymore.
+func (o *ExecutionOperation) deleteTestExecution(ctx context.Context, installation *lsv1alpha1.Installation) error {
+	if installation.Status.TestExecutionReference == nil {
+		return nil
+	}
+
+	exec, err := GetTestExecutionForInstallation(ctx, o.LsUncachedClient(), installation)
+	if err != nil {
+		return err
+	}
+
+	if exec != nil {
+		// the execution controller processes the deletion only in a new job
+		if exec.Status.JobID != installation.Status.JobID {
+			exec.Status.JobID = installation.Status.JobID
+			exec.Status.TransitionTimes = lsutil.NewTransitionTimes()
+			if err := o.WriterToLsUncachedClient().UpdateExecutionStatus(ctx, read_write_layer.W000180, exec); err != nil {
+				return err
+			}
+		}
+
+		if exec.DeletionTimestamp.IsZero() {
+			if err := o.WriterToLsUncachedClient().DeleteExecution(ctx, read_write_layer.W000181, exec); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+
+	installation.Status.TestExecutionReference = nil
+	installation.Status.Tests = nil
+	return o.UpdateInstallationStatus(ctx, installation, read_write_layer.W000182)
+}
+
+// GetTestExecutionForInstallation returns the test execution of an installation.
+// The execution is nil if no test execution has been found, or if the execution with the name of the test execution
+// is not controlled by the installation.
+func GetTestExecutionForInstallation(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation) (*lsv1alpha1.Execution, error) {
+	exec := &lsv1alpha1.Execution{}
+	key := client.ObjectKey{Namespace: inst.Namespace, Name: TestExecutionName(inst.Name)}
+	if err := read_write_layer.GetExecution(ctx, kubeClient, key, exec, read_write_layer.R000134); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if !metav1.IsControlledBy(exec, inst) {
+		return nil, nil
+	}
+	return exec, nil
+}
+
+// NewTestStatus returns the status of a finished run of the tests of an installation.
+func NewTestStatus(tests *lsv1alpha1.TestStatus, exec *lsv1alpha1.Execution, deployItems []*lsv1alpha1.DeployItem) *lsv1alpha1.TestStatus {
+	status := tests.DeepCopy()
+	status.Phase = exec.Status.ExecutionPhase
+	status.CompletionTime = ptr.To(metav1.Now())
+	status.Results = []lsv1alpha1.TestResult{}
+
+	for _, tmpl := range exec.Spec.DeployItems {
+		result := lsv1alpha1.TestResult{Name: tmpl.Name}
+		for _, di := range deployItems {
+			if di.Labels[lsv1alpha1.ExecutionManagedNameLabel] != tmpl.Name {
+				continue
+			}
+			result.DeployItem = &lsv1alpha1.ObjectReference{Name: di.Name, Namespace: di.Namespace}
+			result.Phase = di.Status.Phase
+			if di.Status.LastError != nil && di.Status.Phase.IsFailed() {
+				result.Message = di.Status.LastError.Message
+			}
+		}
+		status.Results = append(status.Results, result)
+	}
+
+	return status
+}
+
+// FailedTests returns the names of the failed tests of a test status.
+func FailedTests(tests *lsv1alpha1.TestStatus) []string {
+	failed := []string{}
+	if tests == nil {
+		return failed
+	}
+	for _, result := range tests.Results {
+		if result.Phase != lsv1alpha1.DeployItemPhases.Succeeded {
+			failed = append(failed, result.Name)
+		}
+	}
+	return failed
+}
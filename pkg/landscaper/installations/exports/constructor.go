@@ -160,6 +160,9 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 			SetSourceType(lsv1alpha1.ExportDataObjectSourceType).
 			SetKey(dataExport.DataRef).
 			SetData(data)
+		if dataExport.Aggregate != nil {
+			do.SetAggregationKey(c.aggregationKey(dataExport.Aggregate))
+		}
 		dataObjects[i] = do
 	}
 
@@ -199,6 +202,16 @@ func (c *Constructor) aggregateDataObjectsInContext(ctx context.Context) (map[st
 		if err := yaml.Unmarshal(do.Data.RawMessage, &data); err != nil {
 			return nil, fmt.Errorf("error while decoding data object %s: %w", do.Name, err)
 		}
+		if meta.AggregationKey != nil {
+			// contributions to an aggregated data object are merged into a map of all contributions
+			contributions, ok := aggDataObjects[meta.Key].(map[string]interface{})
+			if !ok {
+				contributions = map[string]interface{}{}
+				aggDataObjects[meta.Key] = contributions
+			}
+			contributions[*meta.AggregationKey] = data
+			continue
+		}
 		aggDataObjects[meta.Key] = data
 	}
 	return aggDataObjects, nil
@@ -293,3 +306,12 @@ func (c *Constructor) templateDataMappings(fldPath *field.Path, exports map[stri
 	}
 	return values, nil
 }
+
+// aggregationKey returns the key under which the installation contributes to an aggregated data object.
+// It defaults to the name of the installation template for subinstallations and to the installation name otherwise.
+func (c *Constructor) aggregationKey(aggregation *lsv1alpha1.DataExportAggregation) string {
+	if len(aggregation.Key) != 0 {
+		return aggregation.Key
+	}
+	return c.Inst.GetInstallation().GetSiblingName()
+}
@@ -126,6 +126,14 @@ func GetDataImport(ctx context.Context,
 	inst *InstallationAndImports,
 	dataImport lsv1alpha1.DataImport) (*dataobjects.DataObject, *metav1.OwnerReference, error) {
 
+	if dataImport.Aggregated != nil {
+		do, err := GetAggregatedDataImport(ctx, kubeClient, contextName, inst.GetInstallation(), dataImport)
+		if err != nil {
+			return nil, nil, err
+		}
+		return do, nil, nil
+	}
+
 	var rawDataObject *lsv1alpha1.DataObject
 	// get deploy item from current context
 	if len(dataImport.DataRef) != 0 {
@@ -168,6 +176,61 @@ func GetDataImport(ctx context.Context,
 	return do, owner, nil
 }
 
+// GetAggregatedDataImport fetches all contributions to an aggregated data object from the cluster
+// and merges them into one data object.
+func GetAggregatedDataImport(ctx context.Context,
+	kubeClient client.Client,
+	contextName string,
+	inst *lsv1alpha1.Installation,
+	dataImport lsv1alpha1.DataImport) (*dataobjects.DataObject, error) {
+
+	// construct label selector
+	selector := labels.NewSelector()
+	if len(contextName) != 0 {
+		r, err := labels.NewRequirement(lsv1alpha1.DataObjectContextLabel, selection.Equals, []string{contextName})
+		if err != nil {
+			return nil, fmt.Errorf("unable to construct label selector: %w", err)
+		}
+		selector = selector.Add(*r)
+	} else {
+		r, err := labels.NewRequirement(lsv1alpha1.DataObjectContextLabel, selection.DoesNotExist, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to construct label selector: %w", err)
+		}
+		selector = selector.Add(*r)
+	}
+
+	r, err := labels.NewRequirement(lsv1alpha1.DataObjectKeyLabel, selection.Equals, []string{dataImport.DataRef})
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct label selector: %w", err)
+	}
+	selector = selector.Add(*r)
+
+	// only contributions exported by installations are aggregated
+	r, err = labels.NewRequirement(lsv1alpha1.DataObjectSourceTypeLabel, selection.Equals, []string{string(lsv1alpha1.ExportDataObjectSourceType)})
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct label selector: %w", err)
+	}
+	selector = selector.Add(*r)
+
+	r, err = labels.NewRequirement(lsv1alpha1.DataObjectAggregationKeyLabel, selection.Exists, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct label selector: %w", err)
+	}
+	selector = selector.Add(*r)
+
+	doList := &lsv1alpha1.DataObjectList{}
+	if err := read_write_layer.ListDataObjects(ctx, kubeClient, doList, read_write_layer.R000103,
+		client.InNamespace(inst.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("unable to fetch contributions to aggregated data object %s/%s: %w", contextName, dataImport.DataRef, err)
+	}
+	if len(doList.Items) == 0 {
+		return nil, fmt.Errorf("no contributions found for aggregated data object %s/%s", contextName, dataImport.DataRef)
+	}
+
+	return dataobjects.NewAggregatedDataObjectFromList(doList, &dataImport)
+}
+
 // GetTargetImport fetches the target import from the cluster.
 func GetTargetImport(ctx context.Context, kubeClient client.Client, contextName string, inst *lsv1alpha1.Installation, targetImport lsv1alpha1.TargetImport) (*dataobjects.TargetExtension, error) {
 	targetName := targetImport.Target
@@ -23,6 +23,8 @@ import (
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/targettypes"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
 )
 
 const (
@@ -106,6 +108,10 @@ func (c *Constructor) Construct(ctx context.Context, imps *Imports) error {
 		}
 	}
 
+	if err := c.validateImportedTargetTypes(ctx, inst.GetBlueprint().Info.Imports, fldPath); err != nil {
+		return err
+	}
+
 	// performs the importDataMappings
 	templatedDataMappings, err := c.templateDataMappings(fldPath, imps.DataObjects, imps.Targets, imps.TargetLists, imps.TargetMaps) // returns a map mapping logical names to data content
 	if err != nil {
@@ -127,6 +133,31 @@ func (c *Constructor) Construct(ctx context.Context, imps *Imports) error {
 	return nil
 }
 
+// validateImportedTargetTypes checks that the target types of all target imports of the blueprint are registered.
+func (c *Constructor) validateImportedTargetTypes(ctx context.Context, importList lsv1alpha1.ImportDefinitionList, fldPath *field.Path) error {
+	registry, err := targettypes.NewRegistry(ctx, c.LsCachedClient(), read_write_layer.R000114)
+	if err != nil {
+		return err
+	}
+	return validateImportedTargetTypes(registry, importList, fldPath)
+}
+
+func validateImportedTargetTypes(registry *targettypes.Registry, importList lsv1alpha1.ImportDefinitionList, fldPath *field.Path) error {
+	for _, def := range importList {
+		defPath := fldPath.Child(def.Name)
+		switch def.Type {
+		case lsv1alpha1.ImportTypeTarget, lsv1alpha1.ImportTypeTargetList, lsv1alpha1.ImportTypeTargetMap:
+			if !registry.IsKnown(lsv1alpha1.TargetType(def.TargetType)) {
+				return installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: target type %s is not registered by a TargetTypeDefinition", defPath.String(), def.TargetType)
+			}
+		}
+		if err := validateImportedTargetTypes(registry, def.ConditionalImports, defPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // constructImports is an auxiliary function that can be called in a recursive manner to traverse the tree of conditional imports
 func (c *Constructor) constructImports(
 	importList lsv1alpha1.ImportDefinitionList,
@@ -88,33 +88,56 @@ func (rh *ReconcileHelper) AllPredecessorsFinished(ctx context.Context, installa
 
 	// iterate over siblings which is depended on (either directly or transitively) and check if they are 'ready'
 	for name := range predecessorMap {
-		predecessor := predecessorMap[name]
-		reason := string(installations.NotCompletedDependents)
-
-		if installations.IsRootInstallation(installation) {
-			if lsv1alpha1helper.HasOperation(predecessor.GetInstallation().ObjectMeta, lsv1alpha1.ReconcileOperation) {
-				msg := fmt.Sprintf("depending on installation %q which has reconcile annotation",
-					kutil.ObjectKeyFromObject(predecessor.GetInstallation()).String())
-				return lserror.NewWrappedError(nil, reason, reason, msg, lsv1alpha1.ErrorForInfoOnly)
-			}
-
-			if predecessor.GetInstallation().Status.JobID != predecessor.GetInstallation().Status.JobIDFinished {
-				msg := fmt.Sprintf("depending on installation %q which not finished current job %q",
-					kutil.ObjectKeyFromObject(predecessor.GetInstallation()).String(), installation.Status.JobID)
-				return lserror.NewWrappedError(nil, reason, reason, msg, lsv1alpha1.ErrorForInfoOnly)
-			}
-		} else {
-			if installation.Status.JobID != predecessor.GetInstallation().Status.JobIDFinished {
-				msg := fmt.Sprintf("depending on installation %q which not finished current job %q",
-					kutil.ObjectKeyFromObject(predecessor.GetInstallation()).String(), installation.Status.JobID)
-				return lserror.NewWrappedError(nil, reason, reason, msg, lsv1alpha1.ErrorForInfoOnly)
-			}
+		if msg := unfinishedPredecessorMessage(installation, predecessorMap[name].GetInstallation()); len(msg) > 0 {
+			reason := string(installations.NotCompletedDependents)
+			return lserror.NewWrappedError(nil, reason, reason, msg, lsv1alpha1.ErrorForInfoOnly)
 		}
 	}
 
 	return nil
 }
 
+// UnfinishedPredecessors returns the predecessors which have not yet finished the job for which the installation waits,
+// together with the imports of the installation which they export. The result is sorted by name.
+func (rh *ReconcileHelper) UnfinishedPredecessors(installation *lsv1alpha1.Installation,
+	predecessorMap map[string]*installations.InstallationAndImports) []lsv1alpha1.BlockingObject {
+
+	blockedBy := []lsv1alpha1.BlockingObject{}
+	for _, name := range sets.List(sets.KeySet(predecessorMap)) {
+		predecessor := predecessorMap[name].GetInstallation()
+		msg := unfinishedPredecessorMessage(installation, predecessor)
+		if len(msg) == 0 {
+			continue
+		}
+
+		blockingObject := lsv1alpha1helper.NewBlockingObject(utils.InstallationKind, predecessor,
+			lsv1alpha1.PredecessorNotFinishedReason, msg)
+		blockingObject.Imports = dependencies.FetchImportsFromPredecessor(installation, predecessor)
+		blockedBy = append(blockedBy, blockingObject)
+	}
+	return blockedBy
+}
+
+// unfinishedPredecessorMessage returns a message if the predecessor has not yet finished the job for which the
+// installation waits, and an empty string otherwise.
+func unfinishedPredecessorMessage(installation, predecessor *lsv1alpha1.Installation) string {
+	if installations.IsRootInstallation(installation) {
+		if lsv1alpha1helper.HasOperation(predecessor.ObjectMeta, lsv1alpha1.ReconcileOperation) {
+			return fmt.Sprintf("depending on installation %q which has reconcile annotation",
+				kutil.ObjectKeyFromObject(predecessor).String())
+		}
+
+		if predecessor.Status.JobID != predecessor.Status.JobIDFinished {
+			return fmt.Sprintf("depending on installation %q which not finished current job %q",
+				kutil.ObjectKeyFromObject(predecessor).String(), installation.Status.JobID)
+		}
+	} else if installation.Status.JobID != predecessor.Status.JobIDFinished {
+		return fmt.Sprintf("depending on installation %q which not finished current job %q",
+			kutil.ObjectKeyFromObject(predecessor).String(), installation.Status.JobID)
+	}
+	return ""
+}
+
 func (rh *ReconcileHelper) AllPredecessorsSucceeded(ctx context.Context, installation *lsv1alpha1.Installation, predecessorMap map[string]*installations.InstallationAndImports) error {
 	logger, _ := logging.FromContextOrNew(ctx, nil)
 	pm := utils.StartPerformanceMeasurement(&logger, "AllPredecessorsSucceeded")
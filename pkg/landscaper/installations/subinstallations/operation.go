@@ -4,11 +4,17 @@
 
 package subinstallations
 
-import "github.com/openmcp-project/landscaper/pkg/landscaper/installations"
+import (
+	"github.com/openmcp-project/landscaper/apis/config"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/installations"
+)
 
 // Operation contains all subinstallation operations
 type Operation struct {
 	*installations.Operation
+
+	// DeletionSafeguard limits the number of orphaned subinstallations which are deleted without confirmation.
+	DeletionSafeguard *config.DeletionSafeguard
 }
 
 // New creates a new subinstallation operation
@@ -23,6 +23,8 @@ import (
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/openmcp-project/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+	"github.com/openmcp-project/landscaper/pkg/utils/deletion"
 	"github.com/openmcp-project/landscaper/pkg/utils/dependencies"
 	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
 )
@@ -148,11 +150,24 @@ func (o *Operation) cleanupOrphanedSubInstallations(ctx context.Context,
 		orphaned = []string{}
 	)
 
+	orphanedSubInsts := []*lsv1alpha1.Installation{}
+	newlyOrphaned := []string{}
 	for defName, subInst := range subInstallations {
 		if _, ok := getInstallationTemplate(installationTmpl, defName); ok {
 			continue
 		}
 
+		orphanedSubInsts = append(orphanedSubInsts, subInst)
+		if subInst.DeletionTimestamp.IsZero() {
+			newlyOrphaned = append(newlyOrphaned, subInst.Name)
+		}
+	}
+
+	if err := o.checkDeletionSafeguard(ctx, newlyOrphaned, len(subInstallations)); err != nil {
+		return nil, err
+	}
+
+	for _, subInst := range orphanedSubInsts {
 		orphaned = append(orphaned, subInst.Name)
 
 		// delete installation
@@ -182,6 +197,35 @@ func (o *Operation) cleanupOrphanedSubInstallations(ctx context.Context,
 	return orphaned, nil
 }
 
+// checkDeletionSafeguard returns an error if the deletion of the given orphaned subinstallations exceeds the deletion
+// safeguard and has not been confirmed. A confirmation annotation which has been used is removed.
+func (o *Operation) checkDeletionSafeguard(ctx context.Context, orphaned []string, total int) error {
+	inst := o.Inst.GetInstallation()
+	inst.Status.PendingDeletion = nil
+
+	if !deletion.ExceedsSafeguard(o.DeletionSafeguard, len(orphaned), total) {
+		return nil
+	}
+
+	pendingDeletion := deletion.NewPendingDeletion(orphaned, total)
+	if !deletion.IsConfirmed(inst, pendingDeletion) {
+		inst.Status.PendingDeletion = pendingDeletion
+		return deletion.NewNotConfirmedError("CleanupOrphanedSubInstallations", utils.InstallationKind, pendingDeletion)
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	logger.Info("deletion of orphaned installations confirmed", "digest", pendingDeletion.Digest)
+
+	// the update of the installation overwrites the status with the stored one; it is restored afterwards
+	status := inst.Status.DeepCopy()
+	delete(inst.Annotations, lsv1alpha1.ConfirmDeletionAnnotation)
+	if err := o.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000172, inst); err != nil {
+		return o.NewError(err, "RemoveConfirmDeletionAnnotation", err.Error())
+	}
+	inst.Status = *status
+	return nil
+}
+
 // getInstallationTemplates returns all installation templates defined by the referenced blueprint.
 func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTemplate, error) {
 	var instTmpls []*lsv1alpha1.InstallationTemplate
@@ -309,6 +353,8 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 			Exports:             subInstTmpl.Exports,
 			ExportDataMappings:  subInstTmpl.ExportDataMappings,
 			Optimization:        subInstTmpl.Optimization,
+			Rollout:             subInstTmpl.Rollout,
+			DependsOn:           subInstTmpl.DependsOn,
 		}
 
 		o.Scheme().Default(subInst)
@@ -23,6 +23,7 @@ type RegistriesAccessor interface {
 // Operation is the type that is used to share common operational data across the landscaper reconciler
 type Operation struct {
 	lsUncachedClient  client.Client
+	lsCachedClient    client.Client
 	scheme            *runtime.Scheme
 	eventRecorder     events.EventRecorder
 	componentRegistry model.RegistryAccess
@@ -42,6 +43,7 @@ func NewOperation(scheme *runtime.Scheme, recorder events.EventRecorder, lsUncac
 func (o *Operation) Copy() *Operation {
 	return &Operation{
 		lsUncachedClient:  o.lsUncachedClient,
+		lsCachedClient:    o.lsCachedClient,
 		scheme:            o.scheme,
 		eventRecorder:     o.eventRecorder,
 		componentRegistry: o.componentRegistry,
@@ -53,6 +55,21 @@ func (o *Operation) LsUncachedClient() client.Client {
 	return o.lsUncachedClient
 }
 
+// LsCachedClient returns a client which reads from the cache of the manager.
+// The uncached client is returned if no cached client is set.
+func (o *Operation) LsCachedClient() client.Client {
+	if o.lsCachedClient == nil {
+		return o.lsUncachedClient
+	}
+	return o.lsCachedClient
+}
+
+// SetLsCachedClient injects a client which reads from the cache of the manager into the operation
+func (o *Operation) SetLsCachedClient(lsCachedClient client.Client) *Operation {
+	o.lsCachedClient = lsCachedClient
+	return o
+}
+
 func (o *Operation) WriterToLsUncachedClient() *read_write_layer.Writer {
 	return read_write_layer.NewWriter(o.lsUncachedClient)
 }
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+// Registry gives access to the target types that are registered in the landscaper cluster
+// by TargetTypeDefinitions.
+type Registry struct {
+	definitions []lsv1alpha1.TargetTypeDefinition
+}
+
+// NewRegistry reads all TargetTypeDefinitions from the cluster and returns a registry containing them.
+func NewRegistry(ctx context.Context, c client.Reader, readID read_write_layer.ReadID) (*Registry, error) {
+	list := &lsv1alpha1.TargetTypeDefinitionList{}
+	if err := read_write_layer.ListTargetTypeDefinitions(ctx, c, list, readID); err != nil {
+		return nil, fmt.Errorf("unable to list target type definitions: %w", err)
+	}
+	return NewRegistryFromDefinitions(list.Items...), nil
+}
+
+// NewRegistryFromDefinitions returns a registry containing the given TargetTypeDefinitions.
+func NewRegistryFromDefinitions(definitions ...lsv1alpha1.TargetTypeDefinition) *Registry {
+	return &Registry{
+		definitions: definitions,
+	}
+}
+
+// IsEmpty returns true if no target type is registered.
+func (r *Registry) IsEmpty() bool {
+	return len(r.definitions) == 0
+}
+
+// Get returns the TargetTypeDefinition that registers the given target type.
+// Nil is returned if the type is not registered.
+func (r *Registry) Get(targetType lsv1alpha1.TargetType) *lsv1alpha1.TargetTypeDefinition {
+	for i := range r.definitions {
+		if r.definitions[i].Spec.Type == targetType {
+			return &r.definitions[i]
+		}
+	}
+	return nil
+}
+
+// IsKnown checks whether targets of the given type may be used.
+// As long as no TargetTypeDefinition exists, all target types are accepted so that landscaper instances
+// that do not use the registry are not affected.
+// The kubernetes-cluster target type is always known as it is provided by the landscaper itself.
+func (r *Registry) IsKnown(targetType lsv1alpha1.TargetType) bool {
+	if r.IsEmpty() || targetType == targettypes.KubernetesClusterTargetType {
+		return true
+	}
+	return r.Get(targetType) != nil
+}
+
+// ValidateConfiguration validates the configuration of a target against the schema of its registered target type.
+// The configuration can be given as json or yaml.
+// Configurations of target types that are not registered or whose definition has no schema are always valid.
+func (r *Registry) ValidateConfiguration(targetType lsv1alpha1.TargetType, config []byte) error {
+	def := r.Get(targetType)
+	if def == nil || def.Spec.Schema == nil {
+		return nil
+	}
+
+	data, err := yaml.YAMLToJSON(config)
+	if err != nil {
+		return fmt.Errorf("unable to parse configuration of target type %q: %w", targetType, err)
+	}
+
+	res, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(def.Spec.Schema.RawMessage), gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return fmt.Errorf("unable to validate configuration against the schema of target type %q: %w", targetType, err)
+	}
+	if !res.Valid() {
+		var allErrs field.ErrorList
+		for _, resErr := range res.Errors() {
+			allErrs = append(allErrs, field.Invalid(field.NewPath(resErr.Field()), resErr.Value(), resErr.Description()))
+		}
+		return fmt.Errorf("configuration does not match the schema of target type %q: %w", targetType, allErrs.ToAggregate())
+	}
+	return nil
+}
+
+// ValidateSchema checks whether the given schema of a TargetTypeDefinition is a valid json schema.
+func ValidateSchema(schema []byte) error {
+	_, err := gojsonschema.NewSchemaLoader().Compile(gojsonschema.NewBytesLoader(schema))
+	return err
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/openmcp-project/landscaper/pkg/api"
+	lstargettypes "github.com/openmcp-project/landscaper/pkg/landscaper/targettypes"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+var _ = Describe("Registry", func() {
+
+	newDefinition := func(name string, targetType lsv1alpha1.TargetType, schema string) lsv1alpha1.TargetTypeDefinition {
+		def := lsv1alpha1.TargetTypeDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: lsv1alpha1.TargetTypeDefinitionSpec{
+				Type: targetType,
+			},
+		}
+		if len(schema) != 0 {
+			def.Spec.Schema = &lsv1alpha1.JSONSchemaDefinition{RawMessage: json.RawMessage(schema)}
+		}
+		return def
+	}
+
+	It("should read the target type definitions from the cluster", func() {
+		def := newDefinition("my-type", "example.com/my-type", "")
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(&def).Build()
+
+		registry, err := lstargettypes.NewRegistry(context.Background(), kubeClient, read_write_layer.R000112)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(registry.IsEmpty()).To(BeFalse())
+		Expect(registry.Get("example.com/my-type")).ToNot(BeNil())
+		Expect(registry.Get("example.com/other-type")).To(BeNil())
+	})
+
+	It("should know all target types if no target type is registered", func() {
+		registry := lstargettypes.NewRegistryFromDefinitions()
+		Expect(registry.IsKnown("example.com/my-type")).To(BeTrue())
+	})
+
+	It("should only know registered target types and the kubernetes-cluster target type", func() {
+		registry := lstargettypes.NewRegistryFromDefinitions(newDefinition("my-type", "example.com/my-type", ""))
+		Expect(registry.IsKnown("example.com/my-type")).To(BeTrue())
+		Expect(registry.IsKnown(targettypes.KubernetesClusterTargetType)).To(BeTrue())
+		Expect(registry.IsKnown("example.com/other-type")).To(BeFalse())
+	})
+
+	Context("ValidateConfiguration", func() {
+
+		var registry *lstargettypes.Registry
+
+		BeforeEach(func() {
+			registry = lstargettypes.NewRegistryFromDefinitions(
+				newDefinition("my-type", "example.com/my-type", `{
+					"type": "object",
+					"required": ["url"],
+					"properties": {
+						"url": {"type": "string"}
+					}
+				}`),
+				newDefinition("no-schema", "example.com/no-schema", ""),
+			)
+		})
+
+		It("should accept a json configuration that matches the schema", func() {
+			Expect(registry.ValidateConfiguration("example.com/my-type", []byte(`{"url": "https://example.com"}`))).To(Succeed())
+		})
+
+		It("should accept a yaml configuration that matches the schema", func() {
+			Expect(registry.ValidateConfiguration("example.com/my-type", []byte("url: https://example.com\n"))).To(Succeed())
+		})
+
+		It("should reject a configuration that does not match the schema", func() {
+			err := registry.ValidateConfiguration("example.com/my-type", []byte(`{"url": 42}`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("example.com/my-type"))
+
+			Expect(registry.ValidateConfiguration("example.com/my-type", []byte(`{}`))).ToNot(Succeed())
+		})
+
+		It("should accept any configuration of target types without schema", func() {
+			Expect(registry.ValidateConfiguration("example.com/no-schema", []byte(`{"url": 42}`))).To(Succeed())
+			Expect(registry.ValidateConfiguration("example.com/unknown", []byte(`{"url": 42}`))).To(Succeed())
+		})
+	})
+
+	It("should validate schemas", func() {
+		Expect(lstargettypes.ValidateSchema([]byte(`{"type": "object"}`))).To(Succeed())
+		Expect(lstargettypes.ValidateSchema([]byte(`{"type": "unknown"}`))).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deadline
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+)
+
+// Get returns the deadline of the current job of an installation, i.e. the trigger time of the job plus the timeout
+// of the installation. It returns nil if the installation has no timeout or no current job.
+func Get(inst *lsv1alpha1.Installation) *time.Time {
+	if inst.Spec.Timeout == nil || inst.Spec.Timeout.Duration <= 0 {
+		return nil
+	}
+
+	if inst.Status.TransitionTimes == nil || inst.Status.TransitionTimes.TriggerTime == nil {
+		return nil
+	}
+
+	deadline := inst.Status.TransitionTimes.TriggerTime.Add(inst.Spec.Timeout.Duration)
+	return &deadline
+}
+
+// IsExceeded returns whether the deadline of the current job of an installation has passed.
+func IsExceeded(inst *lsv1alpha1.Installation, now time.Time) bool {
+	deadline := Get(inst)
+	return deadline != nil && !now.Before(*deadline)
+}
+
+// TimeUntil returns the remaining duration until the deadline of the current job of an installation.
+// It returns zero if the installation has no deadline or the deadline has already passed.
+func TimeUntil(inst *lsv1alpha1.Installation, now time.Time) time.Duration {
+	deadline := Get(inst)
+	if deadline == nil || !now.Before(*deadline) {
+		return 0
+	}
+	return deadline.Sub(now)
+}
+
+// SlowestChild returns the subinstallation or execution of an installation, which has not yet finished the current
+// job of the installation and which has started it first. Children that have not yet started the job are only
+// returned if no started child is incomplete. Returns nil if all children have finished the job.
+func SlowestChild(inst *lsv1alpha1.Installation, subInsts []*lsv1alpha1.Installation,
+	execs ...*lsv1alpha1.Execution) *lsv1alpha1.IncompleteChild {
+
+	jobID := inst.Status.JobID
+	candidates := []*lsv1alpha1.IncompleteChild{}
+
+	for _, subInst := range subInsts {
+		if subInst.Status.JobIDFinished == jobID {
+			continue
+		}
+		child := newIncompleteChild(utils.InstallationKind, subInst, string(subInst.Status.InstallationPhase))
+		if subInst.Status.JobID == jobID {
+			child.StartTime = startTime(subInst.Status.TransitionTimes)
+		}
+		candidates = append(candidates, child)
+	}
+
+	for _, exec := range execs {
+		if exec == nil || exec.Status.JobIDFinished == jobID {
+			continue
+		}
+		child := newIncompleteChild(utils.ExecutionKind, exec, string(exec.Status.ExecutionPhase))
+		if exec.Status.JobID == jobID {
+			child.StartTime = startTime(exec.Status.TransitionTimes)
+		}
+		candidates = append(candidates, child)
+	}
+
+	var slowest *lsv1alpha1.IncompleteChild
+	for _, child := range candidates {
+		if slowest == nil || isSlower(child, slowest) {
+			slowest = child
+		}
+	}
+	return slowest
+}
+
+func newIncompleteChild(kind string, obj metav1.Object, phase string) *lsv1alpha1.IncompleteChild {
+	return &lsv1alpha1.IncompleteChild{
+		Object: lsv1alpha1.TypedObjectReference{
+			APIVersion:      lsv1alpha1.SchemeGroupVersion.String(),
+			Kind:            kind,
+			ObjectReference: lsv1alpha1helper.ObjectReferenceFromObject(obj),
+		},
+		Phase: phase,
+	}
+}
+
+// startTime returns the time when an object has started its current job, preferring the start of the Init phase.
+func startTime(times *lsv1alpha1.TransitionTimes) *metav1.Time {
+	if times == nil {
+		return nil
+	}
+	if times.InitTime != nil {
+		return times.InitTime.DeepCopy()
+	}
+	return times.TriggerTime.DeepCopy()
+}
+
+// isSlower returns whether child a has been incomplete for a longer time than child b.
+// Children without start time rank last; ties are broken by kind and name to get a stable result.
+func isSlower(a, b *lsv1alpha1.IncompleteChild) bool {
+	switch {
+	case a.StartTime != nil && b.StartTime == nil:
+		return true
+	case a.StartTime == nil && b.StartTime != nil:
+		return false
+	case a.StartTime != nil && !a.StartTime.Equal(b.StartTime):
+		return a.StartTime.Before(b.StartTime)
+	}
+
+	if a.Object.Kind != b.Object.Kind {
+		return a.Object.Kind < b.Object.Kind
+	}
+	return a.Object.Name < b.Object.Name
+}
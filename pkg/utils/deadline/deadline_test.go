@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deadline_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+	"github.com/openmcp-project/landscaper/pkg/utils/deadline"
+)
+
+var _ = Describe("Deadline", func() {
+
+	var (
+		triggerTime time.Time
+		inst        *lsv1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		triggerTime = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		inst = &lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "test"},
+			Spec: lsv1alpha1.InstallationSpec{
+				Timeout: &lsv1alpha1.Duration{Duration: 10 * time.Minute},
+			},
+			Status: lsv1alpha1.InstallationStatus{
+				JobID: "job-2",
+				TransitionTimes: &lsv1alpha1.TransitionTimes{
+					TriggerTime: &metav1.Time{Time: triggerTime},
+				},
+			},
+		}
+	})
+
+	Context("Get", func() {
+
+		It("should compute the deadline from the trigger time and the timeout", func() {
+			Expect(deadline.Get(inst)).To(PointTo(BeTemporally("==", triggerTime.Add(10*time.Minute))))
+		})
+
+		It("should return no deadline without timeout", func() {
+			inst.Spec.Timeout = nil
+			Expect(deadline.Get(inst)).To(BeNil())
+
+			inst.Spec.Timeout = &lsv1alpha1.Duration{}
+			Expect(deadline.Get(inst)).To(BeNil())
+		})
+
+		It("should return no deadline without trigger time", func() {
+			inst.Status.TransitionTimes = nil
+			Expect(deadline.Get(inst)).To(BeNil())
+		})
+	})
+
+	Context("IsExceeded and TimeUntil", func() {
+
+		It("should not be exceeded before the deadline", func() {
+			now := triggerTime.Add(4 * time.Minute)
+			Expect(deadline.IsExceeded(inst, now)).To(BeFalse())
+			Expect(deadline.TimeUntil(inst, now)).To(Equal(6 * time.Minute))
+		})
+
+		It("should be exceeded at and after the deadline", func() {
+			now := triggerTime.Add(10 * time.Minute)
+			Expect(deadline.IsExceeded(inst, now)).To(BeTrue())
+			Expect(deadline.TimeUntil(inst, now)).To(BeZero())
+			Expect(deadline.IsExceeded(inst, now.Add(time.Hour))).To(BeTrue())
+		})
+
+		It("should never be exceeded without timeout", func() {
+			inst.Spec.Timeout = nil
+			Expect(deadline.IsExceeded(inst, triggerTime.Add(time.Hour))).To(BeFalse())
+			Expect(deadline.TimeUntil(inst, triggerTime)).To(BeZero())
+		})
+	})
+
+	Context("SlowestChild", func() {
+
+		newSubInst := func(name, jobID, jobIDFinished string, initTime *time.Time) *lsv1alpha1.Installation {
+			subInst := &lsv1alpha1.Installation{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+				Status: lsv1alpha1.InstallationStatus{
+					JobID:             jobID,
+					JobIDFinished:     jobIDFinished,
+					InstallationPhase: lsv1alpha1.InstallationPhases.Progressing,
+				},
+			}
+			if initTime != nil {
+				subInst.Status.TransitionTimes = &lsv1alpha1.TransitionTimes{InitTime: &metav1.Time{Time: *initTime}}
+			}
+			return subInst
+		}
+
+		at := func(d time.Duration) *time.Time {
+			t := triggerTime.Add(d)
+			return &t
+		}
+
+		It("should return nil if all children have finished", func() {
+			subInsts := []*lsv1alpha1.Installation{
+				newSubInst("a", "job-2", "job-2", at(time.Minute)),
+			}
+			exec := &lsv1alpha1.Execution{
+				Status: lsv1alpha1.ExecutionStatus{JobID: "job-2", JobIDFinished: "job-2"},
+			}
+			Expect(deadline.SlowestChild(inst, subInsts, exec)).To(BeNil())
+			Expect(deadline.SlowestChild(inst, nil, nil)).To(BeNil())
+		})
+
+		It("should return the incomplete child which has started first", func() {
+			subInsts := []*lsv1alpha1.Installation{
+				newSubInst("a", "job-2", "job-2", at(time.Minute)),
+				newSubInst("b", "job-2", "job-1", at(3*time.Minute)),
+				newSubInst("c", "job-2", "job-1", at(2*time.Minute)),
+			}
+			exec := &lsv1alpha1.Execution{
+				ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "test"},
+				Status: lsv1alpha1.ExecutionStatus{
+					JobID:           "job-2",
+					JobIDFinished:   "job-1",
+					ExecutionPhase:  lsv1alpha1.ExecutionPhases.Progressing,
+					TransitionTimes: &lsv1alpha1.TransitionTimes{InitTime: &metav1.Time{Time: *at(5 * time.Minute)}},
+				},
+			}
+
+			child := deadline.SlowestChild(inst, subInsts, exec)
+			Expect(child).NotTo(BeNil())
+			Expect(child.Object.Kind).To(Equal(utils.InstallationKind))
+			Expect(child.Object.Name).To(Equal("c"))
+			Expect(child.Phase).To(Equal(string(lsv1alpha1.InstallationPhases.Progressing)))
+			Expect(child.StartTime.Time).To(BeTemporally("==", *at(2 * time.Minute)))
+		})
+
+		It("should rank children which have not yet started the job last", func() {
+			subInsts := []*lsv1alpha1.Installation{
+				newSubInst("a", "job-1", "job-1", at(-time.Hour)),
+				newSubInst("b", "job-2", "job-1", at(4*time.Minute)),
+			}
+
+			child := deadline.SlowestChild(inst, subInsts, nil)
+			Expect(child).NotTo(BeNil())
+			Expect(child.Object.Name).To(Equal("b"))
+
+			child = deadline.SlowestChild(inst, subInsts[:1], nil)
+			Expect(child).NotTo(BeNil())
+			Expect(child.Object.Name).To(Equal("a"))
+			Expect(child.StartTime).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletion
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openmcp-project/landscaper/apis/config"
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+)
+
+// MaxImpactObjects limits the number of entries of each list of a deletion impact, so that the status of the
+// installation does not grow without bounds.
+const MaxImpactObjects = 100
+
+// LimitImpact sets the total numbers of the deletion impact and truncates its lists to MaxImpactObjects entries.
+func LimitImpact(impact *lsv1alpha1.DeletionImpact) {
+	impact.TotalInstallations = int32(len(impact.Installations))
+	impact.TotalExecutions = int32(len(impact.Executions))
+	impact.TotalDeployItems = int32(len(impact.DeployItems))
+	impact.TotalManagedResources = int32(len(impact.ManagedResources))
+
+	impact.Truncated = false
+	if len(impact.Installations) > MaxImpactObjects {
+		impact.Installations = impact.Installations[:MaxImpactObjects]
+		impact.Truncated = true
+	}
+	if len(impact.Executions) > MaxImpactObjects {
+		impact.Executions = impact.Executions[:MaxImpactObjects]
+		impact.Truncated = true
+	}
+	if len(impact.DeployItems) > MaxImpactObjects {
+		impact.DeployItems = impact.DeployItems[:MaxImpactObjects]
+		impact.Truncated = true
+	}
+	if len(impact.ManagedResources) > MaxImpactObjects {
+		impact.ManagedResources = impact.ManagedResources[:MaxImpactObjects]
+		impact.Truncated = true
+	}
+}
+
+// ExceedsSafeguard returns whether the deletion of the given number of orphaned objects out of the given total number
+// of objects exceeds the deletion safeguard. A nil safeguard never exceeds.
+func ExceedsSafeguard(safeguard *config.DeletionSafeguard, orphaned, total int) bool {
+	if safeguard == nil || orphaned == 0 {
+		return false
+	}
+
+	if safeguard.MaxDeletions != nil && orphaned > int(*safeguard.MaxDeletions) {
+		return true
+	}
+
+	if safeguard.MaxDeletionPercentage != nil && total > 0 && orphaned*100 > int(*safeguard.MaxDeletionPercentage)*total {
+		return true
+	}
+
+	return false
+}
+
+// NewPendingDeletion returns the pending deletion of the given orphaned objects. The digest only depends on the set of
+// orphaned objects, so that it stays stable over reconciliations as long as the same objects are orphaned.
+func NewPendingDeletion(objects []string, total int) *lsv1alpha1.PendingDeletion {
+	sorted := append([]string{}, objects...)
+	sort.Strings(sorted)
+
+	data, _ := json.Marshal(sorted)
+	hash := sha256.Sum256(data)
+
+	return &lsv1alpha1.PendingDeletion{
+		Digest:       hex.EncodeToString(hash[:]),
+		Objects:      sorted,
+		TotalObjects: int32(total),
+	}
+}
+
+// IsConfirmed returns whether the given pending deletion has been confirmed by the confirm-deletion annotation
+// of the given object.
+func IsConfirmed(obj metav1.Object, pendingDeletion *lsv1alpha1.PendingDeletion) bool {
+	if pendingDeletion == nil {
+		return true
+	}
+	digest, ok := obj.GetAnnotations()[lsv1alpha1.ConfirmDeletionAnnotation]
+	return ok && digest == pendingDeletion.Digest
+}
+
+// NewNotConfirmedError returns the error which stops a reconciliation until the pending deletion has been confirmed.
+// The error is no failure, and the reconciliation is not retried, because the confirmation triggers a new one.
+func NewNotConfirmedError(op, kind string, pendingDeletion *lsv1alpha1.PendingDeletion) lserrors.LsError {
+	msg := fmt.Sprintf("deletion of %d out of %d %ss exceeds the deletion safeguard and must be confirmed "+
+		"by annotation %s=%s: %s", len(pendingDeletion.Objects), pendingDeletion.TotalObjects, strings.ToLower(kind),
+		lsv1alpha1.ConfirmDeletionAnnotation, pendingDeletion.Digest, strings.Join(pendingDeletion.Objects, ", "))
+	return lserrors.NewError(op, lsv1alpha1.DeletionNotConfirmedReason, msg,
+		lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+}
+
+// ManagedResources returns the resources in the target cluster which the deployer of the given deploy item deletes
+// together with the deploy item. They are read from the field managedResources of the provider status, which is
+// maintained by the helm and manifest deployer. Resources with policy keep or ignore are not deleted.
+func ManagedResources(di *lsv1alpha1.DeployItem) ([]lsv1alpha1.ManagedResourceReference, error) {
+	if di.Status.ProviderStatus == nil || len(di.Status.ProviderStatus.Raw) == 0 {
+		return nil, nil
+	}
+
+	providerStatus := struct {
+		ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+	}{}
+	if err := json.Unmarshal(di.Status.ProviderStatus.Raw, &providerStatus); err != nil {
+		return nil, fmt.Errorf("unable to decode provider status of deploy item %s/%s: %w", di.Namespace, di.Name, err)
+	}
+
+	var result []lsv1alpha1.ManagedResourceReference
+	for _, mr := range providerStatus.ManagedResources {
+		if mr.Policy == managedresource.KeepPolicy || mr.Policy == managedresource.IgnorePolicy {
+			continue
+		}
+		result = append(result, lsv1alpha1.ManagedResourceReference{
+			DeployItem: lsv1alpha1.ObjectReference{Name: di.Name, Namespace: di.Namespace},
+			APIVersion: mr.Resource.APIVersion,
+			Kind:       mr.Resource.Kind,
+			Name:       mr.Resource.Name,
+			Namespace:  mr.Resource.Namespace,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletion_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/openmcp-project/landscaper/apis/config"
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+	"github.com/openmcp-project/landscaper/pkg/utils/deletion"
+)
+
+var _ = Describe("Deletion", func() {
+
+	Context("ExceedsSafeguard", func() {
+
+		It("should never exceed without safeguard", func() {
+			Expect(deletion.ExceedsSafeguard(nil, 100, 100)).To(BeFalse())
+			Expect(deletion.ExceedsSafeguard(&config.DeletionSafeguard{}, 100, 100)).To(BeFalse())
+		})
+
+		It("should check the maximal number of deletions", func() {
+			safeguard := &config.DeletionSafeguard{MaxDeletions: ptr.To[int32](2)}
+			Expect(deletion.ExceedsSafeguard(safeguard, 2, 100)).To(BeFalse())
+			Expect(deletion.ExceedsSafeguard(safeguard, 3, 100)).To(BeTrue())
+		})
+
+		It("should check the maximal percentage of deletions", func() {
+			safeguard := &config.DeletionSafeguard{MaxDeletionPercentage: ptr.To[int32](50)}
+			Expect(deletion.ExceedsSafeguard(safeguard, 2, 4)).To(BeFalse())
+			Expect(deletion.ExceedsSafeguard(safeguard, 3, 4)).To(BeTrue())
+			Expect(deletion.ExceedsSafeguard(safeguard, 0, 0)).To(BeFalse())
+		})
+	})
+
+	Context("PendingDeletion", func() {
+
+		It("should compute a digest independent of the order of the objects", func() {
+			p1 := deletion.NewPendingDeletion([]string{"b", "a"}, 5)
+			p2 := deletion.NewPendingDeletion([]string{"a", "b"}, 3)
+			Expect(p1.Digest).To(Equal(p2.Digest))
+			Expect(p1.Objects).To(Equal([]string{"a", "b"}))
+			Expect(p1.TotalObjects).To(Equal(int32(5)))
+
+			p3 := deletion.NewPendingDeletion([]string{"a", "c"}, 5)
+			Expect(p3.Digest).NotTo(Equal(p1.Digest))
+		})
+
+		It("should check the confirmation annotation", func() {
+			pendingDeletion := deletion.NewPendingDeletion([]string{"a"}, 1)
+			inst := &lsv1alpha1.Installation{}
+			Expect(deletion.IsConfirmed(inst, nil)).To(BeTrue())
+			Expect(deletion.IsConfirmed(inst, pendingDeletion)).To(BeFalse())
+
+			metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ConfirmDeletionAnnotation, "other")
+			Expect(deletion.IsConfirmed(inst, pendingDeletion)).To(BeFalse())
+
+			metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ConfirmDeletionAnnotation, pendingDeletion.Digest)
+			Expect(deletion.IsConfirmed(inst, pendingDeletion)).To(BeTrue())
+		})
+
+		It("should return an unfinished error", func() {
+			pendingDeletion := deletion.NewPendingDeletion([]string{"a"}, 1)
+			err := deletion.NewNotConfirmedError("op", "Installation", pendingDeletion)
+			Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorUnfinished)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(pendingDeletion.Digest))
+		})
+	})
+
+	Context("ManagedResources", func() {
+
+		It("should return the deleted managed resources", func() {
+			di := &lsv1alpha1.DeployItem{}
+			di.Name = "di"
+			di.Namespace = "ns"
+			di.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{
+				"managedResources": [
+					{"policy": "manage", "resource": {"apiVersion": "v1", "kind": "ConfigMap", "name": "cm1", "namespace": "a"}},
+					{"policy": "keep", "resource": {"apiVersion": "v1", "kind": "ConfigMap", "name": "cm2", "namespace": "a"}},
+					{"policy": "ignore", "resource": {"apiVersion": "v1", "kind": "ConfigMap", "name": "cm3", "namespace": "a"}},
+					{"resource": {"apiVersion": "v1", "kind": "Namespace", "name": "b"}}
+				]}`)}
+
+			resources, err := deletion.ManagedResources(di)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(ConsistOf(
+				lsv1alpha1.ManagedResourceReference{
+					DeployItem: lsv1alpha1.ObjectReference{Name: "di", Namespace: "ns"},
+					APIVersion: "v1", Kind: "ConfigMap", Name: "cm1", Namespace: "a",
+				},
+				lsv1alpha1.ManagedResourceReference{
+					DeployItem: lsv1alpha1.ObjectReference{Name: "di", Namespace: "ns"},
+					APIVersion: "v1", Kind: "Namespace", Name: "b",
+				},
+			))
+		})
+
+		It("should handle deploy items without provider status", func() {
+			resources, err := deletion.ManagedResources(&lsv1alpha1.DeployItem{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(BeEmpty())
+		})
+	})
+
+	Context("LimitImpact", func() {
+
+		It("should count the objects and truncate the lists which exceed the maximum", func() {
+			impact := &lsv1alpha1.DeletionImpact{
+				Installations: []lsv1alpha1.ObjectReference{{Name: "inst"}},
+			}
+			for i := 0; i < deletion.MaxImpactObjects+5; i++ {
+				impact.DeployItems = append(impact.DeployItems, lsv1alpha1.ObjectReference{Name: fmt.Sprintf("di-%d", i)})
+			}
+
+			deletion.LimitImpact(impact)
+			Expect(impact.Installations).To(HaveLen(1))
+			Expect(impact.TotalInstallations).To(Equal(int32(1)))
+			Expect(impact.DeployItems).To(HaveLen(deletion.MaxImpactObjects))
+			Expect(impact.DeployItems[0].Name).To(Equal("di-0"))
+			Expect(impact.TotalDeployItems).To(Equal(int32(deletion.MaxImpactObjects + 5)))
+			Expect(impact.TotalExecutions).To(BeZero())
+			Expect(impact.Truncated).To(BeTrue())
+		})
+
+		It("should not truncate small lists", func() {
+			impact := &lsv1alpha1.DeletionImpact{
+				Executions: []lsv1alpha1.ObjectReference{{Name: "exec"}},
+			}
+
+			deletion.LimitImpact(impact)
+			Expect(impact.Executions).To(HaveLen(1))
+			Expect(impact.TotalExecutions).To(Equal(int32(1)))
+			Expect(impact.Truncated).To(BeFalse())
+		})
+	})
+})
@@ -27,6 +27,48 @@ func FetchPredecessorsFromInstallation(installation *lsv1alpha1.Installation,
 	return predecessors
 }
 
+// FetchImportsFromPredecessor returns the names of the imports of the installation which are exported by the given
+// predecessor. The result is sorted and empty if the installation depends on the predecessor only via dependsOn.
+func FetchImportsFromPredecessor(installation, predecessor *lsv1alpha1.Installation) []string {
+	dataExports := sets.New[string]()
+	for _, exp := range predecessor.Spec.Exports.Data {
+		dataExports.Insert(exp.DataRef)
+	}
+	targetExports := sets.New[string]()
+	for _, exp := range predecessor.Spec.Exports.Targets {
+		targetExports.Insert(exp.Target)
+	}
+
+	imports := sets.New[string]()
+	for _, imp := range installation.Spec.Imports.Data {
+		if len(imp.DataRef) != 0 && dataExports.Has(imp.DataRef) {
+			imports.Insert(imp.Name)
+		}
+	}
+
+	for _, imp := range installation.Spec.Imports.Targets {
+		targets := []string{}
+		if len(imp.Target) != 0 {
+			targets = append(targets, imp.Target)
+		} else if len(imp.Targets) != 0 {
+			targets = imp.Targets
+		} else {
+			for _, t := range imp.TargetMap {
+				targets = append(targets, t)
+			}
+		}
+
+		for _, target := range targets {
+			if targetExports.Has(target) {
+				imports.Insert(imp.Name)
+				break
+			}
+		}
+	}
+
+	return sets.List(imports)
+}
+
 func CheckForCyclesAndDuplicateExports(instTemplates []*lsv1alpha1.InstallationTemplate, computeOrder bool) ([]*lsv1alpha1.InstallationTemplate, error) {
 	instNodes := []*installationNode{}
 
@@ -73,36 +115,43 @@ func CheckForCyclesAndDuplicateExports(instTemplates []*lsv1alpha1.InstallationT
 }
 
 type installationNode struct {
-	name    string
-	exports lsv1alpha1.InstallationExports
-	imports lsv1alpha1.InstallationImports
+	name string
+	// siblingName is the name by which sibling installations refer to the installation in their dependsOn list.
+	siblingName string
+	exports     lsv1alpha1.InstallationExports
+	imports     lsv1alpha1.InstallationImports
+	dependsOn   []string
 }
 
 func newInstallationNodeFromInstallation(installation *lsv1alpha1.Installation) *installationNode {
 	return &installationNode{
-		name:    installation.Name,
-		exports: installation.Spec.Exports,
-		imports: installation.Spec.Imports,
+		name:        installation.Name,
+		siblingName: installation.GetSiblingName(),
+		exports:     installation.Spec.Exports,
+		imports:     installation.Spec.Imports,
+		dependsOn:   installation.Spec.DependsOn,
 	}
 }
 
 func newInstallationNodeFromInstallationTemplate(installation *lsv1alpha1.InstallationTemplate) *installationNode {
 	return &installationNode{
-		name:    installation.Name,
-		exports: installation.Exports,
-		imports: installation.Imports,
+		name:        installation.Name,
+		siblingName: installation.Name,
+		exports:     installation.Exports,
+		imports:     installation.Imports,
+		dependsOn:   installation.DependsOn,
 	}
 }
 
 func (r *installationNode) fetchPredecessors(otherNodes []*installationNode) (sets.Set[string], error) {
-	dataExports, targetExports, hasDuplicateExports := r.getExportMaps(otherNodes)
+	dataExports, aggregatedDataExports, targetExports, hasDuplicateExports := r.getExportMaps(otherNodes)
 
 	if hasDuplicateExports {
 		msg := strings.Builder{}
 		msg.WriteString("the following exports are exported by multiple nested installations:")
 		dupExpFound := false
 		for exp, sources := range dataExports {
-			if sources.Len() > 1 {
+			if sources.Len() > 1 && !aggregatedDataExports.Has(exp) {
 				if !dupExpFound {
 					dupExpFound = true
 					msg.WriteString("\n  data exports:")
@@ -164,14 +213,26 @@ func (r *installationNode) fetchPredecessors(otherNodes []*installationNode) (se
 		}
 	}
 
+	if len(r.dependsOn) > 0 {
+		dependsOn := sets.New(r.dependsOn...)
+		for _, sibling := range otherNodes {
+			if sibling.name != r.name && dependsOn.Has(sibling.siblingName) {
+				predecessors.Insert(sibling.name)
+			}
+		}
+	}
+
 	return predecessors, nil
 }
 
 // getExportMaps returns a mapping from sibling export names to the exporting siblings' names.
 // If for any given key the length of its value (a set) is greater than 1, this means that two or more siblings define the same export.
-// The third returned parameter indicates whether this has happened or not (true in case of duplicate exports).
-func (r *installationNode) getExportMaps(otherNodes []*installationNode) (map[string]sets.Set[string], map[string]sets.Set[string], bool) {
+// This is only allowed for data exports which are aggregated by all exporting siblings. The names of these data exports are returned as second parameter.
+// The fourth returned parameter indicates whether a forbidden duplicate export has happened or not (true in case of duplicate exports).
+func (r *installationNode) getExportMaps(otherNodes []*installationNode) (map[string]sets.Set[string], sets.Set[string], map[string]sets.Set[string], bool) {
 	dataExports := map[string]sets.Set[string]{}
+	aggregatedDataExports := sets.New[string]()
+	nonAggregatedDataExports := sets.New[string]()
 	targetExports := map[string]sets.Set[string]{}
 	hasDuplicateExports := false
 
@@ -188,8 +249,10 @@ func (r *installationNode) getExportMaps(otherNodes []*installationNode) (map[st
 				de = sets.New[string]()
 			}
 			de.Insert(sibling.name)
-			if !hasDuplicateExports && de.Len() > 1 {
-				hasDuplicateExports = true
+			if exp.Aggregate != nil {
+				aggregatedDataExports.Insert(exp.DataRef)
+			} else {
+				nonAggregatedDataExports.Insert(exp.DataRef)
 			}
 			dataExports[exp.DataRef] = de
 		}
@@ -208,5 +271,13 @@ func (r *installationNode) getExportMaps(otherNodes []*installationNode) (map[st
 		}
 	}
 
-	return dataExports, targetExports, hasDuplicateExports
+	// multiple exports of the same data object are only allowed if all of them are aggregated
+	aggregatedDataExports = aggregatedDataExports.Difference(nonAggregatedDataExports)
+	for exp, sources := range dataExports {
+		if !hasDuplicateExports && sources.Len() > 1 && !aggregatedDataExports.Has(exp) {
+			hasDuplicateExports = true
+		}
+	}
+
+	return dataExports, aggregatedDataExports, targetExports, hasDuplicateExports
 }
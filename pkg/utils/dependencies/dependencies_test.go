@@ -9,6 +9,8 @@ import (
 	"sort"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -150,6 +152,143 @@ var _ = Describe("Cyclic Dependency Determination Tests", func() {
 			Expect(err.Error()).To(SatisfyAll(matchers...))
 		})
 
+		It("should allow multiple aggregated data exports and order the importer after all contributors", func() {
+			aggregate := &lsv1alpha1.DataExportAggregation{}
+			tmpls := []*lsv1alpha1.InstallationTemplate{
+				{
+					Name: "a",
+					Imports: lsv1alpha1.InstallationImports{
+						Data: []lsv1alpha1.DataImport{{Name: "registry", DataRef: "registry", Aggregated: &lsv1alpha1.DataImportAggregation{}}},
+					},
+				},
+				{
+					Name:    "b",
+					Exports: lsv1alpha1.InstallationExports{Data: []lsv1alpha1.DataExport{{Name: "entry", DataRef: "registry", Aggregate: aggregate}}},
+				},
+				{
+					Name:    "c",
+					Exports: lsv1alpha1.InstallationExports{Data: []lsv1alpha1.DataExport{{Name: "entry", DataRef: "registry", Aggregate: aggregate}}},
+				},
+			}
+			ordered, err := CheckForCyclesAndDuplicateExports(tmpls, true)
+			Expect(err).ToNot(HaveOccurred())
+			indices := stringSliceToIndexMap(installationTemplatesToNames(ordered))
+			Expect(indices["b"]).To(BeNumerically("<", indices["a"]))
+			Expect(indices["c"]).To(BeNumerically("<", indices["a"]))
+		})
+
+		It("should detect duplicate data exports if not all of them are aggregated", func() {
+			tmpls := []*lsv1alpha1.InstallationTemplate{
+				{
+					Name:    "a",
+					Exports: lsv1alpha1.InstallationExports{Data: []lsv1alpha1.DataExport{{Name: "entry", DataRef: "registry"}}},
+				},
+				{
+					Name:    "b",
+					Exports: lsv1alpha1.InstallationExports{Data: []lsv1alpha1.DataExport{{Name: "entry", DataRef: "registry", Aggregate: &lsv1alpha1.DataExportAggregation{}}}},
+				},
+				{
+					Name: "c",
+				},
+			}
+			_, err := CheckForCyclesAndDuplicateExports(tmpls, true)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("'registry' is exported by [a, b]"))
+		})
+
+		It("should correctly order based on explicit dependencies", func() {
+			tmpls := []*lsv1alpha1.InstallationTemplate{
+				{Name: "a", DependsOn: []string{"b", "c"}},
+				{Name: "b", DependsOn: []string{"c"}},
+				{Name: "c"},
+			}
+			ordered, err := CheckForCyclesAndDuplicateExports(tmpls, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(installationTemplatesToNames(ordered)).To(Equal([]string{"c", "b", "a"}))
+		})
+
+		It("should detect cycles of explicit and data dependencies", func() {
+			deps := map[string][]string{
+				"a": {"b"},
+				"b": nil,
+			}
+			tmpls := generateSubinstallationTemplates(deps, newDependencyProvider(dataDependency))
+			sortInstallationTemplatesAlphabetically(tmpls)
+			tmpls[1].DependsOn = []string{"a"}
+			_, err := CheckForCyclesAndDuplicateExports(tmpls, true)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(SatisfyAll(ContainSubstring("a -{depends_on}-> b"), ContainSubstring("b -{depends_on}-> a")))
+		})
+
+	})
+
+	Context("FetchPredecessorsFromInstallation", func() {
+
+		It("should return the explicit dependencies of a root installation", func() {
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "a"
+			inst.Spec.DependsOn = []string{"b"}
+			b := &lsv1alpha1.Installation{}
+			b.Name = "b"
+			c := &lsv1alpha1.Installation{}
+			c.Name = "c"
+
+			predecessors := FetchPredecessorsFromInstallation(inst, []*lsv1alpha1.Installation{inst, b, c})
+			Expect(sets.List(predecessors)).To(Equal([]string{"b"}))
+		})
+
+		It("should resolve the explicit dependencies of a subinstallation by the template names", func() {
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "a-xyz"
+			inst.Annotations = map[string]string{lsv1alpha1.SubinstallationNameAnnotation: "a"}
+			inst.Spec.DependsOn = []string{"b"}
+			b := &lsv1alpha1.Installation{}
+			b.Name = "b-xyz"
+			b.Annotations = map[string]string{lsv1alpha1.SubinstallationNameAnnotation: "b"}
+
+			predecessors := FetchPredecessorsFromInstallation(inst, []*lsv1alpha1.Installation{b})
+			Expect(sets.List(predecessors)).To(Equal([]string{"b-xyz"}))
+			Expect(b.IsSuccessor(inst)).To(BeTrue())
+			Expect(inst.IsSuccessor(b)).To(BeFalse())
+		})
+
+	})
+
+	Context("FetchImportsFromPredecessor", func() {
+
+		It("should return the imports which are exported by the predecessor", func() {
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "a"
+			inst.Spec.Imports.Data = []lsv1alpha1.DataImport{
+				{Name: "imp-1", DataRef: "data-1"},
+				{Name: "imp-2", DataRef: "data-2"},
+			}
+			inst.Spec.Imports.Targets = []lsv1alpha1.TargetImport{
+				{Name: "imp-3", Target: "target-1"},
+				{Name: "imp-4", Targets: []string{"target-2", "target-3"}},
+				{Name: "imp-5", TargetMap: map[string]string{"x": "target-3"}},
+			}
+
+			b := &lsv1alpha1.Installation{}
+			b.Name = "b"
+			b.Spec.Exports.Data = []lsv1alpha1.DataExport{{Name: "exp-1", DataRef: "data-2"}}
+			b.Spec.Exports.Targets = []lsv1alpha1.TargetExport{{Name: "exp-2", Target: "target-3"}}
+
+			Expect(FetchImportsFromPredecessor(inst, b)).To(Equal([]string{"imp-2", "imp-4", "imp-5"}))
+		})
+
+		It("should return no imports for an explicit dependency", func() {
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "a"
+			inst.Spec.DependsOn = []string{"b"}
+			inst.Spec.Imports.Data = []lsv1alpha1.DataImport{{Name: "imp-1", DataRef: "data-1"}}
+
+			b := &lsv1alpha1.Installation{}
+			b.Name = "b"
+
+			Expect(FetchImportsFromPredecessor(inst, b)).To(BeEmpty())
+		})
+
 	})
 })
 
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	kutil "github.com/openmcp-project/landscaper/controller-utils/pkg/kubernetes"
+	lsutil "github.com/openmcp-project/landscaper/pkg/utils"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+var installationGVK = lsv1alpha1.SchemeGroupVersion.WithKind("Installation")
+
+// Starter starts new jobs which reconcile deploy items that are finished.
+// Deploy items are processed by the job of their execution, which in turn gets its job from its installation.
+// Therefore, a new job is started for the root installation of a deploy item, so that the result of the deploy item
+// reaches its execution and installations. Only executions without installation get a new job directly,
+// and deploy items without execution as well.
+// A Starter remembers the objects for which it has started a job, so that every object gets only one new job.
+type Starter struct {
+	lsUncachedClient client.Client
+	reconcileReason  string
+	triggered        sets.Set[string]
+}
+
+// NewStarter returns a new Starter. The reconcile reason is added to the root installations which get a new job.
+func NewStarter(lsUncachedClient client.Client, reconcileReason string) *Starter {
+	return &Starter{
+		lsUncachedClient: lsUncachedClient,
+		reconcileReason:  reconcileReason,
+		triggered:        sets.New[string](),
+	}
+}
+
+// StartJob starts a new job which reconciles the given deploy item.
+// It returns false if no job could be started because a job of the owning object is still running.
+func (s *Starter) StartJob(ctx context.Context, di *lsv1alpha1.DeployItem) (bool, error) {
+	execName, ok := di.Labels[lsv1alpha1.ExecutionManagedByLabel]
+	if !ok {
+		return s.startDeployItemJob(ctx, di)
+	}
+
+	exec := &lsv1alpha1.Execution{}
+	execKey := client.ObjectKey{Name: execName, Namespace: di.Namespace}
+	if err := read_write_layer.GetExecution(ctx, s.lsUncachedClient, execKey, exec, read_write_layer.R000139); err != nil {
+		return false, fmt.Errorf("unable to get execution of deploy item: %w", err)
+	}
+
+	instName, ok := kutil.OwnerOfGVK(exec.OwnerReferences, installationGVK)
+	if !ok {
+		return s.startExecutionJob(ctx, exec)
+	}
+
+	inst, err := s.getRootInstallation(ctx, client.ObjectKey{Name: instName, Namespace: di.Namespace})
+	if err != nil {
+		return false, err
+	}
+	return s.startInstallationJob(ctx, inst)
+}
+
+// getRootInstallation returns the root installation of the installation with the given key.
+func (s *Starter) getRootInstallation(ctx context.Context, key client.ObjectKey) (*lsv1alpha1.Installation, error) {
+	for {
+		inst := &lsv1alpha1.Installation{}
+		if err := read_write_layer.GetInstallation(ctx, s.lsUncachedClient, key, inst, read_write_layer.R000140); err != nil {
+			return nil, fmt.Errorf("unable to get installation %s: %w", key.String(), err)
+		}
+
+		parentName, ok := kutil.OwnerOfGVK(inst.OwnerReferences, installationGVK)
+		if !ok {
+			return inst, nil
+		}
+		key = client.ObjectKey{Name: parentName, Namespace: key.Namespace}
+	}
+}
+
+// startInstallationJob starts a new job for the given root installation by adding a reconcile operation.
+func (s *Starter) startInstallationJob(ctx context.Context, inst *lsv1alpha1.Installation) (bool, error) {
+	key := "installation/" + client.ObjectKeyFromObject(inst).String()
+	if s.triggered.Has(key) || lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) {
+		return true, nil
+	}
+	if inst.Status.JobID != inst.Status.JobIDFinished {
+		return false, nil
+	}
+
+	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ReconcileReasonAnnotation, s.reconcileReason)
+	if err := read_write_layer.NewWriter(s.lsUncachedClient).UpdateInstallation(ctx, read_write_layer.W000188, inst); err != nil {
+		return false, err
+	}
+	s.triggered.Insert(key)
+	return true, nil
+}
+
+// startExecutionJob starts a new job for the given execution, which is not managed by an installation.
+func (s *Starter) startExecutionJob(ctx context.Context, exec *lsv1alpha1.Execution) (bool, error) {
+	key := "execution/" + client.ObjectKeyFromObject(exec).String()
+	if s.triggered.Has(key) {
+		return true, nil
+	}
+	if exec.Status.JobID != exec.Status.JobIDFinished {
+		return false, nil
+	}
+
+	exec.Status.JobID = uuid.New().String()
+	exec.Status.TransitionTimes = lsutil.NewTransitionTimes()
+	if err := read_write_layer.NewWriter(s.lsUncachedClient).UpdateExecutionStatus(ctx, read_write_layer.W000189, exec); err != nil {
+		return false, err
+	}
+	s.triggered.Insert(key)
+	return true, nil
+}
+
+// startDeployItemJob starts a new job for the given deploy item, which is not managed by an execution.
+func (s *Starter) startDeployItemJob(ctx context.Context, di *lsv1alpha1.DeployItem) (bool, error) {
+	now := metav1.Now()
+	di.Status.SetJobID(uuid.New().String())
+	di.Status.JobIDGenerationTime = &now
+	di.Status.TransitionTimes = lsutil.NewTransitionTimes()
+	if err := read_write_layer.NewWriter(s.lsUncachedClient).UpdateDeployItemStatus(ctx, read_write_layer.W000151, di); err != nil {
+		return false, err
+	}
+	return true, nil
+}
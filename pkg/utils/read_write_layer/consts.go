@@ -153,6 +153,53 @@ const (
 	W000147 WriteID = "w000147"
 	W000148 WriteID = "w000148"
 	W000149 WriteID = "w000149"
+	W000150 WriteID = "w000150"
+	W000151 WriteID = "w000151"
+	W000152 WriteID = "w000152"
+	W000153 WriteID = "w000153"
+	W000154 WriteID = "w000154"
+	W000155 WriteID = "w000155"
+	W000156 WriteID = "w000156"
+	W000157 WriteID = "w000157"
+	W000158 WriteID = "w000158"
+	W000159 WriteID = "w000159"
+	W000160 WriteID = "w000160"
+	W000161 WriteID = "w000161"
+	W000162 WriteID = "w000162"
+	W000163 WriteID = "w000163"
+	W000164 WriteID = "w000164"
+	W000165 WriteID = "w000165"
+	W000166 WriteID = "w000166"
+	W000167 WriteID = "w000167"
+	W000168 WriteID = "w000168"
+	W000169 WriteID = "w000169"
+	W000170 WriteID = "w000170"
+	W000171 WriteID = "w000171"
+	W000172 WriteID = "w000172"
+	W000173 WriteID = "w000173"
+	W000174 WriteID = "w000174"
+	W000175 WriteID = "w000175"
+	W000176 WriteID = "w000176"
+	W000177 WriteID = "w000177"
+	W000178 WriteID = "w000178"
+	W000179 WriteID = "w000179"
+	W000180 WriteID = "w000180"
+	W000181 WriteID = "w000181"
+	W000182 WriteID = "w000182"
+	W000183 WriteID = "w000183"
+	W000184 WriteID = "w000184"
+	W000185 WriteID = "w000185"
+	W000186 WriteID = "w000186"
+	W000187 WriteID = "w000187"
+	W000188 WriteID = "w000188"
+	W000189 WriteID = "w000189"
+	W000190 WriteID = "w000190"
+	W000191 WriteID = "w000191"
+	W000192 WriteID = "w000192"
+	W000193 WriteID = "w000193"
+	W000194 WriteID = "w000194"
+	W000195 WriteID = "w000195"
+	W000196 WriteID = "w000196"
 )
 
 type ReadID string
@@ -270,6 +317,40 @@ const (
 	R000108 ReadID = "r000108"
 	R000109 ReadID = "r000109"
 	R000110 ReadID = "r000110"
+	R000111 ReadID = "r000111"
+	R000112 ReadID = "r000112"
+	R000113 ReadID = "r000113"
+	R000114 ReadID = "r000114"
+	R000115 ReadID = "r000115"
+	R000116 ReadID = "r000116"
+	R000117 ReadID = "r000117"
+	R000118 ReadID = "r000118"
+	R000119 ReadID = "r000119"
+	R000120 ReadID = "r000120"
+	R000121 ReadID = "r000121"
+	R000122 ReadID = "r000122"
+	R000123 ReadID = "r000123"
+	R000124 ReadID = "r000124"
+	R000125 ReadID = "r000125"
+	R000126 ReadID = "r000126"
+	R000127 ReadID = "r000127"
+	R000128 ReadID = "r000128"
+	R000129 ReadID = "r000129"
+	R000130 ReadID = "r000130"
+	R000131 ReadID = "r000131"
+	R000132 ReadID = "r000132"
+	R000133 ReadID = "r000133"
+	R000134 ReadID = "r000134"
+	R000135 ReadID = "r000135"
+	R000136 ReadID = "r000136"
+	R000137 ReadID = "r000137"
+	R000138 ReadID = "r000138"
+	R000139 ReadID = "r000139"
+	R000140 ReadID = "r000140"
+	R000141 ReadID = "r000141"
+	R000142 ReadID = "r000142"
+	R000143 ReadID = "r000143"
+	R000144 ReadID = "r000144"
 )
 
 const (
@@ -287,8 +368,11 @@ const (
 	opDISpec                = "history: deployitem update"
 	opDIStatus              = "history: deployitem status update"
 	opDIDelete              = "history: deployitem delete"
+	opInstSetSpec           = "history: installation set update"
+	opInstSetStatus         = "history: installation set status update"
 	opTargetCreateOrUpdate  = "history: target create or update"
 	opTargetDelete          = "history: target delete"
+	opTargetStatus          = "history: target status update"
 	opSyncObjectCreate      = "history: syncobject create"
 	opSyncObjectSpec        = "history: syncobject update"
 	opSyncObjectDelete      = "history: syncobject delete"
@@ -86,6 +86,36 @@ func (w *Writer) logTargetUpdate(ctx context.Context, writeID WriteID, msg strin
 	}
 }
 
+func (w *Writer) logInstallationSetUpdate(ctx context.Context, writeID WriteID, msg string, set *lsv1alpha1.InstallationSet,
+	generationOld int64, resourceVersionOld string, err error) {
+
+	logger := w.getLogger(ctx, keyUpdatedResource, fmt.Sprintf("%s/%s", set.Namespace, set.Name))
+
+	if err == nil {
+		generationNew, resourceVersionNew := getGenerationAndResourceVersion(set)
+		logger.Log(historyLogLevel, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyGenerationNew, generationNew,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+			lc.KeyResourceVersionNew, resourceVersionNew,
+		)
+	} else if apierrors.IsConflict(err) {
+		message := msg + ": " + err.Error()
+		logger.Info(message,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	} else {
+		logger.Error(err, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	}
+}
+
 func (w *Writer) logSyncObjectUpdate(ctx context.Context, writeID WriteID, msg string, syncObject *lsv1alpha1.SyncObject,
 	generationOld int64, resourceVersionOld string, err error) {
 
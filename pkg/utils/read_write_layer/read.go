@@ -78,12 +78,28 @@ func ListTargets(ctx context.Context, c client.Reader, targets *lsv1alpha1.Targe
 	return list(ctx, c, targets, readID, "targets", opts...)
 }
 
+// read methods for target type definitions
+
+func ListTargetTypeDefinitions(ctx context.Context, c client.Reader, definitions *lsv1alpha1.TargetTypeDefinitionList, readID ReadID, opts ...client.ListOption) error {
+	return list(ctx, c, definitions, readID, "targetTypeDefinitions", opts...)
+}
+
 // read methods for data objects
 
 func ListDataObjects(ctx context.Context, c client.Reader, dataObjects *lsv1alpha1.DataObjectList, readID ReadID, opts ...client.ListOption) error {
 	return list(ctx, c, dataObjects, readID, "dataObjects", opts...)
 }
 
+// read methods for installation sets
+
+func GetInstallationSet(ctx context.Context, c client.Reader, key client.ObjectKey, set *lsv1alpha1.InstallationSet, readID ReadID) error {
+	return get(ctx, c, key, set, readID, "installationSet")
+}
+
+func ListInstallationSets(ctx context.Context, c client.Reader, sets *lsv1alpha1.InstallationSetList, readID ReadID, opts ...client.ListOption) error {
+	return list(ctx, c, sets, readID, "installationSets", opts...)
+}
+
 // read methods for targetsync
 
 func ListTargetSyncs(ctx context.Context, c client.Reader, targetSyncs *lsv1alpha1.TargetSyncList, readID ReadID, opts ...client.ListOption) error {
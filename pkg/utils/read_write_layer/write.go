@@ -74,6 +74,13 @@ func (w *Writer) CreateOrUpdateCoreTarget(ctx context.Context, writeID WriteID,
 	return result, errorWithWriteID(err, writeID)
 }
 
+func (w *Writer) UpdateTargetStatus(ctx context.Context, writeID WriteID, target *lsv1alpha1.Target) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(target)
+	err := updateStatus(ctx, w.client.Status(), target, writeID, opTargetStatus)
+	w.logTargetUpdate(ctx, writeID, opTargetStatus, target, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
 func (w *Writer) DeleteTarget(ctx context.Context, writeID WriteID, target *lsv1alpha1.Target) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(target)
 	err := delete(ctx, w.client, target, writeID, opTargetDelete)
@@ -81,6 +88,22 @@ func (w *Writer) DeleteTarget(ctx context.Context, writeID WriteID, target *lsv1
 	return errorWithWriteID(err, writeID)
 }
 
+// methods for installation sets
+
+func (w *Writer) UpdateInstallationSet(ctx context.Context, writeID WriteID, set *lsv1alpha1.InstallationSet) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(set)
+	err := update(ctx, w.client, set, writeID, opInstSetSpec)
+	w.logInstallationSetUpdate(ctx, writeID, opInstSetSpec, set, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
+func (w *Writer) UpdateInstallationSetStatus(ctx context.Context, writeID WriteID, set *lsv1alpha1.InstallationSet) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(set)
+	err := updateStatus(ctx, w.client.Status(), set, writeID, opInstSetStatus)
+	w.logInstallationSetUpdate(ctx, writeID, opInstSetStatus, set, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
 // methods for data objects
 
 func (w *Writer) CreateOrUpdateCoreDataObject(ctx context.Context, writeID WriteID, do *lsv1alpha1.DataObject,
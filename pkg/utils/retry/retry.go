@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package retry
+
+import (
+	"math/rand"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/openmcp-project/landscaper/apis/errors"
+)
+
+const (
+	// DefaultInitialInterval is the waiting time before the first retry, if the retry policy does not specify it.
+	DefaultInitialInterval = 30 * time.Second
+	// DefaultMaxInterval is the upper limit of the waiting time before a retry, if the retry policy does not specify it.
+	DefaultMaxInterval = 10 * time.Minute
+	// DefaultFactor is the factor by which the waiting time grows, if the retry policy does not specify it.
+	DefaultFactor = 2
+	// DefaultJitterPercentage is the maximal random extension of the waiting time in percent, if the retry policy
+	// does not specify it.
+	DefaultJitterPercentage = 10
+)
+
+// handlingErrorCodes are error codes which control the handling of an error, but do not describe its cause.
+var handlingErrorCodes = []lsv1alpha1.ErrorCode{
+	lsv1alpha1.ErrorUnfinished,
+	lsv1alpha1.ErrorForInfoOnly,
+	lsv1alpha1.ErrorNoRetry,
+}
+
+// FailureCodes returns the error codes of the given errors which describe the cause of a failure, i.e. without the
+// codes which only control the handling of an error. Every code is contained only once.
+func FailureCodes(errs ...*lsv1alpha1.Error) []lsv1alpha1.ErrorCode {
+	codes := []lsv1alpha1.ErrorCode{}
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		for _, code := range err.Codes {
+			if !lserrors.HasErrorCode(handlingErrorCodes, code) && !lserrors.HasErrorCode(codes, code) {
+				codes = append(codes, code)
+			}
+		}
+	}
+	return codes
+}
+
+// IsRetryable returns whether a failure with the given error codes is retried according to the retry policy.
+func IsRetryable(policy *lsv1alpha1.RetryPolicy, codes []lsv1alpha1.ErrorCode) bool {
+	if policy == nil {
+		return false
+	}
+	if lserrors.ContainsAnyErrorCode(codes, policy.NoRetryOn) {
+		return false
+	}
+	return len(policy.RetryOn) == 0 || lserrors.ContainsAnyErrorCode(codes, policy.RetryOn)
+}
+
+// MaxAttemptsReached returns whether the maximal number of retries of the retry policy has been started.
+func MaxAttemptsReached(policy *lsv1alpha1.RetryPolicy, attempts int32) bool {
+	return policy != nil && policy.MaxAttempts != nil && attempts >= *policy.MaxAttempts
+}
+
+// Backoff returns the waiting time before the retry with the given number, starting with 1, without jitter.
+func Backoff(policy *lsv1alpha1.RetryPolicy, attempt int32) time.Duration {
+	initialInterval, maxInterval, factor := DefaultInitialInterval, DefaultMaxInterval, time.Duration(DefaultFactor)
+	if policy != nil && policy.Backoff != nil {
+		if policy.Backoff.InitialInterval != nil {
+			initialInterval = policy.Backoff.InitialInterval.Duration
+		}
+		if policy.Backoff.MaxInterval != nil {
+			maxInterval = policy.Backoff.MaxInterval.Duration
+		}
+		if policy.Backoff.Factor != nil {
+			factor = time.Duration(*policy.Backoff.Factor)
+		}
+	}
+
+	backoff := initialInterval
+	for i := int32(1); i < attempt && backoff < maxInterval; i++ {
+		backoff *= factor
+	}
+	if backoff > maxInterval {
+		return maxInterval
+	}
+	return backoff
+}
+
+// Jitter returns the random extension of the given waiting time. The random value must be in the interval [0,1).
+func Jitter(policy *lsv1alpha1.RetryPolicy, backoff time.Duration, random float64) time.Duration {
+	percentage := int32(DefaultJitterPercentage)
+	if policy != nil && policy.Backoff != nil && policy.Backoff.JitterPercentage != nil {
+		percentage = *policy.Backoff.JitterPercentage
+	}
+	return time.Duration(float64(backoff) * float64(percentage) / 100 * random)
+}
+
+// NextRetryTime returns the time of the retry with the given number after a failure at the given time.
+func NextRetryTime(policy *lsv1alpha1.RetryPolicy, attempt int32, failureTime time.Time) time.Time {
+	backoff := Backoff(policy, attempt)
+	return failureTime.Add(backoff + Jitter(policy, backoff, rand.Float64()))
+}
+
+// Plan computes the next step of the retries of a failed object with the given generation, whose failure has the
+// given error codes. It returns the updated retry status and whether a retry has to be started now.
+// The retries are counted anew if the generation of the object has changed. If a retry is possible, but not yet due,
+// the returned status contains the time of the next retry. The status must be reset when the object has succeeded.
+func Plan(policy *lsv1alpha1.RetryPolicy, status *lsv1alpha1.RetryStatus, generation int64,
+	codes []lsv1alpha1.ErrorCode, now time.Time) (*lsv1alpha1.RetryStatus, bool) {
+
+	if status == nil || status.Generation != generation {
+		status = &lsv1alpha1.RetryStatus{Generation: generation}
+	} else {
+		status = status.DeepCopy()
+	}
+
+	if !IsRetryable(policy, codes) || MaxAttemptsReached(policy, status.Attempts) {
+		status.NextRetryTime = nil
+		return status, false
+	}
+
+	if status.NextRetryTime == nil {
+		nextRetryTime := metav1.NewTime(NextRetryTime(policy, status.Attempts+1, now))
+		status.NextRetryTime = &nextRetryTime
+		return status, false
+	}
+
+	if now.Before(status.NextRetryTime.Time) {
+		return status, false
+	}
+
+	attemptTime := metav1.NewTime(now)
+	status.Attempts++
+	status.LastAttemptTime = &attemptTime
+	status.NextRetryTime = nil
+	return status, true
+}
+
+// TimeUntilNextRetry returns the time until the next scheduled retry, or 0 if no retry is scheduled.
+func TimeUntilNextRetry(status *lsv1alpha1.RetryStatus, now time.Time) time.Duration {
+	if status == nil || status.NextRetryTime == nil {
+		return 0
+	}
+
+	d := status.NextRetryTime.Sub(now)
+	if d < 0 {
+		return 0
+	}
+	return d
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package retry_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/pkg/utils/retry"
+)
+
+var _ = Describe("Retry", func() {
+
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	It("should collect the codes describing the cause of a failure", func() {
+		codes := retry.FailureCodes(
+			&lsv1alpha1.Error{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorForInfoOnly}},
+			nil,
+			&lsv1alpha1.Error{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorInternalProblem}},
+		)
+		Expect(codes).To(Equal([]lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorInternalProblem}))
+	})
+
+	It("should match the error codes of a failure", func() {
+		policy := &lsv1alpha1.RetryPolicy{
+			RetryOn:   []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorInternalProblem},
+			NoRetryOn: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem},
+		}
+
+		Expect(retry.IsRetryable(policy, []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout})).To(BeTrue())
+		Expect(retry.IsRetryable(policy, []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorUnauthorized})).To(BeFalse())
+		Expect(retry.IsRetryable(policy, nil)).To(BeFalse())
+		Expect(retry.IsRetryable(policy, []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorConfigurationProblem})).To(BeFalse())
+
+		policy.RetryOn = nil
+		Expect(retry.IsRetryable(policy, nil)).To(BeTrue())
+		Expect(retry.IsRetryable(policy, []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem})).To(BeFalse())
+		Expect(retry.IsRetryable(nil, nil)).To(BeFalse())
+	})
+
+	It("should compute an exponential backoff", func() {
+		policy := &lsv1alpha1.RetryPolicy{
+			Backoff: &lsv1alpha1.RetryBackoff{
+				InitialInterval: &lsv1alpha1.Duration{Duration: 10 * time.Second},
+				MaxInterval:     &lsv1alpha1.Duration{Duration: time.Minute},
+				Factor:          ptr.To[int32](3),
+			},
+		}
+
+		Expect(retry.Backoff(policy, 1)).To(Equal(10 * time.Second))
+		Expect(retry.Backoff(policy, 2)).To(Equal(30 * time.Second))
+		Expect(retry.Backoff(policy, 3)).To(Equal(time.Minute))
+		Expect(retry.Backoff(policy, 100)).To(Equal(time.Minute))
+
+		Expect(retry.Backoff(&lsv1alpha1.RetryPolicy{}, 1)).To(Equal(retry.DefaultInitialInterval))
+		Expect(retry.Backoff(&lsv1alpha1.RetryPolicy{}, 2)).To(Equal(2 * retry.DefaultInitialInterval))
+	})
+
+	It("should extend the backoff by a jitter", func() {
+		policy := &lsv1alpha1.RetryPolicy{
+			Backoff: &lsv1alpha1.RetryBackoff{JitterPercentage: ptr.To[int32](20)},
+		}
+
+		Expect(retry.Jitter(policy, 100*time.Second, 0)).To(Equal(time.Duration(0)))
+		Expect(retry.Jitter(policy, 100*time.Second, 0.5)).To(Equal(10 * time.Second))
+		Expect(retry.Jitter(&lsv1alpha1.RetryPolicy{}, 100*time.Second, 0.5)).To(Equal(5 * time.Second))
+
+		nextRetryTime := retry.NextRetryTime(policy, 1, now)
+		Expect(nextRetryTime).To(BeTemporally(">=", now.Add(retry.DefaultInitialInterval)))
+		Expect(nextRetryTime).To(BeTemporally("<", now.Add(retry.DefaultInitialInterval*6/5)))
+	})
+
+	It("should schedule and start retries until the maximal number of attempts is reached", func() {
+		policy := &lsv1alpha1.RetryPolicy{
+			MaxAttempts: ptr.To[int32](2),
+			Backoff:     &lsv1alpha1.RetryBackoff{JitterPercentage: ptr.To[int32](0)},
+		}
+		codes := []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout}
+
+		status, start := retry.Plan(policy, nil, 1, codes, now)
+		Expect(start).To(BeFalse())
+		Expect(status.Attempts).To(BeEquivalentTo(0))
+		Expect(status.NextRetryTime.Time).To(Equal(now.Add(30 * time.Second)))
+		Expect(retry.TimeUntilNextRetry(status, now)).To(Equal(30 * time.Second))
+
+		status, start = retry.Plan(policy, status, 1, codes, now.Add(10*time.Second))
+		Expect(start).To(BeFalse())
+		Expect(status.NextRetryTime).NotTo(BeNil())
+
+		status, start = retry.Plan(policy, status, 1, codes, now.Add(30*time.Second))
+		Expect(start).To(BeTrue())
+		Expect(status.Attempts).To(BeEquivalentTo(1))
+		Expect(status.LastAttemptTime.Time).To(Equal(now.Add(30 * time.Second)))
+		Expect(status.NextRetryTime).To(BeNil())
+
+		now = now.Add(time.Minute)
+		status, start = retry.Plan(policy, status, 1, codes, now)
+		Expect(start).To(BeFalse())
+		Expect(status.NextRetryTime.Time).To(Equal(now.Add(time.Minute)))
+
+		status, start = retry.Plan(policy, status, 1, codes, now.Add(time.Minute))
+		Expect(start).To(BeTrue())
+		Expect(status.Attempts).To(BeEquivalentTo(2))
+
+		status, start = retry.Plan(policy, status, 1, codes, now.Add(time.Hour))
+		Expect(start).To(BeFalse())
+		Expect(status.NextRetryTime).To(BeNil())
+		Expect(retry.TimeUntilNextRetry(status, now)).To(Equal(time.Duration(0)))
+
+		// a new generation resets the retries
+		status, start = retry.Plan(policy, status, 2, codes, now)
+		Expect(start).To(BeFalse())
+		Expect(status.Attempts).To(BeEquivalentTo(0))
+		Expect(status.NextRetryTime).NotTo(BeNil())
+	})
+
+	It("should not schedule a retry for a failure which is not retryable", func() {
+		policy := &lsv1alpha1.RetryPolicy{
+			NoRetryOn: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem},
+		}
+
+		status, start := retry.Plan(policy, nil, 1, []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem}, now)
+		Expect(start).To(BeFalse())
+		Expect(status.NextRetryTime).To(BeNil())
+	})
+})
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+)
+
+// Group is a unit of a rollout, i.e. the objects deploying to one element of a targetList or targetMap import.
+type Group struct {
+	// Name identifies the element of the targetList or targetMap import.
+	Name string
+	// Active is true if the group has objects which are running or can be started in the current job.
+	Active bool
+	// Failed is true if an object of the group has failed in the current job.
+	Failed bool
+}
+
+// StopOnFailure returns whether no further waves are released after a failure.
+func StopOnFailure(policy *lsv1alpha1.RolloutPolicy) bool {
+	return policy == nil || policy.StopOnFailure == nil || *policy.StopOnFailure
+}
+
+// BatchSize returns the number of groups which are released in one wave.
+func BatchSize(policy *lsv1alpha1.RolloutPolicy, groupCount int) int {
+	batchSize := groupCount
+	if policy != nil && policy.BatchSize != nil {
+		batchSize = int(*policy.BatchSize)
+	} else if policy != nil && policy.BatchPercentage != nil {
+		batchSize = (groupCount*int(*policy.BatchPercentage) + 99) / 100
+	}
+
+	if batchSize < 1 {
+		return 1
+	}
+	return batchSize
+}
+
+// Waves splits the ordered group names into waves.
+func Waves(policy *lsv1alpha1.RolloutPolicy, groupNames []string) [][]string {
+	batchSize := BatchSize(policy, len(groupNames))
+
+	waves := [][]string{}
+	for start := 0; start < len(groupNames); start += batchSize {
+		end := start + batchSize
+		if end > len(groupNames) {
+			end = len(groupNames)
+		}
+		waves = append(waves, groupNames[start:end])
+	}
+	return waves
+}
+
+// Plan computes which groups are released in the current job. The first wave is released immediately.
+// The next wave is released when no group of the released waves is active anymore, the pause between the waves has
+// elapsed, and, if the policy stops on failure, no group of the released waves has failed.
+// Plan releases at most one further wave per call and returns the updated rollout status together with the names of
+// the released groups.
+func Plan(policy *lsv1alpha1.RolloutPolicy, jobID string, groups []Group, status *lsv1alpha1.RolloutStatus,
+	now time.Time) (*lsv1alpha1.RolloutStatus, sets.Set[string]) {
+
+	groupNames := make([]string, len(groups))
+	for i := range groups {
+		groupNames[i] = groups[i].Name
+	}
+	waves := Waves(policy, groupNames)
+
+	if status == nil || status.JobID != jobID {
+		status = &lsv1alpha1.RolloutStatus{JobID: jobID, CurrentWave: 1}
+	} else {
+		status = status.DeepCopy()
+	}
+
+	status.Waves = int32(len(waves))
+	if status.CurrentWave > status.Waves {
+		status.CurrentWave = status.Waves
+	}
+
+	if status.CurrentWave < status.Waves {
+		released := releasedGroups(waves, status.CurrentWave)
+
+		active, failed := false, false
+		for _, group := range groups {
+			if released.Has(group.Name) {
+				active = active || group.Active
+				failed = failed || group.Failed
+			}
+		}
+
+		switch {
+		case active || (failed && StopOnFailure(policy)):
+			status.NextWaveTime = nil
+		case policy != nil && policy.PauseBetweenWaves != nil && policy.PauseBetweenWaves.Duration > 0 && status.NextWaveTime == nil:
+			nextWaveTime := metav1.NewTime(now.Add(policy.PauseBetweenWaves.Duration))
+			status.NextWaveTime = &nextWaveTime
+		case status.NextWaveTime != nil && now.Before(status.NextWaveTime.Time):
+			// the pause between the waves has not yet elapsed
+		default:
+			status.CurrentWave++
+			status.NextWaveTime = nil
+		}
+	} else {
+		status.NextWaveTime = nil
+	}
+
+	return status, releasedGroups(waves, status.CurrentWave)
+}
+
+// TimeUntilNextWave returns the time until the next wave is released, or zero if the rollout is not paused.
+func TimeUntilNextWave(status *lsv1alpha1.RolloutStatus, now time.Time) time.Duration {
+	if status == nil || status.NextWaveTime == nil {
+		return 0
+	}
+
+	d := status.NextWaveTime.Sub(now)
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func releasedGroups(waves [][]string, releasedWaves int32) sets.Set[string] {
+	released := sets.New[string]()
+	for i := 0; i < int(releasedWaves) && i < len(waves); i++ {
+		released.Insert(waves[i]...)
+	}
+	return released
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/pkg/utils/rollout"
+)
+
+var _ = Describe("Rollout", func() {
+
+	var now time.Time
+
+	newGroups := func(names ...string) []rollout.Group {
+		groups := make([]rollout.Group, len(names))
+		for i := range names {
+			groups[i] = rollout.Group{Name: names[i], Active: true}
+		}
+		return groups
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	It("should split the groups into waves", func() {
+		Expect(rollout.Waves(&lsv1alpha1.RolloutPolicy{BatchSize: ptr.To[int32](2)}, []string{"a", "b", "c"})).
+			To(Equal([][]string{{"a", "b"}, {"c"}}))
+		Expect(rollout.Waves(&lsv1alpha1.RolloutPolicy{BatchPercentage: ptr.To[int32](25)}, []string{"a", "b", "c"})).
+			To(Equal([][]string{{"a"}, {"b"}, {"c"}}))
+		Expect(rollout.Waves(&lsv1alpha1.RolloutPolicy{}, []string{"a", "b", "c"})).
+			To(Equal([][]string{{"a", "b", "c"}}))
+		Expect(rollout.Waves(&lsv1alpha1.RolloutPolicy{}, nil)).To(BeEmpty())
+	})
+
+	It("should release the first wave of a new job", func() {
+		policy := &lsv1alpha1.RolloutPolicy{BatchSize: ptr.To[int32](1)}
+		oldStatus := &lsv1alpha1.RolloutStatus{JobID: "job-0", CurrentWave: 2, Waves: 2}
+
+		status, released := rollout.Plan(policy, "job-1", newGroups("a", "b"), oldStatus, now)
+
+		Expect(status.JobID).To(Equal("job-1"))
+		Expect(status.CurrentWave).To(Equal(int32(1)))
+		Expect(status.Waves).To(Equal(int32(2)))
+		Expect(released.UnsortedList()).To(ConsistOf("a"))
+	})
+
+	It("should release the next wave when the released waves are finished", func() {
+		policy := &lsv1alpha1.RolloutPolicy{BatchSize: ptr.To[int32](1)}
+		groups := newGroups("a", "b", "c")
+
+		status, released := rollout.Plan(policy, "job-1", groups, nil, now)
+		Expect(status.CurrentWave).To(Equal(int32(1)))
+		Expect(released.UnsortedList()).To(ConsistOf("a"))
+
+		groups[0].Active = false
+		status, released = rollout.Plan(policy, "job-1", groups, status, now)
+		Expect(status.CurrentWave).To(Equal(int32(2)))
+		Expect(released.UnsortedList()).To(ConsistOf("a", "b"))
+	})
+
+	It("should pause between the waves", func() {
+		policy := &lsv1alpha1.RolloutPolicy{
+			BatchSize:         ptr.To[int32](1),
+			PauseBetweenWaves: &lsv1alpha1.Duration{Duration: time.Minute},
+		}
+		groups := newGroups("a", "b")
+
+		status, _ := rollout.Plan(policy, "job-1", groups, nil, now)
+		Expect(status.CurrentWave).To(Equal(int32(1)))
+		Expect(status.NextWaveTime).To(BeNil())
+
+		groups[0].Active = false
+		status, released := rollout.Plan(policy, "job-1", groups, status, now)
+		Expect(status.CurrentWave).To(Equal(int32(1)))
+		Expect(status.NextWaveTime).ToNot(BeNil())
+		Expect(status.NextWaveTime.Time).To(Equal(now.Add(time.Minute)))
+		Expect(rollout.TimeUntilNextWave(status, now)).To(Equal(time.Minute))
+		Expect(released.UnsortedList()).To(ConsistOf("a"))
+
+		status, _ = rollout.Plan(policy, "job-1", groups, status, now.Add(30*time.Second))
+		Expect(status.CurrentWave).To(Equal(int32(1)))
+
+		status, released = rollout.Plan(policy, "job-1", groups, status, now.Add(time.Minute))
+		Expect(status.CurrentWave).To(Equal(int32(2)))
+		Expect(status.NextWaveTime).To(BeNil())
+		Expect(released.UnsortedList()).To(ConsistOf("a", "b"))
+	})
+
+	It("should stop on failure", func() {
+		policy := &lsv1alpha1.RolloutPolicy{BatchSize: ptr.To[int32](1)}
+		groups := newGroups("a", "b")
+		groups[0].Active = false
+		groups[0].Failed = true
+
+		status, released := rollout.Plan(policy, "job-1", groups, nil, now)
+		Expect(status.CurrentWave).To(Equal(int32(1)))
+		Expect(released.UnsortedList()).To(ConsistOf("a"))
+	})
+
+	It("should continue after a failure if configured", func() {
+		policy := &lsv1alpha1.RolloutPolicy{BatchSize: ptr.To[int32](1), StopOnFailure: ptr.To(false)}
+		groups := newGroups("a", "b")
+		groups[0].Active = false
+		groups[0].Failed = true
+
+		status, released := rollout.Plan(policy, "job-1", groups, nil, now)
+		Expect(status.CurrentWave).To(Equal(int32(2)))
+		Expect(released.UnsortedList()).To(ConsistOf("a", "b"))
+	})
+})
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package summary
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+)
+
+const (
+	// MaxFailedObjects limits the number of failed objects in a summary, so that the status of the root installation
+	// of a large tree does not grow without bounds.
+	MaxFailedObjects = 20
+
+	// UnknownPhase is the phase under which objects without phase are counted.
+	UnknownPhase = "Unknown"
+)
+
+// Compute aggregates the status of the subtree of an installation. The subinstallations contribute their own phase
+// and the summaries of their subtrees, so that the subtree need not be traversed. The execution and its deploy items
+// contribute their phases. An object has finished if it has finished the current job of the installation.
+func Compute(inst *lsv1alpha1.Installation, subInsts []*lsv1alpha1.Installation, exec *lsv1alpha1.Execution,
+	deployItems []*lsv1alpha1.DeployItem) *lsv1alpha1.SubtreeSummary {
+
+	summary := &lsv1alpha1.SubtreeSummary{}
+
+	for _, subInst := range sortedByName(subInsts) {
+		summary.Installations = increment(summary.Installations, string(subInst.Status.InstallationPhase))
+		summary.TotalObjects++
+
+		inCurrentJob := subInst.Status.JobID == inst.Status.JobID
+		if inCurrentJob && subInst.Status.JobIDFinished == subInst.Status.JobID {
+			summary.FinishedObjects++
+		}
+
+		childSummary := subInst.Status.Summary
+		if childSummary != nil {
+			summary.Installations = merge(summary.Installations, childSummary.Installations)
+			summary.Executions = merge(summary.Executions, childSummary.Executions)
+			summary.DeployItems = merge(summary.DeployItems, childSummary.DeployItems)
+			summary.TotalObjects += childSummary.TotalObjects
+			if inCurrentJob {
+				summary.FinishedObjects += childSummary.FinishedObjects
+			}
+			summary.FailedObjects = append(summary.FailedObjects, childSummary.FailedObjects...)
+		}
+
+		if subInst.Status.InstallationPhase.IsFailed() && (childSummary == nil || len(childSummary.FailedObjects) == 0) {
+			summary.FailedObjects = append(summary.FailedObjects,
+				newFailedObject(utils.InstallationKind, subInst, subInst.Status.LastError))
+		}
+	}
+
+	if exec != nil {
+		summary.Executions = increment(summary.Executions, string(exec.Status.ExecutionPhase))
+		summary.TotalObjects++
+		if exec.Status.JobID == inst.Status.JobID && exec.Status.JobIDFinished == exec.Status.JobID {
+			summary.FinishedObjects++
+		}
+
+		hasFailedDeployItems := false
+		for _, di := range sortedByName(deployItems) {
+			summary.DeployItems = increment(summary.DeployItems, string(di.Status.Phase))
+			summary.TotalObjects++
+			if di.Status.JobID == exec.Status.JobID && di.Status.JobIDFinished == di.Status.JobID {
+				summary.FinishedObjects++
+			}
+
+			if di.Status.Phase.IsFailed() {
+				hasFailedDeployItems = true
+				summary.FailedObjects = append(summary.FailedObjects,
+					newFailedObject(utils.DeployItemKind, di, di.Status.LastError))
+			}
+		}
+
+		if exec.Status.ExecutionPhase.IsFailed() && !hasFailedDeployItems {
+			summary.FailedObjects = append(summary.FailedObjects,
+				newFailedObject(utils.ExecutionKind, exec, exec.Status.LastError))
+		}
+	}
+
+	if len(summary.FailedObjects) > MaxFailedObjects {
+		summary.FailedObjects = summary.FailedObjects[:MaxFailedObjects]
+	}
+
+	summary.Progress = 100
+	if summary.TotalObjects > 0 {
+		summary.Progress = summary.FinishedObjects * 100 / summary.TotalObjects
+	}
+
+	return summary
+}
+
+func newFailedObject(kind string, obj metav1.Object, lastError *lsv1alpha1.Error) lsv1alpha1.FailedObject {
+	failedObject := lsv1alpha1.FailedObject{
+		Object: lsv1alpha1.TypedObjectReference{
+			APIVersion:      lsv1alpha1.SchemeGroupVersion.String(),
+			Kind:            kind,
+			ObjectReference: lsv1alpha1helper.ObjectReferenceFromObject(obj),
+		},
+	}
+	if lastError != nil {
+		failedObject.Reason = lastError.Reason
+		failedObject.Codes = lastError.Codes
+	}
+	return failedObject
+}
+
+func increment(counts map[string]int32, phase string) map[string]int32 {
+	if len(phase) == 0 {
+		phase = UnknownPhase
+	}
+	if counts == nil {
+		counts = map[string]int32{}
+	}
+	counts[phase]++
+	return counts
+}
+
+func merge(counts, other map[string]int32) map[string]int32 {
+	for phase, count := range other {
+		if counts == nil {
+			counts = map[string]int32{}
+		}
+		counts[phase] += count
+	}
+	return counts
+}
+
+func sortedByName[T metav1.Object](objects []T) []T {
+	sorted := make([]T, len(objects))
+	copy(sorted, objects)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetName() < sorted[j].GetName()
+	})
+	return sorted
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package summary_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	"github.com/openmcp-project/landscaper/pkg/utils/summary"
+)
+
+var _ = Describe("Summary", func() {
+
+	newInstallation := func(name, jobID, jobIDFinished string, phase lsv1alpha1.InstallationPhase) *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = name
+		inst.Namespace = "test"
+		inst.Status.JobID = jobID
+		inst.Status.JobIDFinished = jobIDFinished
+		inst.Status.InstallationPhase = phase
+		return inst
+	}
+
+	newExecution := func(jobID, jobIDFinished string, phase lsv1alpha1.ExecutionPhase) *lsv1alpha1.Execution {
+		exec := &lsv1alpha1.Execution{}
+		exec.Name = "exec"
+		exec.Namespace = "test"
+		exec.Status.JobID = jobID
+		exec.Status.JobIDFinished = jobIDFinished
+		exec.Status.ExecutionPhase = phase
+		return exec
+	}
+
+	newDeployItem := func(name, jobID, jobIDFinished string, phase lsv1alpha1.DeployItemPhase) *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = name
+		di.Namespace = "test"
+		di.Status.JobID = jobID
+		di.Status.JobIDFinished = jobIDFinished
+		di.Status.Phase = phase
+		return di
+	}
+
+	It("should count the execution and the deploy items by phase", func() {
+		inst := newInstallation("root", "job", "", lsv1alpha1.InstallationPhases.Progressing)
+		exec := newExecution("job", "", lsv1alpha1.ExecutionPhases.Progressing)
+		deployItems := []*lsv1alpha1.DeployItem{
+			newDeployItem("a", "job", "job", lsv1alpha1.DeployItemPhases.Succeeded),
+			newDeployItem("b", "job", "", lsv1alpha1.DeployItemPhases.Progressing),
+			newDeployItem("c", "old-job", "old-job", ""),
+		}
+
+		s := summary.Compute(inst, nil, exec, deployItems)
+		Expect(s.Installations).To(BeEmpty())
+		Expect(s.Executions).To(Equal(map[string]int32{"Progressing": 1}))
+		Expect(s.DeployItems).To(Equal(map[string]int32{"Succeeded": 1, "Progressing": 1, summary.UnknownPhase: 1}))
+		Expect(s.FailedObjects).To(BeEmpty())
+		Expect(s.TotalObjects).To(Equal(int32(4)))
+		Expect(s.FinishedObjects).To(Equal(int32(1)))
+		Expect(s.Progress).To(Equal(int32(25)))
+	})
+
+	It("should aggregate the summaries of the subinstallations", func() {
+		inst := newInstallation("root", "job", "", lsv1alpha1.InstallationPhases.Progressing)
+
+		sub1 := newInstallation("sub1", "job", "job", lsv1alpha1.InstallationPhases.Succeeded)
+		sub1.Status.Summary = &lsv1alpha1.SubtreeSummary{
+			Installations:   map[string]int32{"Succeeded": 1},
+			Executions:      map[string]int32{"Succeeded": 2},
+			DeployItems:     map[string]int32{"Succeeded": 3},
+			FinishedObjects: 6,
+			TotalObjects:    6,
+			Progress:        100,
+		}
+
+		// the subinstallation is waiting for a later wave of the rollout
+		sub2 := newInstallation("sub2", "old-job", "old-job", lsv1alpha1.InstallationPhases.Succeeded)
+		sub2.Status.Summary = &lsv1alpha1.SubtreeSummary{
+			Executions:      map[string]int32{"Succeeded": 1},
+			DeployItems:     map[string]int32{"Succeeded": 1},
+			FinishedObjects: 2,
+			TotalObjects:    2,
+			Progress:        100,
+		}
+
+		s := summary.Compute(inst, []*lsv1alpha1.Installation{sub2, sub1}, nil, nil)
+		Expect(s.Installations).To(Equal(map[string]int32{"Succeeded": 3}))
+		Expect(s.Executions).To(Equal(map[string]int32{"Succeeded": 3}))
+		Expect(s.DeployItems).To(Equal(map[string]int32{"Succeeded": 4}))
+		Expect(s.TotalObjects).To(Equal(int32(10)))
+		Expect(s.FinishedObjects).To(Equal(int32(7)))
+		Expect(s.Progress).To(Equal(int32(70)))
+	})
+
+	It("should list the objects where the failures originate", func() {
+		inst := newInstallation("root", "job", "", lsv1alpha1.InstallationPhases.Progressing)
+
+		// failed because of a failed deploy item in its subtree
+		sub1 := newInstallation("sub1", "job", "job", lsv1alpha1.InstallationPhases.Failed)
+		sub1.Status.LastError = &lsv1alpha1.Error{Reason: "children failed"}
+		sub1.Status.Summary = &lsv1alpha1.SubtreeSummary{
+			FailedObjects: []lsv1alpha1.FailedObject{{
+				Object: lsv1alpha1.TypedObjectReference{Kind: "DeployItem", ObjectReference: lsv1alpha1.ObjectReference{Name: "nested"}},
+				Reason: "timeout",
+				Codes:  []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout},
+			}},
+		}
+
+		// failed without failed children
+		sub2 := newInstallation("sub2", "job", "job", lsv1alpha1.InstallationPhases.Failed)
+		sub2.Status.LastError = &lsv1alpha1.Error{Reason: "ImportsSatisfied", Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem}}
+
+		exec := newExecution("job", "job", lsv1alpha1.ExecutionPhases.Failed)
+		deployItems := []*lsv1alpha1.DeployItem{
+			newDeployItem("a", "job", "job", lsv1alpha1.DeployItemPhases.Failed),
+		}
+		deployItems[0].Status.LastError = &lsv1alpha1.Error{Reason: "apply", Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorInternalProblem}}
+
+		s := summary.Compute(inst, []*lsv1alpha1.Installation{sub1, sub2}, exec, deployItems)
+		Expect(s.FailedObjects).To(HaveLen(3))
+		Expect(s.FailedObjects[0].Object.Name).To(Equal("nested"))
+		Expect(s.FailedObjects[1].Object.Kind).To(Equal("Installation"))
+		Expect(s.FailedObjects[1].Object.Name).To(Equal("sub2"))
+		Expect(s.FailedObjects[1].Reason).To(Equal("ImportsSatisfied"))
+		Expect(s.FailedObjects[1].Codes).To(ConsistOf(lsv1alpha1.ErrorConfigurationProblem))
+		Expect(s.FailedObjects[2].Object.Kind).To(Equal("DeployItem"))
+		Expect(s.FailedObjects[2].Object.Name).To(Equal("a"))
+		Expect(s.FailedObjects[2].Codes).To(ConsistOf(lsv1alpha1.ErrorInternalProblem))
+	})
+
+	It("should list a failed execution without failed deploy items", func() {
+		inst := newInstallation("root", "job", "", lsv1alpha1.InstallationPhases.Progressing)
+		exec := newExecution("job", "job", lsv1alpha1.ExecutionPhases.Failed)
+		exec.Status.LastError = &lsv1alpha1.Error{Reason: "TriggerDeployItems"}
+
+		s := summary.Compute(inst, nil, exec, nil)
+		Expect(s.FailedObjects).To(HaveLen(1))
+		Expect(s.FailedObjects[0].Object.Kind).To(Equal("Execution"))
+		Expect(s.FailedObjects[0].Reason).To(Equal("TriggerDeployItems"))
+		Expect(s.Progress).To(Equal(int32(100)))
+	})
+
+	It("should limit the number of failed objects", func() {
+		inst := newInstallation("root", "job", "", lsv1alpha1.InstallationPhases.Progressing)
+		exec := newExecution("job", "job", lsv1alpha1.ExecutionPhases.Failed)
+		deployItems := []*lsv1alpha1.DeployItem{}
+		for i := 0; i < summary.MaxFailedObjects+5; i++ {
+			deployItems = append(deployItems, newDeployItem(string(rune('a'+i)), "job", "job", lsv1alpha1.DeployItemPhases.Failed))
+		}
+
+		s := summary.Compute(inst, nil, exec, deployItems)
+		Expect(s.FailedObjects).To(HaveLen(summary.MaxFailedObjects))
+		Expect(s.DeployItems).To(Equal(map[string]int32{"Failed": int32(summary.MaxFailedObjects + 5)}))
+	})
+})
@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: Copyright OpenControlPlane contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	lscore "github.com/openmcp-project/landscaper/apis/core"
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/openmcp-project/landscaper/apis/core/v1alpha1/helper"
+	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
+	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
+	webhooklib "github.com/openmcp-project/landscaper/controller-utils/pkg/webhook"
+	"github.com/openmcp-project/landscaper/pkg/utils"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
+)
+
+// INSTALLATION DELETION PROTECTION
+
+// InstallationDeletionProtectionWebhookLogic rejects the deletion of installations with the deletion protection
+// annotation. For such installations, it also rejects updates that prepare a deletion bypassing the protection,
+// i.e. setting the delete-without-uninstall annotation, or removing the finalizer before the deletion.
+var InstallationDeletionProtectionWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "InstallationDeletionProtectionWebhookLogic"})
+
+	oldInst := &lscore.Installation{}
+	if _, _, err := dec.Decode(req.OldObject.Raw, nil, oldInst); err != nil {
+		logger.Debug("Decoding old failed: " + err.Error())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if req.Operation == admissionv1.Delete {
+		if lsv1alpha1helper.HasDeletionProtectionAnnotation(oldInst.ObjectMeta) {
+			return deniedByDeletionProtection(logger, "installation", oldInst.Namespace, oldInst.Name)
+		}
+		return admission.Allowed("Installation is not protected")
+	}
+
+	inst := &lscore.Installation{}
+	if _, _, err := dec.Decode(req.Object.Raw, nil, inst); err != nil {
+		logger.Debug("Decoding failed: " + err.Error())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !lsv1alpha1helper.HasDeletionProtectionAnnotation(inst.ObjectMeta) {
+		return admission.Allowed("Installation is not protected")
+	}
+
+	if lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(inst.ObjectMeta) &&
+		!lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(oldInst.ObjectMeta) {
+		msg := fmt.Sprintf("installation %s/%s is protected against deletion: annotation %s must not be set",
+			inst.Namespace, inst.Name, lsv1alpha1.DeleteWithoutUninstallAnnotation)
+		logger.Debug("Validation failed: " + msg)
+		return admission.Denied(msg)
+	}
+
+	if inst.DeletionTimestamp.IsZero() &&
+		controllerutil.ContainsFinalizer(oldInst, lsv1alpha1.LandscaperFinalizer) &&
+		!controllerutil.ContainsFinalizer(inst, lsv1alpha1.LandscaperFinalizer) {
+		msg := fmt.Sprintf("installation %s/%s is protected against deletion: finalizer %s must not be removed",
+			inst.Namespace, inst.Name, lsv1alpha1.LandscaperFinalizer)
+		logger.Debug("Validation failed: " + msg)
+		return admission.Denied(msg)
+	}
+
+	return admission.Allowed("Installation is valid")
+}
+
+// TARGET DELETION PROTECTION
+
+// TargetDeletionProtectionWebhookLogic rejects the deletion of targets with the deletion protection annotation.
+var TargetDeletionProtectionWebhookLogic = NewTargetDeletionProtectionWebhookLogic(nil)
+
+// NewTargetDeletionProtectionWebhookLogic returns the webhook logic for the deletion of targets.
+// It rejects the deletion of targets with the deletion protection annotation. If a client is given, it additionally
+// rejects the deletion of targets which are not managed by the landscaper and still referenced by deploy items,
+// because the deploy items need their target to uninstall the deployed artifacts.
+func NewTargetDeletionProtectionWebhookLogic(kubeClient client.Client) webhooklib.WebhookLogic {
+	return func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "TargetDeletionProtectionWebhookLogic"})
+
+		t := &lscore.Target{}
+		if _, _, err := dec.Decode(req.OldObject.Raw, nil, t); err != nil {
+			logger.Debug("Decoding old failed: " + err.Error())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if lsv1alpha1helper.HasDeletionProtectionAnnotation(t.ObjectMeta) {
+			return deniedByDeletionProtection(logger, "target", t.Namespace, t.Name)
+		}
+
+		if kubeClient == nil || isManagedByLandscaper(t.Labels) {
+			return admission.Allowed("Target is not protected")
+		}
+
+		deployItems := &lsv1alpha1.DeployItemList{}
+		if err := read_write_layer.ListDeployItems(ctx, kubeClient, deployItems, read_write_layer.R000131,
+			client.InNamespace(t.Namespace)); err != nil {
+			logger.Debug("Reading deploy items failed: " + err.Error())
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+
+		users := []string{}
+		for _, di := range deployItems.Items {
+			if di.Spec.Target != nil && di.Spec.Target.Name == t.Name &&
+				(len(di.Spec.Target.Namespace) == 0 || di.Spec.Target.Namespace == t.Namespace) {
+				users = append(users, di.Name)
+			}
+		}
+
+		if len(users) > 0 {
+			sort.Strings(users)
+			msg := fmt.Sprintf("target %s/%s is in use by deploy items: %s", t.Namespace, t.Name, strings.Join(users, ", "))
+			logger.Debug("Validation failed: " + msg)
+			return admission.Denied(msg)
+		}
+
+		return admission.Allowed("Target is not in use")
+	}
+}
+
+// DATA OBJECT DELETION PROTECTION
+
+// DataObjectDeletionProtectionWebhookLogic rejects the deletion of data objects with the deletion protection annotation.
+var DataObjectDeletionProtectionWebhookLogic = NewDataObjectDeletionProtectionWebhookLogic(nil)
+
+// NewDataObjectDeletionProtectionWebhookLogic returns the webhook logic for the deletion of data objects.
+// It rejects the deletion of data objects with the deletion protection annotation. If a client is given, the
+// protection only applies as long as the data object is imported by a root installation which is not being deleted.
+func NewDataObjectDeletionProtectionWebhookLogic(kubeClient client.Client) webhooklib.WebhookLogic {
+	return func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "DataObjectDeletionProtectionWebhookLogic"})
+
+		do := &lscore.DataObject{}
+		if _, _, err := dec.Decode(req.OldObject.Raw, nil, do); err != nil {
+			logger.Debug("Decoding old failed: " + err.Error())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if !lsv1alpha1helper.HasDeletionProtectionAnnotation(do.ObjectMeta) {
+			return admission.Allowed("DataObject is not protected")
+		}
+
+		if kubeClient == nil {
+			return deniedByDeletionProtection(logger, "data object", do.Namespace, do.Name)
+		}
+
+		installations := &lsv1alpha1.InstallationList{}
+		if err := read_write_layer.ListInstallations(ctx, kubeClient, installations, read_write_layer.R000132,
+			client.InNamespace(do.Namespace)); err != nil {
+			logger.Debug("Reading installations failed: " + err.Error())
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+
+		for i := range installations.Items {
+			inst := &installations.Items[i]
+			if !inst.DeletionTimestamp.IsZero() || !isRootInstallation(inst) {
+				continue
+			}
+			for _, dataImport := range inst.Spec.Imports.Data {
+				if len(dataImport.DataRef) != 0 && lsv1alpha1helper.GenerateDataObjectName("", dataImport.DataRef) == do.Name {
+					return deniedByDeletionProtection(logger, "data object", do.Namespace, do.Name)
+				}
+			}
+		}
+
+		return admission.Allowed("DataObject is not imported")
+	}
+}
+
+func deniedByDeletionProtection(logger logging.Logger, kind, namespace, name string) admission.Response {
+	msg := fmt.Sprintf("%s %s/%s is protected against deletion by annotation %s", kind, namespace, name,
+		lsv1alpha1.DeletionProtectionAnnotation)
+	logger.Debug("Validation failed: " + msg)
+	return admission.Denied(msg)
+}
+
+// isManagedByLandscaper returns whether a target or data object has been created by the landscaper,
+// for example as export of an installation.
+func isManagedByLandscaper(labels map[string]string) bool {
+	_, hasSource := labels[lsv1alpha1.DataObjectSourceLabel]
+	_, hasContext := labels[lsv1alpha1.DataObjectContextLabel]
+	return hasSource || hasContext
+}
+
+func isRootInstallation(inst *lsv1alpha1.Installation) bool {
+	for _, ref := range inst.OwnerReferences {
+		if ref.Kind == utils.InstallationKind {
+			return false
+		}
+	}
+	return true
+}
@@ -12,14 +12,20 @@ import (
 	lc "github.com/openmcp-project/landscaper/controller-utils/pkg/logging/constants"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	lscore "github.com/openmcp-project/landscaper/apis/core"
+	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
 	"github.com/openmcp-project/landscaper/apis/core/validation"
+	lscutils "github.com/openmcp-project/landscaper/controller-utils/pkg/landscaper"
 	"github.com/openmcp-project/landscaper/controller-utils/pkg/logging"
 	webhooklib "github.com/openmcp-project/landscaper/controller-utils/pkg/webhook"
+	"github.com/openmcp-project/landscaper/pkg/landscaper/targettypes"
+	"github.com/openmcp-project/landscaper/pkg/utils/read_write_layer"
 )
 
 // INSTALLATION
@@ -41,6 +47,25 @@ var InstallationWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context,
 	return admission.Allowed("Installation is valid")
 }
 
+// INSTALLATIONSET
+
+var InstallationSetWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "InstallationSetWebhookLogic"})
+	set := &lscore.InstallationSet{}
+	if _, _, err := dec.Decode(req.Object.Raw, nil, set); err != nil {
+		logger.Debug("Decoding failed: " + err.Error())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateInstallationSet(set); len(errs) > 0 {
+		aggErr := errs.ToAggregate().Error()
+		logger.Debug("Validation failed: " + aggErr)
+		return admission.Denied(aggErr)
+	}
+
+	return admission.Allowed("InstallationSet is valid")
+}
+
 // DEPLOYITEM
 
 var DeployItemWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
@@ -96,20 +121,128 @@ var ExecutionWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, re
 
 // TARGET
 
-var TargetWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
-	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "ExecutionWebhookLogic"})
+// TargetWebhookLogic validates targets without taking the registered target types into account.
+var TargetWebhookLogic = NewTargetWebhookLogic(nil)
 
-	t := &lscore.Target{}
-	if _, _, err := dec.Decode(req.Object.Raw, nil, t); err != nil {
-		logger.Debug("Decoding failed: " + err.Error())
-		return admission.Errored(http.StatusBadRequest, err)
+// NewTargetWebhookLogic returns the webhook logic for targets.
+// If a client is given, the configuration of a target, or the content of the secret referenced by the target,
+// is additionally validated against the schema of the TargetTypeDefinition that registers the type of the target.
+func NewTargetWebhookLogic(kubeClient client.Client) webhooklib.WebhookLogic {
+	return func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "TargetWebhookLogic"})
+
+		t := &lscore.Target{}
+		if _, _, err := dec.Decode(req.Object.Raw, nil, t); err != nil {
+			logger.Debug("Decoding failed: " + err.Error())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if errs := validation.ValidateTarget(t); len(errs) > 0 {
+			aggErr := errs.ToAggregate().Error()
+			logger.Debug("Validation failed: " + aggErr)
+			return admission.Denied(aggErr)
+		}
+
+		if kubeClient == nil {
+			return admission.Allowed("Target is valid")
+		}
+
+		registry, err := targettypes.NewRegistry(ctx, kubeClient, read_write_layer.R000112)
+		if err != nil {
+			logger.Debug("Reading target type definitions failed: " + err.Error())
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+
+		config, err := getTargetConfiguration(ctx, kubeClient, t)
+		if err != nil {
+			logger.Debug("Reading target configuration failed: " + err.Error())
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if config == nil {
+			return admission.Allowed("Target is valid")
+		}
+
+		if err := registry.ValidateConfiguration(lsv1alpha1.TargetType(t.Spec.Type), config); err != nil {
+			logger.Debug("Validation failed: " + err.Error())
+			return admission.Denied(err.Error())
+		}
+
+		return admission.Allowed("Target is valid")
 	}
+}
 
-	if errs := validation.ValidateTarget(t); len(errs) > 0 {
-		aggErr := errs.ToAggregate().Error()
-		logger.Debug("Validation failed: " + aggErr)
-		return admission.Denied(aggErr)
+// getTargetConfiguration returns the inline configuration of a target or the content of the secret referenced by it.
+// Nil is returned if the target has no configuration or if the referenced secret does not exist (yet).
+func getTargetConfiguration(ctx context.Context, kubeClient client.Client, t *lscore.Target) ([]byte, error) {
+	if t.Spec.Configuration != nil {
+		return t.Spec.Configuration.RawMessage, nil
+	}
+	if t.Spec.SecretRef == nil {
+		return nil, nil
 	}
 
-	return admission.Allowed("Target is valid")
+	secretRef := &lsv1alpha1.SecretReference{
+		ObjectReference: lsv1alpha1.ObjectReference{
+			Name:      t.Spec.SecretRef.Name,
+			Namespace: t.Namespace,
+		},
+		Key: t.Spec.SecretRef.Key,
+	}
+	_, content, _, err := lscutils.ResolveSecretReference(ctx, kubeClient, secretRef)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return content, nil
+}
+
+// TARGET TYPE DEFINITION
+
+// TargetTypeDefinitionWebhookLogic validates target type definitions without checking for conflicts with other definitions.
+var TargetTypeDefinitionWebhookLogic = NewTargetTypeDefinitionWebhookLogic(nil)
+
+// NewTargetTypeDefinitionWebhookLogic returns the webhook logic for target type definitions.
+// If a client is given, it is additionally ensured that a target type is not registered twice.
+func NewTargetTypeDefinitionWebhookLogic(kubeClient client.Client) webhooklib.WebhookLogic {
+	return func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "TargetTypeDefinitionWebhookLogic"})
+
+		def := &lscore.TargetTypeDefinition{}
+		if _, _, err := dec.Decode(req.Object.Raw, nil, def); err != nil {
+			logger.Debug("Decoding failed: " + err.Error())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if errs := validation.ValidateTargetTypeDefinition(def); len(errs) > 0 {
+			aggErr := errs.ToAggregate().Error()
+			logger.Debug("Validation failed: " + aggErr)
+			return admission.Denied(aggErr)
+		}
+
+		if def.Spec.Schema != nil {
+			if err := targettypes.ValidateSchema(def.Spec.Schema.RawMessage); err != nil {
+				logger.Debug("Validation failed: " + err.Error())
+				return admission.Denied(field.Invalid(field.NewPath("spec", "schema"), string(def.Spec.Schema.RawMessage), err.Error()).Error())
+			}
+		}
+
+		if kubeClient == nil {
+			return admission.Allowed("TargetTypeDefinition is valid")
+		}
+
+		registry, err := targettypes.NewRegistry(ctx, kubeClient, read_write_layer.R000113)
+		if err != nil {
+			logger.Debug("Reading target type definitions failed: " + err.Error())
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if other := registry.Get(lsv1alpha1.TargetType(def.Spec.Type)); other != nil && other.Name != def.Name {
+			msg := fmt.Sprintf("target type %q is already registered by TargetTypeDefinition %q", def.Spec.Type, other.Name)
+			logger.Debug("Validation failed: " + msg)
+			return admission.Denied(field.Invalid(field.NewPath("spec", "type"), def.Spec.Type, msg).Error())
+		}
+
+		return admission.Allowed("TargetTypeDefinition is valid")
+	}
 }
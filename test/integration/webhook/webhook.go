@@ -15,6 +15,8 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/openmcp-project/landscaper/apis/config"
 	lsv1alpha1 "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
@@ -176,5 +178,210 @@ func WebhookTest(f *framework.Framework) {
 			Expect(err).To(HaveOccurred()) // validation webhook should have denied this
 			Expect(err.Error()).To(ContainSubstring("admission webhook \"targets.validation.landscaper.gardener.cloud\" denied the request"))
 		})
+
+		It("should block the deletion of protected Targets", func() {
+			target := &lsv1alpha1.Target{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-protected-target",
+					Namespace: state.Namespace,
+					Annotations: map[string]string{
+						lsv1alpha1.DeletionProtectionAnnotation: "true",
+					},
+				},
+				Spec: lsv1alpha1.TargetSpec{
+					Type:          "landscaper.gardener.cloud/test",
+					Configuration: lsv1alpha1.NewAnyJSONPointer([]byte(`{"foo": "bar"}`)),
+				},
+			}
+			utils.ExpectNoError(state.Create(ctx, target))
+
+			err := f.Client.Delete(ctx, target)
+			Expect(err).To(HaveOccurred()) // validation webhook should have denied this
+			Expect(err.Error()).To(ContainSubstring("admission webhook \"targets-deletion-protection.validation.landscaper.gardener.cloud\" denied the request"))
+
+			delete(target.Annotations, lsv1alpha1.DeletionProtectionAnnotation)
+			utils.ExpectNoError(state.Update(ctx, target))
+			utils.ExpectNoError(f.Client.Delete(ctx, target))
+		})
+
+		It("should block the deletion of Targets in use by DeployItems", func() {
+			target := &lsv1alpha1.Target{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-used-target",
+					Namespace: state.Namespace,
+				},
+				Spec: lsv1alpha1.TargetSpec{
+					Type:          "landscaper.gardener.cloud/test",
+					Configuration: lsv1alpha1.NewAnyJSONPointer([]byte(`{"foo": "bar"}`)),
+				},
+			}
+			utils.ExpectNoError(state.Create(ctx, target))
+
+			di := &lsv1alpha1.DeployItem{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-deployitem-with-target",
+					Namespace: state.Namespace,
+				},
+				Spec: lsv1alpha1.DeployItemSpec{
+					Type: "some-type",
+					Target: &lsv1alpha1.ObjectReference{
+						Name:      target.Name,
+						Namespace: state.Namespace,
+					},
+				},
+			}
+			utils.ExpectNoError(state.Create(ctx, di))
+
+			err := f.Client.Delete(ctx, target)
+			Expect(err).To(HaveOccurred()) // validation webhook should have denied this
+			Expect(err.Error()).To(ContainSubstring("is in use by deploy items: test-deployitem-with-target"))
+
+			utils.ExpectNoError(f.Client.Delete(ctx, di))
+			utils.ExpectNoError(f.Client.Delete(ctx, target))
+		})
+
+		Context("Installation deletion protection", func() {
+
+			const installationsDeletionProtectionWebhook = "admission webhook \"installations-deletion-protection.validation.landscaper.gardener.cloud\" denied the request"
+
+			newInstallation := func(name string, annotations map[string]string) *lsv1alpha1.Installation {
+				return &lsv1alpha1.Installation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        name,
+						Namespace:   state.Namespace,
+						Annotations: annotations,
+						Finalizers:  []string{lsv1alpha1.LandscaperFinalizer},
+					},
+					Spec: lsv1alpha1.InstallationSpec{
+						Blueprint: lsv1alpha1.BlueprintDefinition{
+							Inline: &lsv1alpha1.InlineBlueprint{
+								Filesystem: lsv1alpha1.NewAnyJSON([]byte(`{"blueprint.yaml": "apiVersion: landscaper.gardener.cloud/v1alpha1\nkind: Blueprint\n"}`)),
+							},
+						},
+					},
+				}
+			}
+
+			// updateInstallation applies the given change to the current version of the installation, because the
+			// landscaper may update the installation concurrently.
+			updateInstallation := func(inst *lsv1alpha1.Installation, change func(*lsv1alpha1.Installation)) error {
+				return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+					if err := f.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst); err != nil {
+						return err
+					}
+					change(inst)
+					return f.Client.Update(ctx, inst)
+				})
+			}
+
+			// unprotectAndDelete removes the deletion protection of the installation and deletes it without uninstall.
+			unprotectAndDelete := func(inst *lsv1alpha1.Installation) {
+				utils.ExpectNoError(updateInstallation(inst, func(inst *lsv1alpha1.Installation) {
+					delete(inst.Annotations, lsv1alpha1.DeletionProtectionAnnotation)
+				}))
+				utils.ExpectNoError(updateInstallation(inst, func(inst *lsv1alpha1.Installation) {
+					metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.DeleteWithoutUninstallAnnotation, "true")
+				}))
+				utils.ExpectNoError(f.Client.Delete(ctx, inst))
+			}
+
+			It("should block the deletion of protected Installations", func() {
+				inst := newInstallation("test-protected-inst", map[string]string{
+					lsv1alpha1.DeletionProtectionAnnotation: "true",
+				})
+				utils.ExpectNoError(state.Create(ctx, inst))
+
+				err := f.Client.Delete(ctx, inst)
+				Expect(err).To(HaveOccurred()) // validation webhook should have denied this
+				Expect(err.Error()).To(ContainSubstring(installationsDeletionProtectionWebhook))
+				Expect(err.Error()).To(ContainSubstring("is protected against deletion"))
+
+				unprotectAndDelete(inst)
+			})
+
+			It("should block setting the delete-without-uninstall annotation at protected Installations", func() {
+				inst := newInstallation("test-protected-inst-without-uninstall", map[string]string{
+					lsv1alpha1.DeletionProtectionAnnotation: "true",
+				})
+				utils.ExpectNoError(state.Create(ctx, inst))
+
+				err := updateInstallation(inst, func(inst *lsv1alpha1.Installation) {
+					metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.DeleteWithoutUninstallAnnotation, "true")
+				})
+				Expect(err).To(HaveOccurred()) // validation webhook should have denied this
+				Expect(err.Error()).To(ContainSubstring(installationsDeletionProtectionWebhook))
+				Expect(err.Error()).To(ContainSubstring("annotation " + lsv1alpha1.DeleteWithoutUninstallAnnotation + " must not be set"))
+
+				unprotectAndDelete(inst)
+			})
+
+			It("should block removing the finalizer of protected Installations", func() {
+				inst := newInstallation("test-protected-inst-finalizer", map[string]string{
+					lsv1alpha1.DeletionProtectionAnnotation: "true",
+				})
+				utils.ExpectNoError(state.Create(ctx, inst))
+
+				err := updateInstallation(inst, func(inst *lsv1alpha1.Installation) {
+					controllerutil.RemoveFinalizer(inst, lsv1alpha1.LandscaperFinalizer)
+				})
+				Expect(err).To(HaveOccurred()) // validation webhook should have denied this
+				Expect(err.Error()).To(ContainSubstring(installationsDeletionProtectionWebhook))
+				Expect(err.Error()).To(ContainSubstring("finalizer " + lsv1alpha1.LandscaperFinalizer + " must not be removed"))
+
+				unprotectAndDelete(inst)
+			})
+
+			It("should allow changes of unprotected Installations", func() {
+				inst := newInstallation("test-unprotected-inst", nil)
+				utils.ExpectNoError(state.Create(ctx, inst))
+
+				utils.ExpectNoError(updateInstallation(inst, func(inst *lsv1alpha1.Installation) {
+					metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.DeleteWithoutUninstallAnnotation, "true")
+				}))
+				utils.ExpectNoError(f.Client.Delete(ctx, inst))
+			})
+		})
+
+		It("should block the deletion of protected DataObjects as long as they are imported", func() {
+			do := &lsv1alpha1.DataObject{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-protected-data",
+					Namespace: state.Namespace,
+					Annotations: map[string]string{
+						lsv1alpha1.DeletionProtectionAnnotation: "true",
+					},
+				},
+				Data: lsv1alpha1.NewAnyJSON([]byte(`"foo"`)),
+			}
+			utils.ExpectNoError(state.Create(ctx, do))
+
+			inst := &lsv1alpha1.Installation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-importing-inst",
+					Namespace:   state.Namespace,
+					Annotations: map[string]string{lsv1alpha1.DeleteWithoutUninstallAnnotation: "true"},
+					Finalizers:  []string{lsv1alpha1.LandscaperFinalizer},
+				},
+				Spec: lsv1alpha1.InstallationSpec{
+					Blueprint: lsv1alpha1.BlueprintDefinition{
+						Inline: &lsv1alpha1.InlineBlueprint{
+							Filesystem: lsv1alpha1.NewAnyJSON([]byte(`{"blueprint.yaml": "apiVersion: landscaper.gardener.cloud/v1alpha1\nkind: Blueprint\n"}`)),
+						},
+					},
+					Imports: lsv1alpha1.InstallationImports{
+						Data: []lsv1alpha1.DataImport{{Name: "data", DataRef: do.Name}},
+					},
+				},
+			}
+			utils.ExpectNoError(state.Create(ctx, inst))
+
+			err := f.Client.Delete(ctx, do)
+			Expect(err).To(HaveOccurred()) // validation webhook should have denied this
+			Expect(err.Error()).To(ContainSubstring("admission webhook \"dataobjects-deletion-protection.validation.landscaper.gardener.cloud\" denied the request"))
+
+			// the protection ends with the deletion of the importing installation
+			utils.ExpectNoError(f.Client.Delete(ctx, inst))
+			utils.ExpectNoError(f.Client.Delete(ctx, do))
+		})
 	})
 }
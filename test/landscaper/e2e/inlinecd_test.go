@@ -57,7 +57,7 @@ var _ = Describe("Inline Component Descriptor", func() {
 
 		execActuator, err = execctlr.NewController(testenv.Client, testenv.Client, testenv.Client, testenv.Client,
 			logging.Discard(), api.LandscaperScheme,
-			events.NewFakeRecorder(1024), 1000, false, "exec-test-"+testutils.GetNextCounter())
+			events.NewFakeRecorder(1024), 1000, false, nil, "exec-test-"+testutils.GetNextCounter())
 		Expect(err).ToNot(HaveOccurred())
 
 		mockActuator, err = mockctlr.NewController(testenv.Client, testenv.Client, testenv.Client, testenv.Client,